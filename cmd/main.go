@@ -2,14 +2,32 @@ package main
 
 import (
 	"flag"
+	"fmt"
+	"os"
+	"strings"
 
 	"github.com/sudo-odner/Backend-trainee-assignment-avito-2025/internal/app"
 	"github.com/sudo-odner/Backend-trainee-assignment-avito-2025/internal/config"
 	"github.com/sudo-odner/Backend-trainee-assignment-avito-2025/pkg/logger"
+	"github.com/sudo-odner/Backend-trainee-assignment-avito-2025/pkg/logger/sl"
 )
 
+const defaultConfigPath = "./config/local.yaml"
+
 func main() {
-	configPath := flag.String("config", "./config/local.yaml", "path to config file")
+	// Подкоманды, без подкоманды - запуск сервиса
+	if len(os.Args) > 1 {
+		switch os.Args[1] {
+		case "import":
+			runImport(os.Args[2:])
+			return
+		case "backup", "restore":
+			runBackup(os.Args[1], os.Args[2:])
+			return
+		}
+	}
+
+	configPath := flag.String("config", defaultConfigPath, "path to config file")
 	flag.Parse()
 	// Init config
 	cfg := config.MustLoad(*configPath)
@@ -18,3 +36,62 @@ func main() {
 	// Init microservice
 	app.Run(cfg, log)
 }
+
+// runImport Импорт состава команд: import -file roster.yaml [-dry-run]
+func runImport(args []string) {
+	flags := flag.NewFlagSet("import", flag.ExitOnError)
+	configPath := flags.String("config", defaultConfigPath, "path to config file")
+	file := flags.String("file", "", "path to roster file (.yaml or .csv)")
+	dryRun := flags.Bool("dry-run", false, "show changes without applying them")
+	_ = flags.Parse(args)
+	if *file == "" {
+		fmt.Fprintln(os.Stderr, "import: -file is required")
+		flags.Usage()
+		os.Exit(2)
+	}
+
+	cfg := config.MustLoad(*configPath)
+	log := logger.SetupLogger(cfg.Env)
+
+	summary, err := app.Import(cfg, log, *file, *dryRun)
+	if err != nil {
+		log.Error("import failed", sl.Err(err))
+		os.Exit(1)
+	}
+	if *dryRun {
+		fmt.Println("dry run, nothing applied")
+	}
+	fmt.Printf("created teams: %s\n", strings.Join(summary.CreatedTeams, ", "))
+	fmt.Printf("created: %s\n", strings.Join(summary.Created, ", "))
+	fmt.Printf("updated: %s\n", strings.Join(summary.Updated, ", "))
+	fmt.Printf("moved: %s\n", strings.Join(summary.Moved, ", "))
+	fmt.Printf("unchanged: %d\n", len(summary.Unchanged))
+}
+
+// runBackup Резервная копия и восстановление: backup -file backup.json, restore -file backup.json
+func runBackup(command string, args []string) {
+	flags := flag.NewFlagSet(command, flag.ExitOnError)
+	configPath := flags.String("config", defaultConfigPath, "path to config file")
+	file := flags.String("file", "", "path to backup archive (.json)")
+	_ = flags.Parse(args)
+	if *file == "" {
+		fmt.Fprintf(os.Stderr, "%s: -file is required\n", command)
+		flags.Usage()
+		os.Exit(2)
+	}
+
+	cfg := config.MustLoad(*configPath)
+	log := logger.SetupLogger(cfg.Env)
+
+	run := app.Backup
+	if command == "restore" {
+		run = app.Restore
+	}
+	snapshot, err := run(cfg, log, *file)
+	if err != nil {
+		log.Error(command+" failed", sl.Err(err))
+		os.Exit(1)
+	}
+	fmt.Printf("%s: users %d, teams %d, pull requests %d, reviewers %d\n", command,
+		len(snapshot.Users), len(snapshot.Teams), len(snapshot.PullRequests), len(snapshot.Reviewers))
+}
@@ -1,34 +1,83 @@
 package app
 
 import (
+	"context"
 	"errors"
 	"fmt"
 	"log/slog"
+	"net"
 	"net/http"
+	"time"
 
 	"github.com/sudo-odner/Backend-trainee-assignment-avito-2025/internal/config"
+	"github.com/sudo-odner/Backend-trainee-assignment-avito-2025/internal/sla"
 	"github.com/sudo-odner/Backend-trainee-assignment-avito-2025/internal/storage/postgresql"
+	"github.com/sudo-odner/Backend-trainee-assignment-avito-2025/internal/transport/grpcserver"
 	"github.com/sudo-odner/Backend-trainee-assignment-avito-2025/internal/transport/router"
+	"github.com/sudo-odner/Backend-trainee-assignment-avito-2025/internal/webhook"
 	"github.com/sudo-odner/Backend-trainee-assignment-avito-2025/pkg/logger/sl"
 )
 
+// defaultIdempotencyCleanupInterval Интервал удаления ключей идемпотентности, если в конфиге задан неположительный
+const defaultIdempotencyCleanupInterval = 10 * time.Minute
+
 func Run(cfg *config.Config, logger *slog.Logger) {
 	// Init storage
-	storage, err := postgresql.New(
-		cfg.Storage.Host,
-		cfg.Storage.Port,
-		cfg.Storage.User,
-		cfg.Storage.Password,
-		cfg.Storage.DBName,
-		cfg.Storage.SSLMode)
+	storage, err := newStorage(cfg)
 	if err != nil {
 		logger.Error("Storage not initialized", sl.Err(err))
 		panic(err)
 	}
 	logger.Debug("Storage initialized")
 
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	// Init review SLA
+	if cfg.ReviewSLA.Enabled {
+		storage.SetReviewSLA(newSLAPolicy(cfg, logger))
+		worker := sla.NewWorker(logger, storage, cfg.ReviewSLA.CheckInterval, cfg.ReviewSLA.ReassignAfter)
+		go worker.Run(ctx)
+		logger.Debug("Review SLA worker started")
+	}
+
+	// Init webhook dispatcher
+	if cfg.Webhooks.Enabled {
+		dispatcher := webhook.NewDispatcher(logger, storage, webhook.Options{
+			PollInterval: cfg.Webhooks.PollInterval,
+			Timeout:      cfg.Webhooks.Timeout,
+			MaxAttempts:  cfg.Webhooks.MaxAttempts,
+			BaseBackoff:  cfg.Webhooks.BaseBackoff,
+			MaxBackoff:   cfg.Webhooks.MaxBackoff,
+			BatchSize:    cfg.Webhooks.BatchSize,
+		})
+		go dispatcher.Run(ctx)
+		logger.Debug("Webhook dispatcher started")
+	}
+
+	// Init idempotency keys cleanup
+	go purgeIdempotencyKeys(ctx, logger, storage, cfg.Idempotency.CleanupInterval)
+
+	// Init gRPC server
+	if cfg.GRPCServer.Enabled {
+		grpcAddr := cfg.GRPCServer.Host + ":" + cfg.GRPCServer.Port
+		lis, err := net.Listen("tcp", grpcAddr)
+		if err != nil {
+			logger.Error(fmt.Sprintf("error listening on '%s'", grpcAddr), sl.Err(err))
+			return
+		}
+		grpcSrv := grpcserver.New(logger, storage, cfg)
+		defer grpcSrv.Stop()
+		go func() {
+			if err := grpcSrv.Serve(lis); err != nil {
+				logger.Error(fmt.Sprintf("error serving gRPC on '%s'", grpcAddr), sl.Err(err))
+			}
+		}()
+		logger.Info(fmt.Sprintf("gRPC server listening on '%s'", grpcAddr))
+	}
+
 	// Init transport
-	handler := router.New(logger, storage)
+	handler := router.New(logger, storage, cfg)
 	logger.Debug("Router initialized")
 
 	// Run service
@@ -45,3 +94,60 @@ func Run(cfg *config.Config, logger *slog.Logger) {
 	}
 	logger.Info(fmt.Sprintf("server listening on '%s'", addr))
 }
+
+func newStorage(cfg *config.Config) (*postgresql.Storage, error) {
+	return postgresql.New(
+		cfg.Storage.Host,
+		cfg.Storage.Port,
+		cfg.Storage.User,
+		cfg.Storage.Password,
+		cfg.Storage.DBName,
+		cfg.Storage.SSLMode)
+}
+
+func newSLAPolicy(cfg *config.Config, logger *slog.Logger) *sla.Policy {
+	loc, err := time.LoadLocation(cfg.ReviewSLA.BusinessHours.Timezone)
+	if err != nil {
+		logger.Warn("unknown SLA timezone, using UTC", sl.Err(err))
+		loc = time.UTC
+	}
+	workdays := make(map[time.Weekday]bool, len(cfg.ReviewSLA.BusinessHours.Workdays))
+	for _, day := range cfg.ReviewSLA.BusinessHours.Workdays {
+		workdays[time.Weekday(day%7)] = true
+	}
+	return &sla.Policy{
+		DefaultHours: cfg.ReviewSLA.DefaultHours,
+		TeamHours:    cfg.ReviewSLA.TeamHours,
+		WorkdayStart: cfg.ReviewSLA.BusinessHours.Start,
+		WorkdayEnd:   cfg.ReviewSLA.BusinessHours.End,
+		Workdays:     workdays,
+		Location:     loc,
+	}
+}
+
+// purgeIdempotencyKeys Периодическое удаление истёкших ключей идемпотентности
+func purgeIdempotencyKeys(ctx context.Context, logger *slog.Logger, storage *postgresql.Storage, interval time.Duration) {
+	if interval <= 0 {
+		logger.Warn("invalid idempotency cleanup interval, using default",
+			slog.Duration("interval", interval), slog.Duration("default", defaultIdempotencyCleanupInterval))
+		interval = defaultIdempotencyCleanupInterval
+	}
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+		purged, err := storage.PurgeIdempotencyKeys(time.Now())
+		if err != nil {
+			logger.Error("failed to purge idempotency keys", sl.Err(err))
+			continue
+		}
+		if purged > 0 {
+			logger.Debug("idempotency keys purged", slog.Int("count", purged))
+		}
+	}
+}
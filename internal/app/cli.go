@@ -0,0 +1,136 @@
+package app
+
+import (
+	"fmt"
+	"io"
+	"log/slog"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/sudo-odner/Backend-trainee-assignment-avito-2025/internal/backup"
+	"github.com/sudo-odner/Backend-trainee-assignment-avito-2025/internal/config"
+	"github.com/sudo-odner/Backend-trainee-assignment-avito-2025/internal/domain"
+	"github.com/sudo-odner/Backend-trainee-assignment-avito-2025/internal/roster"
+	"github.com/sudo-odner/Backend-trainee-assignment-avito-2025/pkg/logger/sl"
+)
+
+// cliActor Инициатор изменений из командной строки (попадает в аудит)
+const cliActor = "cli"
+
+// Import Импорт состава команд из файла (YAML или CSV по расширению)
+func Import(cfg *config.Config, logger *slog.Logger, path string, dryRun bool) (*domain.ImportSummary, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer func() {
+		if err := file.Close(); err != nil {
+			logger.Warn("failed to close roster file", sl.Err(err))
+		}
+	}()
+
+	// Разбор и проверка состава до подключения к БД
+	teams, err := roster.Parse(file, roster.FormatByName(path))
+	if err != nil {
+		return nil, err
+	}
+
+	storage, err := newStorage(cfg)
+	if err != nil {
+		return nil, fmt.Errorf("storage not initialized: %w", err)
+	}
+	defer func() {
+		if err := storage.Close(); err != nil {
+			logger.Warn("failed to close storage", sl.Err(err))
+		}
+	}()
+
+	return storage.ImportTeams(teams, dryRun, domain.ChangeMeta{Actor: cliActor, Reason: "import " + path})
+}
+
+// Backup Запись резервной копии всех данных в файл
+func Backup(cfg *config.Config, logger *slog.Logger, path string) (*domain.Snapshot, error) {
+	storage, err := newStorage(cfg)
+	if err != nil {
+		return nil, fmt.Errorf("storage not initialized: %w", err)
+	}
+	defer func() {
+		if err := storage.Close(); err != nil {
+			logger.Warn("failed to close storage", sl.Err(err))
+		}
+	}()
+
+	snapshot, err := storage.DumpSnapshot()
+	if err != nil {
+		return nil, err
+	}
+
+	createdAt := time.Now()
+	if err := writeFileAtomic(path, func(w io.Writer) error {
+		return backup.Write(w, snapshot, createdAt)
+	}); err != nil {
+		return nil, err
+	}
+	return snapshot, nil
+}
+
+// writeFileAtomic Запись файла через временный файл в том же каталоге: при ошибке записи
+// по пути path остаётся прежний файл (или ничего), а не обрезанный архив
+func writeFileAtomic(path string, write func(w io.Writer) error) (err error) {
+	file, err := os.CreateTemp(filepath.Dir(path), "."+filepath.Base(path)+".*.tmp")
+	if err != nil {
+		return err
+	}
+	defer func() {
+		if err != nil {
+			_ = file.Close()
+			_ = os.Remove(file.Name())
+		}
+	}()
+
+	if err := write(file); err != nil {
+		return err
+	}
+	if err := file.Sync(); err != nil {
+		return err
+	}
+	if err := file.Close(); err != nil {
+		return err
+	}
+	return os.Rename(file.Name(), path)
+}
+
+// Restore Восстановление данных из резервной копии в пустую БД
+func Restore(cfg *config.Config, logger *slog.Logger, path string) (*domain.Snapshot, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer func() {
+		if err := file.Close(); err != nil {
+			logger.Warn("failed to close backup file", sl.Err(err))
+		}
+	}()
+
+	// Проверка архива до подключения к БД
+	snapshot, err := backup.Read(file)
+	if err != nil {
+		return nil, err
+	}
+
+	storage, err := newStorage(cfg)
+	if err != nil {
+		return nil, fmt.Errorf("storage not initialized: %w", err)
+	}
+	defer func() {
+		if err := storage.Close(); err != nil {
+			logger.Warn("failed to close storage", sl.Err(err))
+		}
+	}()
+
+	if err := storage.RestoreSnapshot(snapshot, domain.ChangeMeta{Actor: cliActor, Reason: "restore " + path}); err != nil {
+		return nil, err
+	}
+	return snapshot, nil
+}
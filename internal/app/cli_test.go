@@ -0,0 +1,56 @@
+package app
+
+import (
+	"errors"
+	"io"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestWriteFileAtomic(t *testing.T) {
+	dir := t.TempDir()
+	path := filepath.Join(dir, "backup.json")
+
+	if err := writeFileAtomic(path, func(w io.Writer) error {
+		_, err := io.WriteString(w, "first")
+		return err
+	}); err != nil {
+		t.Fatalf("writeFileAtomic() error = %v", err)
+	}
+
+	// Ошибка посередине записи не трогает прежний файл и не оставляет временных
+	errWrite := errors.New("disk full")
+	err := writeFileAtomic(path, func(w io.Writer) error {
+		_, _ = io.WriteString(w, "sec")
+		return errWrite
+	})
+	if !errors.Is(err, errWrite) {
+		t.Fatalf("writeFileAtomic() error = %v, want %v", err, errWrite)
+	}
+
+	content, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatalf("read file: %v", err)
+	}
+	if string(content) != "first" {
+		t.Errorf("file content = %q, want %q", content, "first")
+	}
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		t.Fatalf("read dir: %v", err)
+	}
+	if len(entries) != 1 {
+		t.Errorf("dir has %d entries, want only the target file", len(entries))
+	}
+}
+
+func TestWriteFileAtomicFailedWriteLeavesNoFile(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "backup.json")
+	_ = writeFileAtomic(path, func(w io.Writer) error {
+		return errors.New("storage closed")
+	})
+	if _, err := os.Stat(path); !errors.Is(err, os.ErrNotExist) {
+		t.Errorf("stat after failed write: %v, want not exist", err)
+	}
+}
@@ -0,0 +1,228 @@
+// Package auth Bearer токены API: владелец токена, уровни доступа и область действия токена
+package auth
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/hex"
+	"errors"
+
+	"github.com/sudo-odner/Backend-trainee-assignment-avito-2025/internal/domain"
+	"github.com/sudo-odner/Backend-trainee-assignment-avito-2025/internal/storage"
+)
+
+const (
+	// tokenPrefix Префикс выпускаемых токенов (чтобы их было легко найти в логах и конфигах)
+	tokenPrefix     = "prt_"
+	tokenRandomSize = 32
+	// configTokenName Имя администратора из auth.admin_token
+	configTokenName = "config"
+)
+
+// Storage Данные, нужные для проверки токена и его области
+type Storage interface {
+	GetAPITokenByHash(tokenHash string) (*domain.APIToken, error)
+	IsUserInTeam(userID, teamName string) (bool, error)
+	IsPRInTeam(prID, teamName string) (bool, error)
+	GetUserTeamByID(userID string) (string, error)
+}
+
+// Access Кому доступна операция
+type Access int
+
+const (
+	AccessAdmin Access = iota
+	// AccessTeamLead Admin или руководитель команды (область проверяет обработчик)
+	AccessTeamLead
+	AccessUser
+	// AccessPublic Без токена
+	AccessPublic
+)
+
+// Allows Доступна ли операция роли
+func (a Access) Allows(role string) bool {
+	switch a {
+	case AccessAdmin:
+		return role == domain.RoleAdmin
+	case AccessTeamLead:
+		return role == domain.RoleAdmin || role == domain.RoleTeamLead
+	default:
+		return true
+	}
+}
+
+// ScopeError Действие вне области токена (роль или команда)
+type ScopeError struct {
+	Message string
+}
+
+func (e *ScopeError) Error() string {
+	return e.Message
+}
+
+// Authenticator Проверка токенов и области их действия
+type Authenticator struct {
+	storage    Storage
+	adminToken string
+}
+
+func New(storage Storage, adminToken string) *Authenticator {
+	return &Authenticator{storage: storage, adminToken: adminToken}
+}
+
+// Authenticate Владелец токена; storage.ErrTokenNotFound для пустого, неизвестного или отозванного токена
+func (a *Authenticator) Authenticate(token string) (*domain.Principal, error) {
+	if token == "" {
+		return nil, storage.ErrTokenNotFound
+	}
+	if a.adminToken != "" && subtle.ConstantTimeCompare([]byte(token), []byte(a.adminToken)) == 1 {
+		return &domain.Principal{Name: configTokenName, Role: domain.RoleAdmin}, nil
+	}
+
+	apiToken, err := a.storage.GetAPITokenByHash(HashToken(token))
+	if err != nil {
+		return nil, err
+	}
+	return &domain.Principal{
+		TokenID:  apiToken.ID,
+		Name:     apiToken.Name,
+		Role:     apiToken.Role,
+		UserID:   apiToken.UserID,
+		TeamName: apiToken.TeamName,
+	}, nil
+}
+
+type principalKey struct{}
+
+// WithPrincipal Контекст запроса с владельцем токена
+func WithPrincipal(ctx context.Context, principal *domain.Principal) context.Context {
+	return context.WithValue(ctx, principalKey{}, principal)
+}
+
+// PrincipalFrom Владелец токена запроса (nil, если аутентификация отключена)
+func PrincipalFrom(ctx context.Context) *domain.Principal {
+	principal, _ := ctx.Value(principalKey{}).(*domain.Principal)
+	return principal
+}
+
+// ChangeMeta Инициатор изменения: токен не-admin всегда действует от имени своего пользователя,
+// admin может передать инициатора явно (actor)
+func ChangeMeta(ctx context.Context, actor string) domain.ChangeMeta {
+	principal := PrincipalFrom(ctx)
+	if principal != nil && principal.Role != domain.RoleAdmin {
+		return domain.ChangeMeta{Actor: principal.UserID}
+	}
+	if actor == "" && principal != nil {
+		actor = principal.Name
+	}
+	if actor == "" {
+		actor = "anonymous"
+	}
+	return domain.ChangeMeta{Actor: actor}
+}
+
+// TeamScope Руководитель команды управляет только своей командой
+func (a *Authenticator) TeamScope(ctx context.Context, teamName string) error {
+	principal := PrincipalFrom(ctx)
+	if principal == nil || principal.Role == domain.RoleAdmin || principal.TeamName == teamName {
+		return nil
+	}
+	return &ScopeError{Message: "team is out of token scope"}
+}
+
+// UserScope Руководитель команды управляет только участниками своей команды
+func (a *Authenticator) UserScope(ctx context.Context, userID string) error {
+	principal := PrincipalFrom(ctx)
+	if principal == nil || principal.Role == domain.RoleAdmin {
+		return nil
+	}
+	if principal.Role == domain.RoleTeamLead {
+		inTeam, err := a.storage.IsUserInTeam(userID, principal.TeamName)
+		if err != nil {
+			return err
+		}
+		if inTeam {
+			return nil
+		}
+	}
+	return &ScopeError{Message: "user is out of token scope"}
+}
+
+// RosterScope Руководитель импортирует только свою команду и не забирает участников других команд
+func (a *Authenticator) RosterScope(ctx context.Context, teams []domain.Team) error {
+	principal := PrincipalFrom(ctx)
+	if principal == nil || principal.Role == domain.RoleAdmin {
+		return nil
+	}
+	for _, team := range teams {
+		if err := a.TeamScope(ctx, team.Name); err != nil {
+			return err
+		}
+		for _, user := range team.Users {
+			userTeam, err := a.storage.GetUserTeamByID(user.ID)
+			if errors.Is(err, storage.ErrTeamNotFound) {
+				continue
+			}
+			if err != nil {
+				return err
+			}
+			if userTeam != principal.TeamName {
+				return &ScopeError{Message: "user is out of token scope"}
+			}
+		}
+	}
+	return nil
+}
+
+// ReviewScope Токен роли user меняет только свои назначения, руководитель - назначения в PR своей команды
+func (a *Authenticator) ReviewScope(ctx context.Context, prID, reviewerID string) error {
+	principal := PrincipalFrom(ctx)
+	if principal == nil || principal.Role == domain.RoleAdmin || principal.UserID == reviewerID {
+		return nil
+	}
+	if principal.Role == domain.RoleTeamLead {
+		inTeam, err := a.storage.IsPRInTeam(prID, principal.TeamName)
+		if err != nil {
+			return err
+		}
+		if inTeam {
+			return nil
+		}
+	}
+	return &ScopeError{Message: "review is out of token scope"}
+}
+
+// AuthorScope Токен роли user создаёт PR только от своего имени, руководитель - от имени участников своей команды
+func (a *Authenticator) AuthorScope(ctx context.Context, authorID string) error {
+	principal := PrincipalFrom(ctx)
+	if principal == nil || principal.Role == domain.RoleAdmin || principal.UserID == authorID {
+		return nil
+	}
+	if principal.Role == domain.RoleTeamLead {
+		inTeam, err := a.storage.IsUserInTeam(authorID, principal.TeamName)
+		if err != nil {
+			return err
+		}
+		if inTeam {
+			return nil
+		}
+	}
+	return &ScopeError{Message: "author is out of token scope"}
+}
+
+// NewToken Случайный токен
+func NewToken() (string, error) {
+	buf := make([]byte, tokenRandomSize)
+	if _, err := rand.Read(buf); err != nil {
+		return "", err
+	}
+	return tokenPrefix + hex.EncodeToString(buf), nil
+}
+
+// HashToken Хеш токена для хранения и поиска
+func HashToken(token string) string {
+	sum := sha256.Sum256([]byte(token))
+	return hex.EncodeToString(sum[:])
+}
@@ -0,0 +1,256 @@
+package auth
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/sudo-odner/Backend-trainee-assignment-avito-2025/internal/domain"
+	"github.com/sudo-odner/Backend-trainee-assignment-avito-2025/internal/storage"
+	"github.com/sudo-odner/Backend-trainee-assignment-avito-2025/internal/storage/storagetest"
+)
+
+var (
+	admin    = &domain.Principal{Name: "config", Role: domain.RoleAdmin}
+	user     = &domain.Principal{Name: "alice", Role: domain.RoleUser, UserID: "u1"}
+	teamLead = &domain.Principal{Name: "lead", Role: domain.RoleTeamLead, UserID: "u2", TeamName: "backend"}
+)
+
+// checkScope Ошибка области: nil, ScopeError или другая ошибка (тест падает)
+func checkScope(t *testing.T, err error, wantErr bool) {
+	t.Helper()
+	var scopeErr *ScopeError
+	if err != nil && !errors.As(err, &scopeErr) {
+		t.Fatalf("error = %v, want ScopeError", err)
+	}
+	if (err != nil) != wantErr {
+		t.Errorf("error = %v, wantErr %v", err, wantErr)
+	}
+}
+
+func TestAuthorScope(t *testing.T) {
+	tests := []struct {
+		name      string
+		principal *domain.Principal
+		authorID  string
+		wantErr   bool
+	}{
+		{name: "auth disabled", authorID: "u3"},
+		{name: "admin", principal: admin, authorID: "u3"},
+		{name: "user as self", principal: user, authorID: "u1"},
+		{name: "user as teammate", principal: user, authorID: "u2", wantErr: true},
+		{name: "team lead as self", principal: teamLead, authorID: "u2"},
+		{name: "team lead in team", principal: teamLead, authorID: "u1"},
+		{name: "team lead out of team", principal: teamLead, authorID: "u3", wantErr: true},
+	}
+	a := New(storagetest.New(), "")
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			checkScope(t, a.AuthorScope(principalContext(tt.principal), tt.authorID), tt.wantErr)
+		})
+	}
+}
+
+func TestAccessAllows(t *testing.T) {
+	tests := []struct {
+		access Access
+		role   string
+		want   bool
+	}{
+		{access: AccessAdmin, role: domain.RoleAdmin, want: true},
+		{access: AccessAdmin, role: domain.RoleTeamLead},
+		{access: AccessAdmin, role: domain.RoleUser},
+		{access: AccessTeamLead, role: domain.RoleAdmin, want: true},
+		{access: AccessTeamLead, role: domain.RoleTeamLead, want: true},
+		{access: AccessTeamLead, role: domain.RoleUser},
+		{access: AccessUser, role: domain.RoleAdmin, want: true},
+		{access: AccessUser, role: domain.RoleTeamLead, want: true},
+		{access: AccessUser, role: domain.RoleUser, want: true},
+	}
+	for _, tt := range tests {
+		if got := tt.access.Allows(tt.role); got != tt.want {
+			t.Errorf("Access(%d).Allows(%q) = %v, want %v", tt.access, tt.role, got, tt.want)
+		}
+	}
+}
+
+func TestTeamScope(t *testing.T) {
+	tests := []struct {
+		name      string
+		principal *domain.Principal
+		teamName  string
+		wantErr   bool
+	}{
+		{name: "auth disabled", teamName: "frontend"},
+		{name: "admin", principal: admin, teamName: "frontend"},
+		{name: "team lead own team", principal: teamLead, teamName: "backend"},
+		{name: "team lead other team", principal: teamLead, teamName: "frontend", wantErr: true},
+		{name: "team lead without team filter", principal: teamLead, wantErr: true},
+		{name: "user", principal: user, teamName: "backend", wantErr: true},
+	}
+	a := New(storagetest.New(), "")
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			checkScope(t, a.TeamScope(principalContext(tt.principal), tt.teamName), tt.wantErr)
+		})
+	}
+}
+
+func TestUserScope(t *testing.T) {
+	tests := []struct {
+		name      string
+		principal *domain.Principal
+		userID    string
+		wantErr   bool
+	}{
+		{name: "auth disabled", userID: "u3"},
+		{name: "admin", principal: admin, userID: "u3"},
+		{name: "team lead in team", principal: teamLead, userID: "u1"},
+		{name: "team lead out of team", principal: teamLead, userID: "u3", wantErr: true},
+		{name: "team lead unknown user", principal: teamLead, userID: "u9", wantErr: true},
+		// Активность меняют admin и руководитель, а не сам пользователь
+		{name: "user self", principal: user, userID: "u1", wantErr: true},
+		{name: "user other", principal: user, userID: "u3", wantErr: true},
+	}
+	a := New(storagetest.New(), "")
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			checkScope(t, a.UserScope(principalContext(tt.principal), tt.userID), tt.wantErr)
+		})
+	}
+}
+
+func TestRosterScope(t *testing.T) {
+	member := func(id string) domain.User { return domain.User{ID: id, IsActive: true} }
+
+	tests := []struct {
+		name      string
+		principal *domain.Principal
+		teams     []domain.Team
+		wantErr   bool
+	}{
+		{name: "auth disabled", teams: []domain.Team{{Name: "frontend", Users: []domain.User{member("u1")}}}},
+		{name: "admin moves users between teams", principal: admin,
+			teams: []domain.Team{{Name: "frontend", Users: []domain.User{member("u1")}}}},
+		{name: "team lead own team", principal: teamLead,
+			teams: []domain.Team{{Name: "backend", Users: []domain.User{member("u1"), member("u2")}}}},
+		{name: "team lead adds new user", principal: teamLead,
+			teams: []domain.Team{{Name: "backend", Users: []domain.User{member("u9")}}}},
+		{name: "team lead other team", principal: teamLead,
+			teams: []domain.Team{{Name: "frontend", Users: []domain.User{member("u3")}}}, wantErr: true},
+		{name: "team lead takes user of other team", principal: teamLead,
+			teams: []domain.Team{{Name: "backend", Users: []domain.User{member("u3")}}}, wantErr: true},
+		{name: "team lead one of teams is other", principal: teamLead,
+			teams: []domain.Team{{Name: "backend"}, {Name: "frontend"}}, wantErr: true},
+		{name: "user", principal: user, teams: []domain.Team{{Name: "backend"}}, wantErr: true},
+	}
+	a := New(storagetest.New(), "")
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			checkScope(t, a.RosterScope(principalContext(tt.principal), tt.teams), tt.wantErr)
+		})
+	}
+}
+
+func TestReviewScope(t *testing.T) {
+	tests := []struct {
+		name       string
+		principal  *domain.Principal
+		prID       string
+		reviewerID string
+		wantErr    bool
+	}{
+		{name: "auth disabled", prID: "pr-1", reviewerID: "u3"},
+		{name: "admin", principal: admin, prID: "pr-1", reviewerID: "u3"},
+		{name: "user own review", principal: user, prID: "pr-1", reviewerID: "u1"},
+		{name: "user other review in own team", principal: user, prID: "pr-2", reviewerID: "u2", wantErr: true},
+		{name: "team lead PR of team", principal: teamLead, prID: "pr-2", reviewerID: "u3"},
+		{name: "team lead own review in other team", principal: teamLead, prID: "pr-1", reviewerID: "u2"},
+		{name: "team lead PR of other team", principal: teamLead, prID: "pr-1", reviewerID: "u3", wantErr: true},
+	}
+	a := New(storagetest.New(), "")
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			checkScope(t, a.ReviewScope(principalContext(tt.principal), tt.prID, tt.reviewerID), tt.wantErr)
+		})
+	}
+}
+
+func TestScopeStorageError(t *testing.T) {
+	errStorage := errors.New("storage unavailable")
+	a := New(failingStorage{err: errStorage}, "")
+	ctx := principalContext(teamLead)
+
+	// Ошибка хранилища не выдаётся за отказ в доступе
+	for name, err := range map[string]error{
+		"UserScope":   a.UserScope(ctx, "u1"),
+		"AuthorScope": a.AuthorScope(ctx, "u1"),
+		"ReviewScope": a.ReviewScope(ctx, "pr-1", "u1"),
+		"RosterScope": a.RosterScope(ctx, []domain.Team{{Name: "backend", Users: []domain.User{{ID: "u1"}}}}),
+	} {
+		if !errors.Is(err, errStorage) {
+			t.Errorf("%s() error = %v, want storage error", name, err)
+		}
+	}
+}
+
+// failingStorage Хранилище, все запросы к которому завершаются ошибкой
+type failingStorage struct {
+	err error
+}
+
+func (s failingStorage) GetAPITokenByHash(_ string) (*domain.APIToken, error) { return nil, s.err }
+func (s failingStorage) IsUserInTeam(_, _ string) (bool, error)               { return false, s.err }
+func (s failingStorage) IsPRInTeam(_, _ string) (bool, error)                 { return false, s.err }
+func (s failingStorage) GetUserTeamByID(_ string) (string, error)             { return "", s.err }
+
+func TestAuthenticate(t *testing.T) {
+	a := New(storagetest.New(), "admin-token")
+
+	principal, err := a.Authenticate("admin-token")
+	if err != nil || principal.Role != domain.RoleAdmin {
+		t.Errorf("Authenticate(admin token) = %+v, %v, want admin", principal, err)
+	}
+	for _, token := range []string{"", "other-token"} {
+		if _, err := a.Authenticate(token); !errors.Is(err, storage.ErrTokenNotFound) {
+			t.Errorf("Authenticate(%q) error = %v, want ErrTokenNotFound", token, err)
+		}
+	}
+	// Без admin_token в конфиге пустой токен не становится токеном администратора
+	if _, err := New(storagetest.New(), "").Authenticate(""); !errors.Is(err, storage.ErrTokenNotFound) {
+		t.Errorf("Authenticate(\"\") without admin token error = %v, want ErrTokenNotFound", err)
+	}
+}
+
+func TestChangeMeta(t *testing.T) {
+	tests := []struct {
+		name      string
+		principal *domain.Principal
+		actor     string
+		want      string
+	}{
+		{name: "auth disabled with actor", actor: "bob", want: "bob"},
+		{name: "auth disabled", want: "anonymous"},
+		{name: "admin with actor", principal: admin, actor: "bob", want: "bob"},
+		{name: "admin", principal: admin, want: "config"},
+		// Токен не-admin не может выдать себя за другого инициатора
+		{name: "user with actor", principal: user, actor: "bob", want: "u1"},
+		{name: "team lead", principal: teamLead, want: "u2"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := ChangeMeta(principalContext(tt.principal), tt.actor); got.Actor != tt.want {
+				t.Errorf("ChangeMeta() actor = %q, want %q", got.Actor, tt.want)
+			}
+		})
+	}
+}
+
+// principalContext Контекст с владельцем токена (nil - аутентификация отключена)
+func principalContext(principal *domain.Principal) context.Context {
+	ctx := context.Background()
+	if principal != nil {
+		ctx = WithPrincipal(ctx, principal)
+	}
+	return ctx
+}
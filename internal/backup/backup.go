@@ -0,0 +1,188 @@
+package backup
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"time"
+
+	"github.com/sudo-odner/Backend-trainee-assignment-avito-2025/internal/domain"
+)
+
+// Version Версия формата архива (увеличивается при несовместимых изменениях)
+const Version = 1
+
+// Archive Резервная копия данных сервиса в JSON
+type Archive struct {
+	Version      int               `json:"version"`
+	CreatedAt    time.Time         `json:"created_at"`
+	Users        []archiveUser     `json:"users"`
+	Teams        []archiveTeam     `json:"teams"`
+	PullRequests []archivePR       `json:"pull_requests"`
+	Reviewers    []archiveReviewer `json:"reviewers"`
+}
+
+type archiveUser struct {
+	UserID   string `json:"user_id"`
+	Username string `json:"username"`
+	IsActive bool   `json:"is_active"`
+}
+
+type archiveTeam struct {
+	TeamName string   `json:"team_name"`
+	Members  []string `json:"members"`
+}
+
+type archivePR struct {
+	PullRequestID   string     `json:"pull_request_id"`
+	PullRequestName string     `json:"pull_request_name"`
+	AuthorID        string     `json:"author_id"`
+	Status          string     `json:"status"`
+	CreatedAt       *time.Time `json:"created_at"`
+	MergedAt        *time.Time `json:"merged_at"`
+}
+
+type archiveReviewer struct {
+	PullRequestID string     `json:"pull_request_id"`
+	ReviewerID    string     `json:"reviewer_id"`
+	AssignedAt    time.Time  `json:"assigned_at"`
+	DueAt         *time.Time `json:"due_at"`
+	OverdueAt     *time.Time `json:"overdue_at"`
+}
+
+// Write Запись среза данных в архив
+func Write(w io.Writer, snapshot *domain.Snapshot, createdAt time.Time) error {
+	archive := Archive{
+		Version:      Version,
+		CreatedAt:    createdAt,
+		Users:        make([]archiveUser, 0, len(snapshot.Users)),
+		Teams:        make([]archiveTeam, 0, len(snapshot.Teams)),
+		PullRequests: make([]archivePR, 0, len(snapshot.PullRequests)),
+		Reviewers:    make([]archiveReviewer, 0, len(snapshot.Reviewers)),
+	}
+	for _, user := range snapshot.Users {
+		archive.Users = append(archive.Users, archiveUser{UserID: user.ID, Username: user.Name, IsActive: user.IsActive})
+	}
+	for _, team := range snapshot.Teams {
+		members := make([]string, 0, len(team.Users))
+		for _, user := range team.Users {
+			members = append(members, user.ID)
+		}
+		archive.Teams = append(archive.Teams, archiveTeam{TeamName: team.Name, Members: members})
+	}
+	for _, pr := range snapshot.PullRequests {
+		archive.PullRequests = append(archive.PullRequests, archivePR{
+			PullRequestID:   pr.ID,
+			PullRequestName: pr.Name,
+			AuthorID:        pr.Author.ID,
+			Status:          pr.Status,
+			CreatedAt:       optionalTime(pr.CreatedAt),
+			MergedAt:        optionalTime(pr.MergedAt),
+		})
+	}
+	for _, review := range snapshot.Reviewers {
+		archive.Reviewers = append(archive.Reviewers, archiveReviewer{
+			PullRequestID: review.PullRequestID,
+			ReviewerID:    review.ReviewerID,
+			AssignedAt:    review.AssignedAt,
+			DueAt:         optionalTime(review.DueAt),
+			OverdueAt:     optionalTime(review.OverdueAt),
+		})
+	}
+
+	encoder := json.NewEncoder(w)
+	encoder.SetIndent("", "  ")
+	return encoder.Encode(archive)
+}
+
+// Read Чтение архива с проверкой версии и ссылок между сущностями
+func Read(r io.Reader) (*domain.Snapshot, error) {
+	var archive Archive
+	decoder := json.NewDecoder(r)
+	decoder.DisallowUnknownFields()
+	if err := decoder.Decode(&archive); err != nil {
+		return nil, fmt.Errorf("decode archive: %w", err)
+	}
+	if archive.Version != Version {
+		return nil, fmt.Errorf("unsupported archive version %d (expected %d)", archive.Version, Version)
+	}
+
+	snapshot := &domain.Snapshot{
+		Users:        make([]domain.User, 0, len(archive.Users)),
+		Teams:        make([]domain.Team, 0, len(archive.Teams)),
+		PullRequests: make([]domain.PullRequest, 0, len(archive.PullRequests)),
+		Reviewers:    make([]domain.ReviewAssignment, 0, len(archive.Reviewers)),
+	}
+	users := make(map[string]bool, len(archive.Users))
+	for _, user := range archive.Users {
+		if user.UserID == "" || users[user.UserID] {
+			return nil, fmt.Errorf("invalid archive: empty or duplicated user %q", user.UserID)
+		}
+		users[user.UserID] = true
+		snapshot.Users = append(snapshot.Users, domain.User{ID: user.UserID, Name: user.Username, IsActive: user.IsActive})
+	}
+	teams := make(map[string]bool, len(archive.Teams))
+	for _, team := range archive.Teams {
+		if team.TeamName == "" || teams[team.TeamName] {
+			return nil, fmt.Errorf("invalid archive: empty or duplicated team %q", team.TeamName)
+		}
+		teams[team.TeamName] = true
+		members := make([]domain.User, 0, len(team.Members))
+		for _, userID := range team.Members {
+			if !users[userID] {
+				return nil, fmt.Errorf("invalid archive: team %s: unknown member %s", team.TeamName, userID)
+			}
+			members = append(members, domain.User{ID: userID})
+		}
+		snapshot.Teams = append(snapshot.Teams, domain.Team{Name: team.TeamName, Users: members})
+	}
+	prs := make(map[string]bool, len(archive.PullRequests))
+	for _, pr := range archive.PullRequests {
+		if pr.PullRequestID == "" || prs[pr.PullRequestID] {
+			return nil, fmt.Errorf("invalid archive: empty or duplicated pull request %q", pr.PullRequestID)
+		}
+		if !users[pr.AuthorID] {
+			return nil, fmt.Errorf("invalid archive: pull request %s: unknown author %s", pr.PullRequestID, pr.AuthorID)
+		}
+		if pr.Status != "OPEN" && pr.Status != "MERGED" {
+			return nil, fmt.Errorf("invalid archive: pull request %s: unknown status %q", pr.PullRequestID, pr.Status)
+		}
+		prs[pr.PullRequestID] = true
+		snapshot.PullRequests = append(snapshot.PullRequests, domain.PullRequest{
+			ID:        pr.PullRequestID,
+			Name:      pr.PullRequestName,
+			Author:    domain.User{ID: pr.AuthorID},
+			Status:    pr.Status,
+			CreatedAt: timeValue(pr.CreatedAt),
+			MergedAt:  timeValue(pr.MergedAt),
+		})
+	}
+	for _, review := range archive.Reviewers {
+		if !prs[review.PullRequestID] || !users[review.ReviewerID] {
+			return nil, fmt.Errorf("invalid archive: reviewer %s of %s: unknown pull request or user",
+				review.ReviewerID, review.PullRequestID)
+		}
+		snapshot.Reviewers = append(snapshot.Reviewers, domain.ReviewAssignment{
+			PullRequestID: review.PullRequestID,
+			ReviewerID:    review.ReviewerID,
+			AssignedAt:    review.AssignedAt,
+			DueAt:         timeValue(review.DueAt),
+			OverdueAt:     timeValue(review.OverdueAt),
+		})
+	}
+	return snapshot, nil
+}
+
+func optionalTime(t time.Time) *time.Time {
+	if t.IsZero() {
+		return nil
+	}
+	return &t
+}
+
+func timeValue(t *time.Time) time.Time {
+	if t == nil {
+		return time.Time{}
+	}
+	return *t
+}
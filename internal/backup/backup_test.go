@@ -0,0 +1,107 @@
+package backup
+
+import (
+	"bytes"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/sudo-odner/Backend-trainee-assignment-avito-2025/internal/domain"
+)
+
+func TestWriteRead(t *testing.T) {
+	created := time.Date(2025, 11, 3, 9, 0, 0, 0, time.UTC)
+	merged := created.Add(26 * time.Hour)
+	snapshot := &domain.Snapshot{
+		Users: []domain.User{
+			{ID: "u1", Name: "Alice", IsActive: true},
+			{ID: "u2", Name: "Bob", IsActive: false},
+		},
+		Teams: []domain.Team{{Name: "backend", Users: []domain.User{{ID: "u1"}, {ID: "u2"}}}},
+		PullRequests: []domain.PullRequest{
+			{ID: "pr-1", Name: "Add search", Author: domain.User{ID: "u1"}, Status: "MERGED", CreatedAt: created, MergedAt: merged},
+			{ID: "pr-2", Name: "Legacy", Author: domain.User{ID: "u2"}, Status: "OPEN"},
+		},
+		Reviewers: []domain.ReviewAssignment{
+			{PullRequestID: "pr-1", ReviewerID: "u2", AssignedAt: created, DueAt: created.Add(24 * time.Hour)},
+		},
+	}
+
+	var buf bytes.Buffer
+	if err := Write(&buf, snapshot, created); err != nil {
+		t.Fatalf("Write() error = %v", err)
+	}
+	got, err := Read(&buf)
+	if err != nil {
+		t.Fatalf("Read() error = %v", err)
+	}
+	if !reflect.DeepEqual(got, snapshot) {
+		t.Errorf("Read(Write()) = %+v, want %+v", got, snapshot)
+	}
+}
+
+func TestReadInvalid(t *testing.T) {
+	const users = `"users":[{"user_id":"u1","username":"Alice","is_active":true}]`
+	tests := []struct {
+		name    string
+		archive string
+		wantErr string
+	}{
+		{name: "not json", archive: `{"version":`, wantErr: "decode archive"},
+		{name: "unknown field", archive: `{"version":1,"owner":"me"}`, wantErr: "decode archive"},
+		{name: "other version", archive: `{"version":2}`, wantErr: "unsupported archive version 2"},
+		{
+			name:    "duplicated user",
+			archive: `{"version":1,"users":[{"user_id":"u1"},{"user_id":"u1"}]}`,
+			wantErr: `duplicated user "u1"`,
+		},
+		{
+			name:    "empty user id",
+			archive: `{"version":1,"users":[{"user_id":""}]}`,
+			wantErr: `empty or duplicated user ""`,
+		},
+		{
+			name:    "duplicated team",
+			archive: `{"version":1,` + users + `,"teams":[{"team_name":"a","members":[]},{"team_name":"a","members":[]}]}`,
+			wantErr: `duplicated team "a"`,
+		},
+		{
+			name:    "unknown member",
+			archive: `{"version":1,` + users + `,"teams":[{"team_name":"a","members":["u2"]}]}`,
+			wantErr: "team a: unknown member u2",
+		},
+		{
+			name:    "unknown author",
+			archive: `{"version":1,` + users + `,"pull_requests":[{"pull_request_id":"pr-1","author_id":"u2","status":"OPEN"}]}`,
+			wantErr: "pull request pr-1: unknown author u2",
+		},
+		{
+			name:    "unknown status",
+			archive: `{"version":1,` + users + `,"pull_requests":[{"pull_request_id":"pr-1","author_id":"u1","status":"CLOSED"}]}`,
+			wantErr: `pull request pr-1: unknown status "CLOSED"`,
+		},
+		{
+			name: "duplicated pull request",
+			archive: `{"version":1,` + users + `,"pull_requests":[` +
+				`{"pull_request_id":"pr-1","author_id":"u1","status":"OPEN"},` +
+				`{"pull_request_id":"pr-1","author_id":"u1","status":"OPEN"}]}`,
+			wantErr: `duplicated pull request "pr-1"`,
+		},
+		{
+			name: "reviewer of unknown pull request",
+			archive: `{"version":1,` + users + `,"reviewers":[` +
+				`{"pull_request_id":"pr-9","reviewer_id":"u1","assigned_at":"2025-11-03T09:00:00Z"}]}`,
+			wantErr: "reviewer u1 of pr-9: unknown pull request or user",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := Read(strings.NewReader(tt.archive))
+			if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+				t.Errorf("Read() error = %v, want containing %q", err, tt.wantErr)
+			}
+		})
+	}
+}
@@ -1,8 +1,10 @@
 package config
 
 import (
+	"errors"
 	"log"
 	"os"
+	"time"
 
 	"github.com/ilyakaznacheev/cleanenv"
 )
@@ -22,6 +24,89 @@ type Config struct {
 		Host string `yaml:"host"`
 		Port string `yaml:"port"`
 	} `yaml:"http_server"`
+	GRPCServer struct {
+		Enabled bool   `yaml:"enabled"`
+		Host    string `yaml:"host"`
+		Port    string `yaml:"port"`
+		// Как часто поток событий PR проверяет новые события
+		EventPollInterval time.Duration `yaml:"event_poll_interval" env-default:"1s"`
+	} `yaml:"grpc_server"`
+	Auth struct {
+		Enabled bool `yaml:"enabled"`
+		// Токен администратора из конфига (для выпуска первых токенов)
+		AdminToken string `yaml:"admin_token" env:"ADMIN_TOKEN"`
+	} `yaml:"auth"`
+	RateLimit struct {
+		Enabled bool `yaml:"enabled"`
+		// Лимит на IP до проверки токена (в том числе для запросов с неверным токеном)
+		IP RateLimit `yaml:"ip"`
+		// Лимит на клиента (токен или IP) для маршрутов без своего лимита
+		Default RateLimit `yaml:"default"`
+		// Лимиты отдельных маршрутов по "METHOD /path"
+		Routes  map[string]RateLimit `yaml:"routes"`
+		IdleTTL time.Duration        `yaml:"idle_ttl" env-default:"10m"`
+	} `yaml:"rate_limit"`
+	GraphQL struct {
+		Enabled bool `yaml:"enabled"`
+		// Ограничения запроса: глубина вложенности полей и стоимость (поле - 1, список умножает стоимость вложенных полей на limit)
+		MaxDepth      int `yaml:"max_depth" env-default:"8"`
+		MaxComplexity int `yaml:"max_complexity" env-default:"5000"`
+	} `yaml:"graphql"`
+	OpenAPI struct {
+		// Проверка запросов по task/openapi.yml до обработчиков
+		ValidateRequests bool `yaml:"validate_requests"`
+	} `yaml:"openapi"`
+	ReviewSLA struct {
+		Enabled bool `yaml:"enabled"`
+		// SLA по умолчанию и для отдельных команд (в рабочих часах)
+		DefaultHours  int            `yaml:"default_hours" env-default:"24"`
+		TeamHours     map[string]int `yaml:"team_hours"`
+		BusinessHours struct {
+			Start    int    `yaml:"start" env-default:"10"`
+			End      int    `yaml:"end" env-default:"19"`
+			Workdays []int  `yaml:"workdays"`
+			Timezone string `yaml:"timezone" env-default:"UTC"`
+		} `yaml:"business_hours"`
+		CheckInterval time.Duration `yaml:"check_interval" env-default:"1m"`
+		// Через сколько после срока ревью переназначается (0 - не переназначать)
+		ReassignAfter time.Duration `yaml:"reassign_after"`
+	} `yaml:"review_sla"`
+	Webhooks struct {
+		Enabled      bool          `yaml:"enabled"`
+		PollInterval time.Duration `yaml:"poll_interval" env-default:"2s"`
+		Timeout      time.Duration `yaml:"timeout" env-default:"5s"`
+		MaxAttempts  int           `yaml:"max_attempts" env-default:"8"`
+		BaseBackoff  time.Duration `yaml:"base_backoff" env-default:"5s"`
+		MaxBackoff   time.Duration `yaml:"max_backoff" env-default:"10m"`
+		BatchSize    int           `yaml:"batch_size" env-default:"100"`
+	} `yaml:"webhooks"`
+	Idempotency struct {
+		// Сколько хранится ответ для повторов с тем же Idempotency-Key
+		TTL             time.Duration `yaml:"ttl" env-default:"24h"`
+		CleanupInterval time.Duration `yaml:"cleanup_interval" env-default:"10m"`
+	} `yaml:"idempotency"`
+	Integrations struct {
+		GitHub struct {
+			Enabled bool   `yaml:"enabled"`
+			Secret  string `yaml:"secret" env:"GITHUB_WEBHOOK_SECRET"`
+			// Соответствие логинов GitHub и id пользователей сервиса
+			Users map[string]string `yaml:"users"`
+		} `yaml:"github"`
+		GitLab struct {
+			Enabled bool   `yaml:"enabled"`
+			Token   string `yaml:"token" env:"GITLAB_WEBHOOK_TOKEN"`
+			// Соответствие username GitLab и id пользователей сервиса
+			Users map[string]string `yaml:"users"`
+			// Соответствие id пользователей GitLab и id пользователей сервиса (автор при переоткрытии MR)
+			UserIDs map[int]string `yaml:"user_ids"`
+		} `yaml:"gitlab"`
+	} `yaml:"integrations"`
+}
+
+// RateLimit Token bucket: пополнение в запросах в секунду и ёмкость
+type RateLimit struct {
+	RPS   float64 `yaml:"rps"`
+	Burst int     `yaml:"burst"`
 }
 
 func MustLoad(configPath string) *Config {
@@ -34,6 +119,21 @@ func MustLoad(configPath string) *Config {
 	if err := cleanenv.ReadConfig(configPath, &cfg); err != nil {
 		log.Fatal(err)
 	}
+	if err := cfg.validate(); err != nil {
+		log.Fatal(err)
+	}
 
 	return &cfg
 }
+
+// validate Проверка настроек, без которых сервис нельзя запускать
+func (cfg *Config) validate() error {
+	// Без секрета подпись GitHub может посчитать, а пустой токен GitLab передать кто угодно
+	if cfg.Integrations.GitHub.Enabled && cfg.Integrations.GitHub.Secret == "" {
+		return errors.New("integrations.github is enabled without secret (GITHUB_WEBHOOK_SECRET)")
+	}
+	if cfg.Integrations.GitLab.Enabled && cfg.Integrations.GitLab.Token == "" {
+		return errors.New("integrations.gitlab is enabled without token (GITLAB_WEBHOOK_TOKEN)")
+	}
+	return nil
+}
@@ -0,0 +1,31 @@
+package config
+
+import "testing"
+
+func TestValidateIntegrationSecret(t *testing.T) {
+	tests := []struct {
+		name    string
+		github  bool
+		secret  string
+		gitlab  bool
+		token   string
+		wantErr bool
+	}{
+		{name: "disabled without secrets"},
+		{name: "enabled with secrets", github: true, secret: "secret", gitlab: true, token: "token"},
+		{name: "github without secret", github: true, wantErr: true},
+		{name: "gitlab without token", gitlab: true, wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var cfg Config
+			cfg.Integrations.GitHub.Enabled = tt.github
+			cfg.Integrations.GitHub.Secret = tt.secret
+			cfg.Integrations.GitLab.Enabled = tt.gitlab
+			cfg.Integrations.GitLab.Token = tt.token
+			if err := cfg.validate(); (err != nil) != tt.wantErr {
+				t.Errorf("validate() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
@@ -19,10 +19,271 @@ type PullRequest struct {
 	Author    User
 	Status    string
 	Reviewers []User
+	CreatedAt time.Time
 	MergedAt  time.Time
+	// Версия PR, увеличивается при изменении reviewer или статуса
+	Version int64
 }
 
 type UserReviewStat struct {
 	UserID      string
 	ReviewCount int
 }
+
+type ReviewerDecline struct {
+	PullRequestID string
+	ReviewerID    string
+	Reason        string
+	ReplacedBy    string
+	DeclinedAt    time.Time
+}
+
+type ReviewAssignment struct {
+	PullRequestID   string
+	PullRequestName string
+	AuthorID        string
+	ReviewerID      string
+	TeamName        string
+	AssignedAt      time.Time
+	DueAt           time.Time
+	OverdueAt       time.Time
+}
+
+type OverdueFilter struct {
+	DueBefore  time.Time
+	TeamName   string
+	ReviewerID string
+}
+
+// Типы доменных событий (outbox)
+const (
+	EventReviewersAssigned  = "reviewers.assigned"
+	EventReviewerReassigned = "reviewer.reassigned"
+	EventReviewerDeclined   = "reviewer.declined"
+	EventPRMerged           = "pull_request.merged"
+	EventTeamDeactivated    = "team.deactivated"
+)
+
+type Webhook struct {
+	ID         int64
+	URL        string
+	Secret     string
+	EventTypes []string
+	IsActive   bool
+	CreatedAt  time.Time
+}
+
+type WebhookDelivery struct {
+	ID            int64
+	WebhookID     int64
+	URL           string
+	Secret        string
+	EventID       int64
+	EventType     string
+	Payload       []byte
+	OccurredAt    time.Time
+	Status        string
+	Attempts      int
+	NextAttemptAt time.Time
+	LastError     string
+	UpdatedAt     time.Time
+}
+
+// ChangeMeta Инициатор и причина изменения (для аудита)
+type ChangeMeta struct {
+	Actor  string
+	Reason string
+	// Ожидаемая версия PR (If-Match), 0 - без проверки
+	IfVersion int64
+}
+
+// Типы событий аудита
+const (
+	AuditTeamCreated        = "team.created"
+	AuditPRCreated          = "pr.created"
+	AuditReviewerAssigned   = "reviewer.assigned"
+	AuditReviewerReassigned = "reviewer.reassigned"
+	AuditReviewerDeclined   = "reviewer.declined"
+	AuditReviewerRemoved    = "reviewer.removed"
+	AuditUserActivated      = "user.activated"
+	AuditUserDeactivated    = "user.deactivated"
+	AuditPRMerged           = "pr.merged"
+	AuditTeamImported       = "team.imported"
+	AuditBackupRestored     = "backup.restored"
+)
+
+type AuditEvent struct {
+	ID            int64
+	Type          string
+	PullRequestID string
+	UserIDs       []string
+	TeamName      string
+	Actor         string
+	Reason        string
+	Before        map[string]any
+	After         map[string]any
+	CreatedAt     time.Time
+}
+
+type AuditFilter struct {
+	PullRequestID string
+	UserID        string
+	TeamName      string
+	From          time.Time
+	To            time.Time
+	AfterID       int64
+	Limit         int
+	// Типы событий (пусто - все)
+	Types []string
+}
+
+// StatFilter Фильтры статистики: окно времени [From, To), команда и статус PR
+type StatFilter struct {
+	From     time.Time
+	To       time.Time
+	TeamName string
+	Status   string
+}
+
+type TeamStat struct {
+	TeamName           string
+	Members            int
+	ActiveMembers      int
+	PullRequests       int
+	OpenPullRequests   int
+	MergedPullRequests int
+	ReviewAssignments  int
+	AvgReviewersPerPR  float64
+}
+
+type PRStat struct {
+	PullRequests       int
+	OpenPullRequests   int
+	MergedPullRequests int
+	AvgReviewersPerPR  float64
+	// Время до merge (перцентили), только для PR с известным временем создания
+	TimeToMergeP50 time.Duration
+	TimeToMergeP90 time.Duration
+	TimeToMergeP99 time.Duration
+}
+
+// UserLoad Нагрузка ревью на пользователя
+type UserLoad struct {
+	UserID      string
+	ReviewCount int
+}
+
+// TeamLoad Нагрузка ревью на активных участников команды
+type TeamLoad struct {
+	TeamName string
+	Members  []UserLoad
+}
+
+// RebalanceMove Перенос ревью PR от одного reviewer к другому
+type RebalanceMove struct {
+	PullRequestID  string
+	FromReviewerID string
+	ToReviewerID   string
+}
+
+// PRCursor Позиция в списке PR для постраничной выдачи (значение ключа сортировки и id)
+type PRCursor struct {
+	SortAt time.Time
+	ID     string
+}
+
+// Поля сортировки списка PR
+const (
+	PRSortCreatedAt = "created_at"
+	PRSortMergedAt  = "merged_at"
+)
+
+// PRFilter Фильтры списка PR с сортировкой по времени создания или merge
+type PRFilter struct {
+	AuthorID   string
+	ReviewerID string
+	TeamName   string
+	Status     string
+	// Подстрока в названии PR (без учёта регистра)
+	NameQuery string
+	// Диапазоны времени [From, To)
+	CreatedFrom time.Time
+	CreatedTo   time.Time
+	MergedFrom  time.Time
+	MergedTo    time.Time
+	// Поле сортировки (по умолчанию created_at) и направление
+	SortBy string
+	Desc   bool
+	After  *PRCursor
+	Limit  int
+}
+
+// Роль пользователя в PR
+const (
+	PRRoleReviewer = "reviewer"
+	PRRoleAuthor   = "author"
+)
+
+// UsersPRFilter Последние PR сразу нескольких пользователей: не больше Limit PR на пользователя
+type UsersPRFilter struct {
+	UserIDs []string
+	// Роль пользователей в PR: PRRoleReviewer или PRRoleAuthor
+	Role   string
+	Status string
+	Limit  int
+}
+
+// ImportSummary Итог импорта состава команд (id команд и пользователей)
+type ImportSummary struct {
+	CreatedTeams []string
+	Created      []string
+	Updated      []string
+	Moved        []string
+	Unchanged    []string
+}
+
+// Snapshot Полный срез данных: пользователи, команды (участники - только id),
+// PR (автор - только id) и назначения reviewer
+type Snapshot struct {
+	Users        []User
+	Teams        []Team
+	PullRequests []PullRequest
+	Reviewers    []ReviewAssignment
+}
+
+// IdempotentResponse Сохранённый ответ на запрос с Idempotency-Key
+type IdempotentResponse struct {
+	BodyHash    string
+	StatusCode  int
+	ContentType string
+	Body        []byte
+	// Первый запрос ещё обрабатывается
+	InProgress bool
+}
+
+// Роли API токенов
+const (
+	RoleAdmin = "admin"
+	RoleUser  = "user"
+	// RoleTeamLead Управляет только своей командой
+	RoleTeamLead = "team_lead"
+)
+
+type APIToken struct {
+	ID        int64
+	Name      string
+	Role      string
+	UserID    string
+	TeamName  string
+	CreatedAt time.Time
+	RevokedAt time.Time
+}
+
+// Principal Аутентифицированный клиент запроса
+type Principal struct {
+	TokenID  int64
+	Name     string
+	Role     string
+	UserID   string
+	TeamName string
+}
@@ -0,0 +1,129 @@
+package fairness
+
+import (
+	"math"
+	"slices"
+	"sort"
+
+	"github.com/sudo-odner/Backend-trainee-assignment-avito-2025/internal/domain"
+)
+
+// Metrics Метрики распределения ревью между участниками команды
+type Metrics struct {
+	Min    int
+	Max    int
+	Mean   float64
+	StdDev float64
+	// Коэффициент Джини: 0 - нагрузка распределена поровну, ближе к 1 - на одном человеке
+	Gini float64
+}
+
+// Compute Расчёт метрик по количеству ревью участников
+func Compute(counts []int) Metrics {
+	if len(counts) == 0 {
+		return Metrics{}
+	}
+	sorted := slices.Clone(counts)
+	slices.Sort(sorted)
+
+	n := float64(len(sorted))
+	sum := 0
+	for _, count := range sorted {
+		sum += count
+	}
+	mean := float64(sum) / n
+
+	variance := 0.0
+	for _, count := range sorted {
+		variance += (float64(count) - mean) * (float64(count) - mean)
+	}
+
+	// G = sum((2i - n - 1) * x_i) / (n * sum(x)) для отсортированных x_i, i = 1..n
+	gini := 0.0
+	if sum > 0 {
+		weighted := 0.0
+		for i, count := range sorted {
+			weighted += float64(2*(i+1)-len(sorted)-1) * float64(count)
+		}
+		gini = weighted / (n * float64(sum))
+	}
+
+	return Metrics{
+		Min:    sorted[0],
+		Max:    sorted[len(sorted)-1],
+		Mean:   mean,
+		StdDev: math.Sqrt(variance / n),
+		Gini:   gini,
+	}
+}
+
+// Review Открытый PR, в котором участвуют reviewer из команды
+type Review struct {
+	PullRequestID string
+	AuthorID      string
+	Reviewers     []string
+}
+
+// Plan План переноса ревью от самых загруженных участников к наименее загруженным.
+// Ревью переносится, только если разница в нагрузке после переноса уменьшается
+// (новый reviewer не автор PR и ещё не назначен на него)
+func Plan(members []domain.UserLoad, reviews []Review) []domain.RebalanceMove {
+	loads := make(map[string]int, len(members))
+	for _, member := range members {
+		loads[member.UserID] = member.ReviewCount
+	}
+	// Копия reviewer по PR, план применяется последовательно
+	prReviewers := make([][]string, len(reviews))
+	for i, review := range reviews {
+		prReviewers[i] = slices.Clone(review.Reviewers)
+	}
+
+	moves := make([]domain.RebalanceMove, 0)
+	for {
+		order := make([]string, 0, len(loads))
+		for userID := range loads {
+			order = append(order, userID)
+		}
+		sort.Slice(order, func(i, j int) bool {
+			if loads[order[i]] != loads[order[j]] {
+				return loads[order[i]] < loads[order[j]]
+			}
+			return order[i] < order[j]
+		})
+
+		move, ok := nextMove(order, loads, reviews, prReviewers)
+		if !ok {
+			return moves
+		}
+		moves = append(moves, move)
+	}
+}
+
+// nextMove Поиск одного переноса, начиная с самого загруженного участника
+func nextMove(order []string, loads map[string]int, reviews []Review, prReviewers [][]string) (domain.RebalanceMove, bool) {
+	for from := len(order) - 1; from > 0; from-- {
+		fromID := order[from]
+		for i, review := range reviews {
+			if !slices.Contains(prReviewers[i], fromID) {
+				continue
+			}
+			for _, toID := range order[:from] {
+				if loads[fromID]-loads[toID] < 2 {
+					break
+				}
+				if toID == review.AuthorID || slices.Contains(prReviewers[i], toID) {
+					continue
+				}
+				prReviewers[i][slices.Index(prReviewers[i], fromID)] = toID
+				loads[fromID]--
+				loads[toID]++
+				return domain.RebalanceMove{
+					PullRequestID:  review.PullRequestID,
+					FromReviewerID: fromID,
+					ToReviewerID:   toID,
+				}, true
+			}
+		}
+	}
+	return domain.RebalanceMove{}, false
+}
@@ -0,0 +1,197 @@
+package fairness
+
+import (
+	"fmt"
+	"math"
+	"testing"
+
+	"github.com/sudo-odner/Backend-trainee-assignment-avito-2025/internal/domain"
+)
+
+func TestCompute(t *testing.T) {
+	tests := []struct {
+		name   string
+		counts []int
+		want   Metrics
+	}{
+		{name: "no members", counts: nil, want: Metrics{}},
+		{name: "single reviewer", counts: []int{5}, want: Metrics{Min: 5, Max: 5, Mean: 5}},
+		{name: "all zeros", counts: []int{0, 0, 0}, want: Metrics{}},
+		{name: "equal load", counts: []int{3, 3, 3, 3}, want: Metrics{Min: 3, Max: 3, Mean: 3}},
+		{
+			// Вся нагрузка на одном из n: G = (n-1)/n
+			name:   "all on one reviewer",
+			counts: []int{0, 8, 0, 0},
+			want:   Metrics{Min: 0, Max: 8, Mean: 2, StdDev: math.Sqrt(12), Gini: 0.75},
+		},
+		{
+			name:   "uneven load",
+			counts: []int{4, 1, 1},
+			want:   Metrics{Min: 1, Max: 4, Mean: 2, StdDev: math.Sqrt2, Gini: 1.0 / 3},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := Compute(tt.counts)
+			if got.Min != tt.want.Min || got.Max != tt.want.Max ||
+				!almostEqual(got.Mean, tt.want.Mean) || !almostEqual(got.StdDev, tt.want.StdDev) ||
+				!almostEqual(got.Gini, tt.want.Gini) {
+				t.Errorf("Compute(%v) = %+v, want %+v", tt.counts, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestComputeDoesNotSortInput(t *testing.T) {
+	counts := []int{3, 1, 2}
+	Compute(counts)
+	if fmt.Sprint(counts) != "[3 1 2]" {
+		t.Errorf("Compute() modified input: %v", counts)
+	}
+}
+
+func TestPlan(t *testing.T) {
+	tests := []struct {
+		name    string
+		members []domain.UserLoad
+		reviews []Review
+		want    []domain.RebalanceMove
+	}{
+		{
+			name:    "balanced team",
+			members: loads(map[string]int{"u1": 1, "u2": 1, "u3": 1}),
+			reviews: []Review{
+				{PullRequestID: "pr-1", AuthorID: "u3", Reviewers: []string{"u1", "u2"}},
+				{PullRequestID: "pr-2", AuthorID: "u1", Reviewers: []string{"u3"}},
+			},
+			want: []domain.RebalanceMove{},
+		},
+		{
+			name:    "single reviewer",
+			members: loads(map[string]int{"u1": 2}),
+			reviews: []Review{
+				{PullRequestID: "pr-1", AuthorID: "u9", Reviewers: []string{"u1"}},
+				{PullRequestID: "pr-2", AuthorID: "u9", Reviewers: []string{"u1"}},
+			},
+			want: []domain.RebalanceMove{},
+		},
+		{
+			name:    "difference of one is kept",
+			members: loads(map[string]int{"u1": 1, "u2": 0}),
+			reviews: []Review{{PullRequestID: "pr-1", AuthorID: "u9", Reviewers: []string{"u1"}}},
+			want:    []domain.RebalanceMove{},
+		},
+		{
+			name:    "moves to least loaded",
+			members: loads(map[string]int{"u1": 3, "u2": 0, "u3": 0}),
+			reviews: []Review{
+				{PullRequestID: "pr-1", AuthorID: "u9", Reviewers: []string{"u1"}},
+				{PullRequestID: "pr-2", AuthorID: "u9", Reviewers: []string{"u1"}},
+				{PullRequestID: "pr-3", AuthorID: "u9", Reviewers: []string{"u1"}},
+			},
+			want: []domain.RebalanceMove{
+				{PullRequestID: "pr-1", FromReviewerID: "u1", ToReviewerID: "u2"},
+				{PullRequestID: "pr-2", FromReviewerID: "u1", ToReviewerID: "u3"},
+			},
+		},
+		{
+			name:    "skips author and current reviewer",
+			members: loads(map[string]int{"u1": 2, "u2": 0, "u3": 1}),
+			reviews: []Review{
+				{PullRequestID: "pr-1", AuthorID: "u2", Reviewers: []string{"u1", "u3"}},
+				{PullRequestID: "pr-2", AuthorID: "u3", Reviewers: []string{"u1"}},
+			},
+			want: []domain.RebalanceMove{
+				{PullRequestID: "pr-2", FromReviewerID: "u1", ToReviewerID: "u2"},
+			},
+		},
+		{
+			name:    "nothing can be moved",
+			members: loads(map[string]int{"u1": 2, "u2": 0}),
+			reviews: []Review{
+				{PullRequestID: "pr-1", AuthorID: "u2", Reviewers: []string{"u1"}},
+				{PullRequestID: "pr-2", AuthorID: "u2", Reviewers: []string{"u1"}},
+			},
+			want: []domain.RebalanceMove{},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := Plan(tt.members, tt.reviews)
+			if fmt.Sprint(got) != fmt.Sprint(tt.want) {
+				t.Errorf("Plan() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+// TestPlanReachesBalance После применения плана нагрузка отличается не больше чем на 1
+// и никто не ревьюит свой PR или один PR дважды
+func TestPlanReachesBalance(t *testing.T) {
+	members := loads(map[string]int{"u1": 6, "u2": 3, "u3": 0, "u4": 0})
+	reviews := []Review{
+		{PullRequestID: "pr-1", AuthorID: "u3", Reviewers: []string{"u1", "u2"}},
+		{PullRequestID: "pr-2", AuthorID: "u4", Reviewers: []string{"u1", "u2"}},
+		{PullRequestID: "pr-3", AuthorID: "u3", Reviewers: []string{"u1", "u2"}},
+		{PullRequestID: "pr-4", AuthorID: "u2", Reviewers: []string{"u1"}},
+		{PullRequestID: "pr-5", AuthorID: "u2", Reviewers: []string{"u1"}},
+		{PullRequestID: "pr-6", AuthorID: "u2", Reviewers: []string{"u1"}},
+	}
+
+	moves := Plan(members, reviews)
+
+	counts := make(map[string]int)
+	byPR := make(map[string]Review, len(reviews))
+	for _, review := range reviews {
+		byPR[review.PullRequestID] = Review{
+			PullRequestID: review.PullRequestID,
+			AuthorID:      review.AuthorID,
+			Reviewers:     append([]string(nil), review.Reviewers...),
+		}
+	}
+	for _, move := range moves {
+		review := byPR[move.PullRequestID]
+		replaced := false
+		for i, reviewer := range review.Reviewers {
+			if reviewer == move.FromReviewerID {
+				review.Reviewers[i] = move.ToReviewerID
+				replaced = true
+			}
+		}
+		if !replaced {
+			t.Fatalf("move %+v: %s is not a reviewer", move, move.FromReviewerID)
+		}
+	}
+	for _, review := range byPR {
+		seen := make(map[string]bool)
+		for _, reviewer := range review.Reviewers {
+			if reviewer == review.AuthorID || seen[reviewer] {
+				t.Errorf("%s: invalid reviewers %v (author %s)", review.PullRequestID, review.Reviewers, review.AuthorID)
+			}
+			seen[reviewer] = true
+			counts[reviewer]++
+		}
+	}
+
+	values := make([]int, 0, len(members))
+	for _, member := range members {
+		values = append(values, counts[member.UserID])
+	}
+	if metrics := Compute(values); metrics.Max-metrics.Min > 1 {
+		t.Errorf("load after plan = %v, want difference at most 1 (moves %v)", counts, moves)
+	}
+}
+
+func loads(counts map[string]int) []domain.UserLoad {
+	res := make([]domain.UserLoad, 0, len(counts))
+	for userID, count := range counts {
+		res = append(res, domain.UserLoad{UserID: userID, ReviewCount: count})
+	}
+	return res
+}
+
+func almostEqual(a, b float64) bool {
+	return math.Abs(a-b) < 1e-9
+}
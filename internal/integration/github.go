@@ -0,0 +1,59 @@
+package integration
+
+import (
+	"encoding/json"
+	"fmt"
+)
+
+const (
+	GitHubHeaderEvent     = "X-GitHub-Event"
+	GitHubHeaderDelivery  = "X-GitHub-Delivery"
+	GitHubHeaderSignature = "X-Hub-Signature-256"
+)
+
+type gitHubPullRequestEvent struct {
+	Action      string `json:"action"`
+	Number      int    `json:"number"`
+	PullRequest struct {
+		Title  string `json:"title"`
+		Merged bool   `json:"merged"`
+		User   struct {
+			Login string `json:"login"`
+		} `json:"user"`
+	} `json:"pull_request"`
+	Repository struct {
+		FullName string `json:"full_name"`
+	} `json:"repository"`
+}
+
+// ParseGitHubPullRequest Перевод события pull_request из GitHub в событие PR сервиса
+func ParseGitHubPullRequest(body []byte) (*PREvent, error) {
+	var payload gitHubPullRequestEvent
+	if err := json.Unmarshal(body, &payload); err != nil {
+		return nil, fmt.Errorf("decode github pull_request event: %w", err)
+	}
+	if payload.Repository.FullName == "" || payload.Number == 0 {
+		return nil, fmt.Errorf("github pull_request event without repository or number")
+	}
+
+	event := &PREvent{
+		// PR из GitHub идентифицируется как "<owner>/<repo>#<number>"
+		PullRequestID:   fmt.Sprintf("%s#%d", payload.Repository.FullName, payload.Number),
+		PullRequestName: payload.PullRequest.Title,
+		AuthorLogin:     payload.PullRequest.User.Login,
+	}
+	switch payload.Action {
+	case "opened", "reopened":
+		event.Action = ActionOpen
+	case "closed":
+		// В GitHub merge приходит как closed с merged=true
+		if payload.PullRequest.Merged {
+			event.Action = ActionMerge
+		} else {
+			event.Action = ActionClose
+		}
+	default:
+		event.Action = ActionIgnore
+	}
+	return event, nil
+}
@@ -0,0 +1,68 @@
+package integration
+
+import (
+	"encoding/json"
+	"fmt"
+)
+
+const (
+	GitLabHeaderEvent     = "X-Gitlab-Event"
+	GitLabHeaderToken     = "X-Gitlab-Token"
+	GitLabHeaderEventUUID = "X-Gitlab-Event-UUID"
+	GitLabMergeRequest    = "Merge Request Hook"
+)
+
+type gitLabMergeRequestEvent struct {
+	ObjectKind string `json:"object_kind"`
+	User       struct {
+		Username string `json:"username"`
+	} `json:"user"`
+	Project struct {
+		PathWithNamespace string `json:"path_with_namespace"`
+	} `json:"project"`
+	ObjectAttributes struct {
+		IID      int    `json:"iid"`
+		Title    string `json:"title"`
+		Action   string `json:"action"`
+		AuthorID int    `json:"author_id"`
+	} `json:"object_attributes"`
+}
+
+// ParseGitLabMergeRequest Перевод события Merge Request Hook из GitLab в событие PR сервиса
+func ParseGitLabMergeRequest(body []byte) (*PREvent, error) {
+	var payload gitLabMergeRequestEvent
+	if err := json.Unmarshal(body, &payload); err != nil {
+		return nil, fmt.Errorf("decode gitlab merge request event: %w", err)
+	}
+	if payload.ObjectKind != "merge_request" {
+		return nil, fmt.Errorf("unexpected gitlab object kind %q", payload.ObjectKind)
+	}
+	if payload.Project.PathWithNamespace == "" || payload.ObjectAttributes.IID == 0 {
+		return nil, fmt.Errorf("gitlab merge request event without project or iid")
+	}
+
+	event := &PREvent{
+		// MR из GitLab идентифицируется как "<namespace>/<project>!<iid>"
+		PullRequestID:   fmt.Sprintf("%s!%d", payload.Project.PathWithNamespace, payload.ObjectAttributes.IID),
+		PullRequestName: payload.ObjectAttributes.Title,
+	}
+	switch payload.ObjectAttributes.Action {
+	case "open":
+		event.Action = ActionOpen
+		// В событии есть только пользователь, вызвавший его: автором он является лишь при открытии MR
+		event.AuthorLogin = payload.User.Username
+	case "reopen":
+		// Как reopened в GitHub: PR создаётся, если его нет; MR мог переоткрыть не автор, поэтому автор - по author_id
+		event.Action = ActionOpen
+		event.AuthorID = payload.ObjectAttributes.AuthorID
+	case "update":
+		event.Action = ActionUpdate
+	case "merge":
+		event.Action = ActionMerge
+	case "close":
+		event.Action = ActionClose
+	default:
+		event.Action = ActionIgnore
+	}
+	return event, nil
+}
@@ -0,0 +1,23 @@
+package integration
+
+// Action Действие над PR, к которому сводится событие внешней системы
+type Action string
+
+const (
+	ActionOpen   Action = "open"
+	ActionMerge  Action = "merge"
+	ActionClose  Action = "close"
+	ActionIgnore Action = "ignore"
+	// Изменение PR (название, описание, ветки): сервис эти данные не синхронизирует
+	ActionUpdate Action = "update"
+)
+
+// PREvent Событие жизненного цикла PR из внешней системы (GitHub, GitLab)
+type PREvent struct {
+	Action          Action
+	PullRequestID   string
+	PullRequestName string
+	AuthorLogin     string
+	// Числовой id автора во внешней системе, когда логина автора в событии нет (GitLab author_id)
+	AuthorID int
+}
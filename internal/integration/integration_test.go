@@ -0,0 +1,159 @@
+package integration
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func readPayload(t *testing.T, name string) []byte {
+	t.Helper()
+	body, err := os.ReadFile(filepath.Join("testdata", name))
+	if err != nil {
+		t.Fatalf("read payload: %v", err)
+	}
+	return body
+}
+
+func TestParseGitHubPullRequest(t *testing.T) {
+	tests := []struct {
+		payload string
+		want    PREvent
+	}{
+		{
+			payload: "github_pull_request_opened.json",
+			want: PREvent{
+				Action:          ActionOpen,
+				PullRequestID:   "avito-tech/review-service#42",
+				PullRequestName: "Add search endpoint",
+				AuthorLogin:     "octocat",
+			},
+		},
+		{
+			// Автор берётся из PR, а не из отправителя события
+			payload: "github_pull_request_merged.json",
+			want: PREvent{
+				Action:          ActionMerge,
+				PullRequestID:   "avito-tech/review-service#42",
+				PullRequestName: "Add search endpoint",
+				AuthorLogin:     "octocat",
+			},
+		},
+		{
+			payload: "github_pull_request_closed.json",
+			want: PREvent{
+				Action:          ActionClose,
+				PullRequestID:   "avito-tech/review-service#42",
+				PullRequestName: "Add search endpoint",
+				AuthorLogin:     "octocat",
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.payload, func(t *testing.T) {
+			got, err := ParseGitHubPullRequest(readPayload(t, tt.payload))
+			if err != nil {
+				t.Fatalf("ParseGitHubPullRequest() error = %v", err)
+			}
+			if *got != tt.want {
+				t.Errorf("ParseGitHubPullRequest() = %+v, want %+v", *got, tt.want)
+			}
+		})
+	}
+}
+
+func TestParseGitHubPullRequestIgnoredAction(t *testing.T) {
+	got, err := ParseGitHubPullRequest([]byte(`{"action":"labeled","number":1,"repository":{"full_name":"a/b"}}`))
+	if err != nil {
+		t.Fatalf("ParseGitHubPullRequest() error = %v", err)
+	}
+	if got.Action != ActionIgnore {
+		t.Errorf("ParseGitHubPullRequest() action = %q, want %q", got.Action, ActionIgnore)
+	}
+}
+
+func TestParseGitHubPullRequestInvalid(t *testing.T) {
+	for name, body := range map[string]string{
+		"not json":           `{`,
+		"without repository": `{"action":"opened","number":1}`,
+		"without number":     `{"action":"opened","repository":{"full_name":"a/b"}}`,
+	} {
+		t.Run(name, func(t *testing.T) {
+			if _, err := ParseGitHubPullRequest([]byte(body)); err == nil {
+				t.Error("ParseGitHubPullRequest() error = nil, want error")
+			}
+		})
+	}
+}
+
+func TestParseGitLabMergeRequest(t *testing.T) {
+	tests := []struct {
+		payload string
+		want    PREvent
+	}{
+		{
+			payload: "gitlab_merge_request_open.json",
+			want: PREvent{
+				Action:          ActionOpen,
+				PullRequestID:   "platform/review-service!7",
+				PullRequestName: "Fix reviewer rotation",
+				AuthorLogin:     "jdoe",
+			},
+		},
+		{
+			payload: "gitlab_merge_request_update.json",
+			want: PREvent{
+				// Пользователь события update - не автор MR
+				Action:          ActionUpdate,
+				PullRequestID:   "platform/review-service!7",
+				PullRequestName: "Fix reviewer rotation for inactive users",
+			},
+		},
+		{
+			payload: "gitlab_merge_request_reopen.json",
+			want: PREvent{
+				// Переоткрыл MR бот, автор берётся из author_id
+				Action:          ActionOpen,
+				PullRequestID:   "platform/review-service!7",
+				PullRequestName: "Fix reviewer rotation for inactive users",
+				AuthorID:        51,
+			},
+		},
+		{
+			payload: "gitlab_merge_request_merge.json",
+			want: PREvent{
+				Action:          ActionMerge,
+				PullRequestID:   "platform/review-service!7",
+				PullRequestName: "Fix reviewer rotation for inactive users",
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.payload, func(t *testing.T) {
+			got, err := ParseGitLabMergeRequest(readPayload(t, tt.payload))
+			if err != nil {
+				t.Fatalf("ParseGitLabMergeRequest() error = %v", err)
+			}
+			if *got != tt.want {
+				t.Errorf("ParseGitLabMergeRequest() = %+v, want %+v", *got, tt.want)
+			}
+		})
+	}
+}
+
+func TestParseGitLabMergeRequestInvalid(t *testing.T) {
+	for name, body := range map[string]string{
+		"not json":        `[]`,
+		"other kind":      `{"object_kind":"push","project":{"path_with_namespace":"a/b"},"object_attributes":{"iid":1}}`,
+		"without iid":     `{"object_kind":"merge_request","project":{"path_with_namespace":"a/b"}}`,
+		"without project": `{"object_kind":"merge_request","object_attributes":{"iid":1}}`,
+	} {
+		t.Run(name, func(t *testing.T) {
+			if _, err := ParseGitLabMergeRequest([]byte(body)); err == nil {
+				t.Error("ParseGitLabMergeRequest() error = nil, want error")
+			}
+		})
+	}
+}
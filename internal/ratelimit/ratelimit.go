@@ -0,0 +1,90 @@
+package ratelimit
+
+import (
+	"math"
+	"sync"
+	"time"
+)
+
+// Limit Скорость пополнения (запросов в секунду) и ёмкость bucket
+type Limit struct {
+	Rate  float64
+	Burst int
+}
+
+type bucket struct {
+	tokens float64
+	last   time.Time
+}
+
+// Limiter Token bucket на каждый ключ (клиент + маршрут) в памяти процесса
+type Limiter struct {
+	mu        sync.Mutex
+	buckets   map[string]*bucket
+	throttled map[string]uint64
+	idleTTL   time.Duration
+	lastSweep time.Time
+}
+
+// New Bucket без запросов дольше idleTTL удаляется
+func New(idleTTL time.Duration) *Limiter {
+	return &Limiter{
+		buckets:   make(map[string]*bucket),
+		throttled: make(map[string]uint64),
+		idleTTL:   idleTTL,
+	}
+}
+
+// Allow Списание токена из bucket ключа. При отказе возвращает время до появления токена
+func (l *Limiter) Allow(key, route string, limit Limit, now time.Time) (bool, time.Duration) {
+	if limit.Rate <= 0 || limit.Burst <= 0 {
+		return true, 0
+	}
+
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	l.sweep(now)
+	b, ok := l.buckets[key]
+	if !ok {
+		b = &bucket{tokens: float64(limit.Burst), last: now}
+		l.buckets[key] = b
+	}
+	if elapsed := now.Sub(b.last).Seconds(); elapsed > 0 {
+		b.tokens = math.Min(float64(limit.Burst), b.tokens+elapsed*limit.Rate)
+	}
+	b.last = now
+
+	if b.tokens >= 1 {
+		b.tokens--
+		return true, 0
+	}
+	l.throttled[route]++
+	wait := time.Duration((1 - b.tokens) / limit.Rate * float64(time.Second))
+	return false, wait
+}
+
+// Throttled Число отклонённых запросов по маршрутам
+func (l *Limiter) Throttled() map[string]uint64 {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	throttled := make(map[string]uint64, len(l.throttled))
+	for route, count := range l.throttled {
+		throttled[route] = count
+	}
+	return throttled
+}
+
+// sweep Удаление простаивающих bucket (не чаще раза в idleTTL)
+func (l *Limiter) sweep(now time.Time) {
+	if l.idleTTL <= 0 || now.Sub(l.lastSweep) < l.idleTTL {
+		return
+	}
+	l.lastSweep = now
+	for key, b := range l.buckets {
+		if now.Sub(b.last) >= l.idleTTL {
+			delete(l.buckets, key)
+		}
+	}
+}
@@ -0,0 +1,187 @@
+package roster
+
+import (
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+
+	"github.com/sudo-odner/Backend-trainee-assignment-avito-2025/internal/domain"
+	"gopkg.in/yaml.v3"
+)
+
+// Форматы файла состава команд
+const (
+	FormatYAML = "yaml"
+	FormatCSV  = "csv"
+)
+
+// csvHeader Колонки CSV: одна строка на участника команды
+var csvHeader = []string{"team_name", "user_id", "username", "is_active"}
+
+type yamlRoster struct {
+	Teams []struct {
+		TeamName string `yaml:"team_name"`
+		Members  []struct {
+			UserID   string `yaml:"user_id"`
+			Username string `yaml:"username"`
+			IsActive *bool  `yaml:"is_active"`
+		} `yaml:"members"`
+	} `yaml:"teams"`
+}
+
+// Parse Разбор состава команд из YAML или CSV с проверкой до применения
+func Parse(r io.Reader, format string) ([]domain.Team, error) {
+	var teams []domain.Team
+	var err error
+	switch format {
+	case FormatYAML:
+		teams, err = parseYAML(r)
+	case FormatCSV:
+		teams, err = parseCSV(r)
+	default:
+		return nil, fmt.Errorf("unknown roster format %q", format)
+	}
+	if err != nil {
+		return nil, err
+	}
+	if err := Validate(teams); err != nil {
+		return nil, err
+	}
+	return teams, nil
+}
+
+// FormatByName Формат по расширению файла или Content-Type (по умолчанию YAML)
+func FormatByName(name string) string {
+	name = strings.ToLower(name)
+	if strings.HasSuffix(name, ".csv") || strings.Contains(name, "text/csv") {
+		return FormatCSV
+	}
+	return FormatYAML
+}
+
+func parseYAML(r io.Reader) ([]domain.Team, error) {
+	var raw yamlRoster
+	decoder := yaml.NewDecoder(r)
+	decoder.KnownFields(true)
+	if err := decoder.Decode(&raw); err != nil {
+		if errors.Is(err, io.EOF) {
+			return nil, errors.New("roster is empty")
+		}
+		return nil, fmt.Errorf("parse yaml: %w", err)
+	}
+
+	teams := make([]domain.Team, 0, len(raw.Teams))
+	for _, rawTeam := range raw.Teams {
+		team := domain.Team{Name: rawTeam.TeamName, Users: make([]domain.User, 0, len(rawTeam.Members))}
+		for _, member := range rawTeam.Members {
+			// Без is_active пользователь считается активным
+			isActive := member.IsActive == nil || *member.IsActive
+			team.Users = append(team.Users, domain.User{ID: member.UserID, Name: member.Username, IsActive: isActive})
+		}
+		teams = append(teams, team)
+	}
+	return teams, nil
+}
+
+func parseCSV(r io.Reader) ([]domain.Team, error) {
+	reader := csv.NewReader(r)
+	reader.FieldsPerRecord = len(csvHeader)
+	reader.TrimLeadingSpace = true
+
+	header, err := reader.Read()
+	if err != nil {
+		if errors.Is(err, io.EOF) {
+			return nil, errors.New("roster is empty")
+		}
+		return nil, fmt.Errorf("parse csv: %w", err)
+	}
+	for i, column := range csvHeader {
+		if strings.TrimSpace(header[i]) != column {
+			return nil, fmt.Errorf("parse csv: expected header %s", strings.Join(csvHeader, ","))
+		}
+	}
+
+	// Порядок команд - порядок первого упоминания
+	teams := make([]domain.Team, 0)
+	teamIndex := make(map[string]int)
+	for {
+		record, err := reader.Read()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return nil, fmt.Errorf("parse csv: %w", err)
+		}
+		line, _ := reader.FieldPos(0)
+		isActive := true
+		if raw := strings.TrimSpace(record[3]); raw != "" {
+			if isActive, err = strconv.ParseBool(raw); err != nil {
+				return nil, fmt.Errorf("parse csv: line %d: invalid is_active %q", line, raw)
+			}
+		}
+
+		teamName := strings.TrimSpace(record[0])
+		index, ok := teamIndex[teamName]
+		if !ok {
+			index = len(teams)
+			teamIndex[teamName] = index
+			teams = append(teams, domain.Team{Name: teamName, Users: []domain.User{}})
+		}
+		teams[index].Users = append(teams[index].Users, domain.User{
+			ID:       strings.TrimSpace(record[1]),
+			Name:     strings.TrimSpace(record[2]),
+			IsActive: isActive,
+		})
+	}
+	return teams, nil
+}
+
+// Validate Проверка состава: заполненные поля, без повторов команд и пользователей
+// (у пользователя может быть только одна команда)
+func Validate(teams []domain.Team) error {
+	if len(teams) == 0 {
+		return errors.New("roster has no teams")
+	}
+	problems := make([]string, 0)
+	seenTeams := make(map[string]bool, len(teams))
+	userTeam := make(map[string]string)
+	for i, team := range teams {
+		if team.Name == "" {
+			problems = append(problems, fmt.Sprintf("team #%d: team_name is required", i+1))
+		} else if seenTeams[team.Name] {
+			problems = append(problems, fmt.Sprintf("team %s: duplicated", team.Name))
+		}
+		seenTeams[team.Name] = true
+
+		for j, user := range team.Users {
+			if user.ID == "" {
+				problems = append(problems, fmt.Sprintf("team %s: member #%d: user_id is required", team.Name, j+1))
+				continue
+			}
+			if user.Name == "" {
+				problems = append(problems, fmt.Sprintf("team %s: user %s: username is required", team.Name, user.ID))
+			}
+			if other, ok := userTeam[user.ID]; ok {
+				problems = append(problems, fmt.Sprintf("user %s: listed in %s and %s", user.ID, other, team.Name))
+				continue
+			}
+			userTeam[user.ID] = team.Name
+		}
+	}
+	if len(problems) > 0 {
+		return &ValidationError{Problems: problems}
+	}
+	return nil
+}
+
+// ValidationError Все найденные ошибки состава
+type ValidationError struct {
+	Problems []string
+}
+
+func (e *ValidationError) Error() string {
+	return "invalid roster: " + strings.Join(e.Problems, "; ")
+}
@@ -0,0 +1,151 @@
+package roster
+
+import (
+	"errors"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/sudo-odner/Backend-trainee-assignment-avito-2025/internal/domain"
+)
+
+func TestParse(t *testing.T) {
+	want := []domain.Team{
+		{Name: "backend", Users: []domain.User{
+			{ID: "u1", Name: "Alice", IsActive: true},
+			{ID: "u2", Name: "Bob", IsActive: false},
+		}},
+		{Name: "frontend", Users: []domain.User{
+			{ID: "u3", Name: "Carol", IsActive: true},
+		}},
+	}
+
+	tests := []struct {
+		name   string
+		format string
+		input  string
+	}{
+		{
+			name:   "yaml",
+			format: FormatYAML,
+			input: `
+teams:
+  - team_name: backend
+    members:
+      - user_id: u1
+        username: Alice
+      - user_id: u2
+        username: Bob
+        is_active: false
+  - team_name: frontend
+    members:
+      - user_id: u3
+        username: Carol
+        is_active: true
+`,
+		},
+		{
+			name:   "csv",
+			format: FormatCSV,
+			input: `team_name,user_id,username,is_active
+backend, u1, Alice,
+frontend,u3,Carol,true
+backend,u2,Bob,false
+`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := Parse(strings.NewReader(tt.input), tt.format)
+			if err != nil {
+				t.Fatalf("Parse() error = %v", err)
+			}
+			if !reflect.DeepEqual(got, want) {
+				t.Errorf("Parse() = %+v, want %+v", got, want)
+			}
+		})
+	}
+}
+
+func TestParseInvalid(t *testing.T) {
+	tests := []struct {
+		name    string
+		format  string
+		input   string
+		wantErr string
+	}{
+		{name: "unknown format", format: "xml", input: "<teams/>", wantErr: "unknown roster format"},
+		{name: "empty yaml", format: FormatYAML, input: "", wantErr: "roster is empty"},
+		{name: "unknown yaml field", format: FormatYAML, input: "teams:\n  - team_name: a\n    lead: u1\n", wantErr: "parse yaml"},
+		{name: "yaml without teams", format: FormatYAML, input: "teams: []\n", wantErr: "roster has no teams"},
+		{name: "empty csv", format: FormatCSV, input: "", wantErr: "roster is empty"},
+		{name: "csv header", format: FormatCSV, input: "team,user,name,active\n", wantErr: "expected header"},
+		{name: "csv columns", format: FormatCSV, input: "team_name,user_id,username,is_active\na,u1,Alice\n", wantErr: "parse csv"},
+		{name: "csv is_active", format: FormatCSV, input: "team_name,user_id,username,is_active\na,u1,Alice,maybe\n", wantErr: `line 2: invalid is_active "maybe"`},
+		{name: "csv without rows", format: FormatCSV, input: "team_name,user_id,username,is_active\n", wantErr: "roster has no teams"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := Parse(strings.NewReader(tt.input), tt.format)
+			if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+				t.Errorf("Parse() error = %v, want containing %q", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestValidate(t *testing.T) {
+	teams := []domain.Team{
+		{Name: "", Users: []domain.User{{ID: "u9", Name: "Dave"}}},
+		{Name: "frontend", Users: []domain.User{{ID: "u1", Name: "Alice"}}},
+		{Name: "backend", Users: []domain.User{
+			{ID: "", Name: "Nobody"},
+			{ID: "u2"},
+			{ID: "u1", Name: "Alice"},
+		}},
+		{Name: "backend"},
+	}
+
+	err := Validate(teams)
+	var validationErr *ValidationError
+	if !errors.As(err, &validationErr) {
+		t.Fatalf("Validate() error = %v, want *ValidationError", err)
+	}
+	want := []string{
+		"team #1: team_name is required",
+		"team backend: member #1: user_id is required",
+		"team backend: user u2: username is required",
+		"user u1: listed in frontend and backend",
+		"team backend: duplicated",
+	}
+	if !reflect.DeepEqual(validationErr.Problems, want) {
+		t.Errorf("Validate() problems = %q, want %q", validationErr.Problems, want)
+	}
+}
+
+func TestValidateValid(t *testing.T) {
+	err := Validate([]domain.Team{
+		{Name: "backend", Users: []domain.User{{ID: "u1", Name: "Alice"}}},
+		{Name: "empty", Users: []domain.User{}},
+	})
+	if err != nil {
+		t.Errorf("Validate() error = %v", err)
+	}
+}
+
+func TestFormatByName(t *testing.T) {
+	for name, want := range map[string]string{
+		"teams.csv":          FormatCSV,
+		"TEAMS.CSV":          FormatCSV,
+		"text/csv":           FormatCSV,
+		"teams.yaml":         FormatYAML,
+		"application/x-yaml": FormatYAML,
+		"":                   FormatYAML,
+	} {
+		if got := FormatByName(name); got != want {
+			t.Errorf("FormatByName(%q) = %q, want %q", name, got, want)
+		}
+	}
+}
@@ -0,0 +1,65 @@
+package sla
+
+import (
+	"time"
+)
+
+// Policy Правила расчёта срока ревью (SLA) с учётом рабочего времени
+type Policy struct {
+	DefaultHours int
+	TeamHours    map[string]int
+	// Рабочие часы [WorkdayStart, WorkdayEnd), если не заданы - считаем календарное время
+	WorkdayStart int
+	WorkdayEnd   int
+	Workdays     map[time.Weekday]bool
+	Location     *time.Location
+}
+
+// Hours SLA команды в часах (0 - без SLA)
+func (p *Policy) Hours(teamName string) int {
+	if hours, ok := p.TeamHours[teamName]; ok {
+		return hours
+	}
+	return p.DefaultHours
+}
+
+// DueAt Срок ревью для назначения, сделанного в момент assignedAt
+func (p *Policy) DueAt(teamName string, assignedAt time.Time) (time.Time, bool) {
+	hours := p.Hours(teamName)
+	if hours <= 0 {
+		return time.Time{}, false
+	}
+	remaining := time.Duration(hours) * time.Hour
+
+	// Без рабочих часов срок считается календарно
+	if p.WorkdayStart >= p.WorkdayEnd || len(p.Workdays) == 0 {
+		return assignedAt.Add(remaining), true
+	}
+
+	loc := p.Location
+	if loc == nil {
+		loc = time.UTC
+	}
+	t := assignedAt.In(loc)
+	for {
+		dayStart := time.Date(t.Year(), t.Month(), t.Day(), p.WorkdayStart, 0, 0, 0, loc)
+		dayEnd := time.Date(t.Year(), t.Month(), t.Day(), p.WorkdayEnd, 0, 0, 0, loc)
+
+		// Нерабочий день или рабочий день закончился - переходим к началу следующего дня
+		if !p.Workdays[t.Weekday()] || !t.Before(dayEnd) {
+			next := t.AddDate(0, 0, 1)
+			t = time.Date(next.Year(), next.Month(), next.Day(), p.WorkdayStart, 0, 0, 0, loc)
+			continue
+		}
+		if t.Before(dayStart) {
+			t = dayStart
+		}
+
+		available := dayEnd.Sub(t)
+		if remaining <= available {
+			return t.Add(remaining).In(assignedAt.Location()), true
+		}
+		remaining -= available
+		t = dayEnd
+	}
+}
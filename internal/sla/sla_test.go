@@ -0,0 +1,146 @@
+package sla
+
+import (
+	"testing"
+	"time"
+)
+
+func TestPolicyDueAt(t *testing.T) {
+	msk := time.FixedZone("MSK", 3*60*60)
+	workdays := map[time.Weekday]bool{
+		time.Monday: true, time.Tuesday: true, time.Wednesday: true, time.Thursday: true, time.Friday: true,
+	}
+	business := &Policy{
+		DefaultHours: 4,
+		TeamHours:    map[string]int{"backend": 24, "no-sla": 0},
+		WorkdayStart: 10,
+		WorkdayEnd:   19,
+		Workdays:     workdays,
+		Location:     msk,
+	}
+	calendar := &Policy{DefaultHours: 4}
+
+	tests := []struct {
+		name       string
+		policy     *Policy
+		team       string
+		assignedAt time.Time
+		want       time.Time
+		wantOK     bool
+	}{
+		{
+			name:       "calendar time without business hours",
+			policy:     calendar,
+			assignedAt: time.Date(2026, 10, 24, 22, 0, 0, 0, msk),
+			want:       time.Date(2026, 10, 25, 2, 0, 0, 0, msk),
+			wantOK:     true,
+		},
+		{
+			name:       "team without SLA",
+			policy:     business,
+			team:       "no-sla",
+			assignedAt: time.Date(2026, 10, 19, 11, 0, 0, 0, msk),
+		},
+		{
+			name:       "within working day",
+			policy:     business,
+			assignedAt: time.Date(2026, 10, 19, 11, 0, 0, 0, msk),
+			want:       time.Date(2026, 10, 19, 15, 0, 0, 0, msk),
+			wantOK:     true,
+		},
+		{
+			name:       "ends exactly at end of day",
+			policy:     business,
+			assignedAt: time.Date(2026, 10, 19, 15, 0, 0, 0, msk),
+			want:       time.Date(2026, 10, 19, 19, 0, 0, 0, msk),
+			wantOK:     true,
+		},
+		{
+			name:       "carries over to next day",
+			policy:     business,
+			assignedAt: time.Date(2026, 10, 19, 17, 0, 0, 0, msk),
+			want:       time.Date(2026, 10, 20, 12, 0, 0, 0, msk),
+			wantOK:     true,
+		},
+		{
+			name:       "before working hours",
+			policy:     business,
+			assignedAt: time.Date(2026, 10, 19, 8, 0, 0, 0, msk),
+			want:       time.Date(2026, 10, 19, 14, 0, 0, 0, msk),
+			wantOK:     true,
+		},
+		{
+			name:       "after working hours",
+			policy:     business,
+			assignedAt: time.Date(2026, 10, 19, 20, 30, 0, 0, msk),
+			want:       time.Date(2026, 10, 20, 14, 0, 0, 0, msk),
+			wantOK:     true,
+		},
+		{
+			name:       "friday evening skips weekend",
+			policy:     business,
+			assignedAt: time.Date(2026, 10, 23, 18, 0, 0, 0, msk),
+			want:       time.Date(2026, 10, 26, 13, 0, 0, 0, msk),
+			wantOK:     true,
+		},
+		{
+			name:       "assigned on saturday",
+			policy:     business,
+			assignedAt: time.Date(2026, 10, 24, 12, 0, 0, 0, msk),
+			want:       time.Date(2026, 10, 26, 14, 0, 0, 0, msk),
+			wantOK:     true,
+		},
+		{
+			name:       "team SLA over several days",
+			policy:     business,
+			team:       "backend",
+			assignedAt: time.Date(2026, 10, 22, 10, 0, 0, 0, msk),
+			want:       time.Date(2026, 10, 26, 16, 0, 0, 0, msk),
+			wantOK:     true,
+		},
+		{
+			name:   "policy timezone differs from assignment",
+			policy: business,
+			// Воскресенье 22:00 UTC - понедельник 01:00 MSK
+			assignedAt: time.Date(2026, 10, 18, 22, 0, 0, 0, time.UTC),
+			want:       time.Date(2026, 10, 19, 11, 0, 0, 0, time.UTC),
+			wantOK:     true,
+		},
+		{
+			name:       "working day in policy timezone ends earlier than in UTC",
+			policy:     business,
+			assignedAt: time.Date(2026, 10, 19, 15, 0, 0, 0, time.UTC),
+			want:       time.Date(2026, 10, 20, 10, 0, 0, 0, time.UTC),
+			wantOK:     true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, ok := tt.policy.DueAt(tt.team, tt.assignedAt)
+			if ok != tt.wantOK {
+				t.Fatalf("DueAt() ok = %v, want %v", ok, tt.wantOK)
+			}
+			if !got.Equal(tt.want) {
+				t.Errorf("DueAt() = %v, want %v", got, tt.want)
+			}
+			if ok && got.Location() != tt.assignedAt.Location() {
+				t.Errorf("DueAt() location = %v, want %v", got.Location(), tt.assignedAt.Location())
+			}
+		})
+	}
+}
+
+func TestPolicyDueAtDefaultsToUTC(t *testing.T) {
+	policy := &Policy{
+		DefaultHours: 2,
+		WorkdayStart: 10,
+		WorkdayEnd:   19,
+		Workdays:     map[time.Weekday]bool{time.Monday: true},
+	}
+	got, ok := policy.DueAt("", time.Date(2026, 10, 19, 18, 0, 0, 0, time.UTC))
+	want := time.Date(2026, 10, 26, 11, 0, 0, 0, time.UTC)
+	if !ok || !got.Equal(want) {
+		t.Errorf("DueAt() = %v, %v, want %v, true", got, ok, want)
+	}
+}
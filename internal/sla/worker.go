@@ -0,0 +1,103 @@
+package sla
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"time"
+
+	"github.com/sudo-odner/Backend-trainee-assignment-avito-2025/internal/domain"
+	"github.com/sudo-odner/Backend-trainee-assignment-avito-2025/internal/storage"
+	"github.com/sudo-odner/Backend-trainee-assignment-avito-2025/pkg/logger/sl"
+)
+
+// defaultInterval Интервал проверки, если в конфиге задан неположительный
+const defaultInterval = time.Minute
+
+type ReviewStorage interface {
+	MarkOverdueReviews(now time.Time) (int, error)
+	GetOverdueReviews(filter domain.OverdueFilter) ([]domain.ReviewAssignment, error)
+	ReassignReviewer(prID, oldReviewerID string, meta domain.ChangeMeta) (*domain.PullRequest, string, error)
+}
+
+// Worker Фоновая проверка просроченных ревью и эскалация
+type Worker struct {
+	log     *slog.Logger
+	storage ReviewStorage
+	// Интервал проверки
+	interval time.Duration
+	// Через сколько после срока ревью переназначается (0 - не переназначать)
+	reassignAfter time.Duration
+}
+
+func NewWorker(log *slog.Logger, storage ReviewStorage, interval, reassignAfter time.Duration) *Worker {
+	if interval <= 0 {
+		log.Warn("invalid review SLA check interval, using default",
+			slog.Duration("interval", interval), slog.Duration("default", defaultInterval))
+		interval = defaultInterval
+	}
+	return &Worker{
+		log:           log,
+		storage:       storage,
+		interval:      interval,
+		reassignAfter: reassignAfter,
+	}
+}
+
+// Run Запуск проверки до отмены контекста
+func (w *Worker) Run(ctx context.Context) {
+	ticker := time.NewTicker(w.interval)
+	defer ticker.Stop()
+
+	for {
+		w.check(time.Now())
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+func (w *Worker) check(now time.Time) {
+	// Отмечаем просроченные ревью
+	marked, err := w.storage.MarkOverdueReviews(now)
+	if err != nil {
+		w.log.Error("failed to mark overdue reviews", sl.Err(err))
+		return
+	}
+	if marked > 0 {
+		w.log.Info("overdue reviews marked", slog.Int("count", marked))
+	}
+
+	if w.reassignAfter <= 0 {
+		return
+	}
+
+	// Переназначаем ревью, просроченные дольше порога эскалации
+	reviews, err := w.storage.GetOverdueReviews(domain.OverdueFilter{DueBefore: now.Add(-w.reassignAfter)})
+	if err != nil {
+		w.log.Error("failed to get overdue reviews", sl.Err(err))
+		return
+	}
+	for _, review := range reviews {
+		_, newReviewerID, err := w.storage.ReassignReviewer(review.PullRequestID, review.ReviewerID, domain.ChangeMeta{
+			Actor:  "system:sla",
+			Reason: "review overdue",
+		})
+		if err != nil {
+			if errors.Is(err, storage.ErrNoCandidate) {
+				w.log.Debug("no candidate to escalate overdue review",
+					slog.String("pull_request_id", review.PullRequestID),
+					slog.String("reviewer_id", review.ReviewerID))
+				continue
+			}
+			w.log.Error("failed to reassign overdue review", sl.Err(err))
+			continue
+		}
+		w.log.Info("overdue review reassigned",
+			slog.String("pull_request_id", review.PullRequestID),
+			slog.String("old_reviewer_id", review.ReviewerID),
+			slog.String("new_reviewer_id", newReviewerID))
+	}
+}
@@ -0,0 +1,20 @@
+package sla
+
+import (
+	"io"
+	"log/slog"
+	"testing"
+	"time"
+)
+
+func TestNewWorkerDefaultsInterval(t *testing.T) {
+	log := slog.New(slog.NewTextHandler(io.Discard, nil))
+	for _, interval := range []time.Duration{0, -time.Second} {
+		if w := NewWorker(log, nil, interval, 0); w.interval != defaultInterval {
+			t.Errorf("NewWorker(%v) interval = %v, want %v", interval, w.interval, defaultInterval)
+		}
+	}
+	if w := NewWorker(log, nil, time.Second, 0); w.interval != time.Second {
+		t.Errorf("NewWorker(1s) interval = %v, want 1s", w.interval)
+	}
+}
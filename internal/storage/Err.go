@@ -13,4 +13,9 @@ var (
 	ErrNoCandidate         = errors.New("no candidate")
 	ErrRowsNotClosed       = errors.New("rows not closed")
 	ErrRollbackFailed      = errors.New("rollback failed")
+	ErrWebhookNotFound     = errors.New("webhook not found")
+	ErrDeliveryNotFound    = errors.New("webhook delivery not found")
+	ErrStorageNotEmpty     = errors.New("storage is not empty")
+	ErrVersionMismatch     = errors.New("pull request version mismatch")
+	ErrTokenNotFound       = errors.New("api token not found")
 )
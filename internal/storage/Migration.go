@@ -3,19 +3,30 @@ package storage
 import (
 	"fmt"
 	"os"
+	"path/filepath"
+	"sort"
 
 	"github.com/jmoiron/sqlx"
 )
 
 func RunMigrations(db *sqlx.DB) error {
-	data, err := os.ReadFile("./migrations/001_init.up.sql")
+	// Все up-миграции выполняются по порядку номера (миграции идемпотентны)
+	files, err := filepath.Glob("./migrations/*.up.sql")
 	if err != nil {
-		return fmt.Errorf("read migration: %w", err)
+		return fmt.Errorf("find migrations: %w", err)
 	}
+	sort.Strings(files)
 
-	_, err = db.Exec(string(data))
-	if err != nil {
-		return fmt.Errorf("exec migration: %w", err)
+	for _, file := range files {
+		data, err := os.ReadFile(file)
+		if err != nil {
+			return fmt.Errorf("read migration: %w", err)
+		}
+
+		_, err = db.Exec(string(data))
+		if err != nil {
+			return fmt.Errorf("exec migration %s: %w", filepath.Base(file), err)
+		}
 	}
 
 	return nil
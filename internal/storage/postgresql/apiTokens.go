@@ -0,0 +1,131 @@
+package postgresql
+
+import (
+	"database/sql"
+	"errors"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/sudo-odner/Backend-trainee-assignment-avito-2025/internal/domain"
+	"github.com/sudo-odner/Backend-trainee-assignment-avito-2025/internal/storage"
+)
+
+// CreateAPIToken Сохранение токена (в базе только хеш). Руководитель команды должен в ней состоять
+func (s *Storage) CreateAPIToken(name, tokenHash, role, userID, teamName string) (*domain.APIToken, error) {
+	const op = "storage.postgresql.CreateAPIToken"
+
+	if userID != "" {
+		var exists bool
+		if err := s.db.QueryRow(`select exists(select 1 from users where id = $1)`, userID).Scan(&exists); err != nil {
+			return nil, fmt.Errorf("%s: %w", op, err)
+		}
+		if !exists {
+			return nil, fmt.Errorf("%s: %w", op, storage.ErrUserNotFound)
+		}
+	}
+	if teamName != "" {
+		if err := s.IsTeamExists(teamName); err != nil {
+			return nil, fmt.Errorf("%s: %w", op, err)
+		}
+		inTeam, err := s.IsUserInTeam(userID, teamName)
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", op, err)
+		}
+		if !inTeam {
+			return nil, fmt.Errorf("%s: %w", op, storage.ErrUserNotFound)
+		}
+	}
+
+	token := domain.APIToken{
+		Name:      name,
+		Role:      role,
+		UserID:    userID,
+		TeamName:  teamName,
+		CreatedAt: time.Now(),
+	}
+	err := s.db.QueryRow(`
+	insert into api_tokens (name, token_hash, role, user_id, team_name, created_at)
+	values ($1, $2, $3, nullif($4, ''), nullif($5, ''), $6)
+	returning id`,
+		name, tokenHash, role, userID, teamName, token.CreatedAt).Scan(&token.ID)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	return &token, nil
+}
+
+// GetAPITokenByHash Действующий токен по хешу
+func (s *Storage) GetAPITokenByHash(tokenHash string) (*domain.APIToken, error) {
+	const op = "storage.postgresql.GetAPITokenByHash"
+
+	var token domain.APIToken
+	var userID, teamName sql.NullString
+	err := s.db.QueryRow(`
+	select id, name, role, user_id, team_name, created_at
+	from api_tokens
+	where token_hash = $1 and revoked_at is null`, tokenHash).Scan(
+		&token.ID, &token.Name, &token.Role, &userID, &teamName, &token.CreatedAt)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, storage.ErrTokenNotFound
+		}
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	token.UserID = userID.String
+	token.TeamName = teamName.String
+	return &token, nil
+}
+
+// GetAPITokens Список действующих токенов
+func (s *Storage) GetAPITokens() ([]domain.APIToken, error) {
+	const op = "storage.postgresql.GetAPITokens"
+
+	rows, err := s.db.Query(`
+	select id, name, role, user_id, team_name, created_at
+	from api_tokens
+	where revoked_at is null
+	order by id`)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	defer func() {
+		if err := rows.Close(); err != nil {
+			log.Printf("rows close failed: %v", err)
+		}
+	}()
+
+	tokens := make([]domain.APIToken, 0)
+	for rows.Next() {
+		var token domain.APIToken
+		var userID, teamName sql.NullString
+		if err := rows.Scan(&token.ID, &token.Name, &token.Role, &userID, &teamName, &token.CreatedAt); err != nil {
+			return nil, fmt.Errorf("%s: %w", op, err)
+		}
+		token.UserID = userID.String
+		token.TeamName = teamName.String
+		tokens = append(tokens, token)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	return tokens, nil
+}
+
+// RevokeAPIToken Отзыв токена
+func (s *Storage) RevokeAPIToken(tokenID int64) error {
+	const op = "storage.postgresql.RevokeAPIToken"
+
+	res, err := s.db.Exec(`update api_tokens set revoked_at = $2 where id = $1 and revoked_at is null`, tokenID, time.Now())
+	if err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+	rowsAffected, err := res.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+	if rowsAffected == 0 {
+		return storage.ErrTokenNotFound
+	}
+	return nil
+}
@@ -0,0 +1,155 @@
+package postgresql
+
+import (
+	"database/sql"
+	"encoding/json"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/lib/pq"
+	"github.com/sudo-odner/Backend-trainee-assignment-avito-2025/internal/domain"
+)
+
+// addAuditEvent Запись события аудита в рамках транзакции изменения
+func addAuditEvent(tx *sql.Tx, meta domain.ChangeMeta, event domain.AuditEvent) error {
+	before, err := marshalAuditState(event.Before)
+	if err != nil {
+		return fmt.Errorf("marshal audit %s: %w", event.Type, err)
+	}
+	after, err := marshalAuditState(event.After)
+	if err != nil {
+		return fmt.Errorf("marshal audit %s: %w", event.Type, err)
+	}
+	if event.UserIDs == nil {
+		event.UserIDs = []string{}
+	}
+
+	_, err = tx.Exec(`
+	insert into audit_events (event_type, pull_request_id, user_ids, team_name, actor, reason, before, after, created_at)
+	values ($1, $2, $3, $4, $5, $6, $7, $8, $9)`,
+		event.Type, nullString(event.PullRequestID), pq.Array(event.UserIDs), nullString(event.TeamName),
+		meta.Actor, nullString(meta.Reason), before, after, time.Now())
+	if err != nil {
+		return fmt.Errorf("insert audit %s: %w", event.Type, err)
+	}
+	return nil
+}
+
+// GetAuditEvents Получение событий аудита по фильтрам (в хронологическом порядке, Limit 0 - без ограничения)
+func (s *Storage) GetAuditEvents(filter domain.AuditFilter) ([]domain.AuditEvent, error) {
+	const op = "storage.postgresql.GetAuditEvents"
+
+	events := make([]domain.AuditEvent, 0)
+	err := s.EachAuditEvent(filter, func(event domain.AuditEvent) error {
+		events = append(events, event)
+		return nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	return events, nil
+}
+
+// GetLastAuditEventID Id последнего события аудита (0 - событий нет)
+func (s *Storage) GetLastAuditEventID() (int64, error) {
+	const op = "storage.postgresql.GetLastAuditEventID"
+
+	var id int64
+	if err := s.db.QueryRow(`select coalesce(max(id), 0) from audit_events`).Scan(&id); err != nil {
+		return 0, fmt.Errorf("%s: %w", op, err)
+	}
+	return id, nil
+}
+
+// EachAuditEvent Построчный обход событий аудита без загрузки всей выборки в память
+func (s *Storage) EachAuditEvent(filter domain.AuditFilter, fn func(event domain.AuditEvent) error) error {
+	const op = "storage.postgresql.EachAuditEvent"
+
+	rows, err := s.db.Query(`
+	select id, event_type, coalesce(pull_request_id, ''), user_ids, coalesce(team_name, ''),
+	       actor, coalesce(reason, ''), before, after, created_at
+	from audit_events
+	where ($1 = '' or pull_request_id = $1)
+	and ($2 = '' or $2 = any(user_ids))
+	and ($3 = '' or team_name = $3)
+	and ($4::timestamp is null or created_at >= $4)
+	and ($5::timestamp is null or created_at < $5)
+	and id > $6
+	and ($8::text[] is null or event_type = any($8))
+	order by id
+	limit $7`,
+		filter.PullRequestID, filter.UserID, filter.TeamName,
+		nullTime(filter.From), nullTime(filter.To), filter.AfterID,
+		sql.NullInt64{Int64: int64(filter.Limit), Valid: filter.Limit > 0},
+		pq.Array(filter.Types))
+	if err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+	defer func() {
+		if err := rows.Close(); err != nil {
+			log.Printf("rows close failed: %v", err)
+		}
+	}()
+
+	for rows.Next() {
+		var event domain.AuditEvent
+		var before, after []byte
+		if err := rows.Scan(
+			&event.ID, &event.Type, &event.PullRequestID, pq.Array(&event.UserIDs), &event.TeamName,
+			&event.Actor, &event.Reason, &before, &after, &event.CreatedAt); err != nil {
+			return fmt.Errorf("%s: %w", op, err)
+		}
+		if event.Before, err = unmarshalAuditState(before); err != nil {
+			return fmt.Errorf("%s: %w", op, err)
+		}
+		if event.After, err = unmarshalAuditState(after); err != nil {
+			return fmt.Errorf("%s: %w", op, err)
+		}
+		if err := fn(event); err != nil {
+			return err
+		}
+	}
+	if err := rows.Err(); err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+	return nil
+}
+
+func marshalAuditState(state map[string]any) (sql.NullString, error) {
+	if state == nil {
+		return sql.NullString{}, nil
+	}
+	data, err := json.Marshal(state)
+	if err != nil {
+		return sql.NullString{}, err
+	}
+	return sql.NullString{String: string(data), Valid: true}, nil
+}
+
+func unmarshalAuditState(data []byte) (map[string]any, error) {
+	if data == nil {
+		return nil, nil
+	}
+	var state map[string]any
+	if err := json.Unmarshal(data, &state); err != nil {
+		return nil, err
+	}
+	return state, nil
+}
+
+func nullString(value string) sql.NullString {
+	return sql.NullString{String: value, Valid: value != ""}
+}
+
+func nullTime(value time.Time) sql.NullTime {
+	return sql.NullTime{Time: value, Valid: !value.IsZero()}
+}
+
+// nullTimeValue Значение времени для JSON состояния аудита (nil, если не задано)
+func nullTimeValue(value sql.NullTime) any {
+	if !value.Valid {
+		return nil
+	}
+	return value.Time
+}
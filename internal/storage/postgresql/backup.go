@@ -0,0 +1,210 @@
+package postgresql
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"log"
+
+	"github.com/sudo-odner/Backend-trainee-assignment-avito-2025/internal/domain"
+	"github.com/sudo-odner/Backend-trainee-assignment-avito-2025/internal/storage"
+)
+
+// DumpSnapshot Согласованный срез всех данных (одна транзакция repeatable read)
+func (s *Storage) DumpSnapshot() (*domain.Snapshot, error) {
+	const op = "storage.postgresql.DumpSnapshot"
+
+	tx, err := s.db.BeginTx(context.Background(), &sql.TxOptions{Isolation: sql.LevelRepeatableRead, ReadOnly: true})
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	defer func() {
+		if err := tx.Rollback(); err != nil && err != sql.ErrTxDone {
+			log.Printf("tx rollback failed: %v", err)
+		}
+	}()
+
+	snapshot := &domain.Snapshot{
+		Users:        []domain.User{},
+		Teams:        []domain.Team{},
+		PullRequests: []domain.PullRequest{},
+		Reviewers:    []domain.ReviewAssignment{},
+	}
+
+	// Пользователи
+	err = eachRow(tx, `select id, name, is_active from users order by id`, func(rows *sql.Rows) error {
+		var user domain.User
+		if err := rows.Scan(&user.ID, &user.Name, &user.IsActive); err != nil {
+			return err
+		}
+		snapshot.Users = append(snapshot.Users, user)
+		return nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	// Команды и участники
+	err = eachRow(tx, `
+	select t.name, tu.user_id
+	from teams t
+	left join teams_users tu on tu.team_name = t.name
+	order by t.name, tu.internal_id`, func(rows *sql.Rows) error {
+		var teamName string
+		var userID sql.NullString
+		if err := rows.Scan(&teamName, &userID); err != nil {
+			return err
+		}
+		if len(snapshot.Teams) == 0 || snapshot.Teams[len(snapshot.Teams)-1].Name != teamName {
+			snapshot.Teams = append(snapshot.Teams, domain.Team{Name: teamName, Users: []domain.User{}})
+		}
+		if userID.Valid {
+			team := &snapshot.Teams[len(snapshot.Teams)-1]
+			team.Users = append(team.Users, domain.User{ID: userID.String})
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	// PR
+	err = eachRow(tx, `
+	select id, name, author_id, status, created_at, merged_at
+	from pull_requests
+	order by internal_id`, func(rows *sql.Rows) error {
+		var pr domain.PullRequest
+		var createdAt, mergedAt sql.NullTime
+		if err := rows.Scan(&pr.ID, &pr.Name, &pr.Author.ID, &pr.Status, &createdAt, &mergedAt); err != nil {
+			return err
+		}
+		pr.CreatedAt = createdAt.Time
+		pr.MergedAt = mergedAt.Time
+		snapshot.PullRequests = append(snapshot.PullRequests, pr)
+		return nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	// Назначения reviewer
+	err = eachRow(tx, `
+	select pull_request_id, reviewer_id, assigned_at, due_at, overdue_at
+	from pr_reviewers
+	order by internal_id`, func(rows *sql.Rows) error {
+		var review domain.ReviewAssignment
+		var dueAt, overdueAt sql.NullTime
+		if err := rows.Scan(&review.PullRequestID, &review.ReviewerID, &review.AssignedAt, &dueAt, &overdueAt); err != nil {
+			return err
+		}
+		review.DueAt = dueAt.Time
+		review.OverdueAt = overdueAt.Time
+		snapshot.Reviewers = append(snapshot.Reviewers, review)
+		return nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	return snapshot, nil
+}
+
+// RestoreSnapshot Восстановление среза данных в пустую БД одной транзакцией
+func (s *Storage) RestoreSnapshot(snapshot *domain.Snapshot, meta domain.ChangeMeta) error {
+	const op = "storage.postgresql.RestoreSnapshot"
+
+	tx, err := s.db.BeginTx(context.Background(), nil)
+	if err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+	defer func() {
+		if err := tx.Rollback(); err != nil && err != sql.ErrTxDone {
+			log.Printf("tx rollback failed: %v", err)
+		}
+	}()
+
+	// Восстановление только в пустую БД
+	var notEmpty bool
+	err = tx.QueryRow(`
+	select exists(select 1 from users) or exists(select 1 from teams) or exists(select 1 from pull_requests)`).Scan(&notEmpty)
+	if err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+	if notEmpty {
+		return storage.ErrStorageNotEmpty
+	}
+
+	for _, user := range snapshot.Users {
+		if _, err := tx.Exec(`insert into users (id, name, is_active) values ($1, $2, $3)`,
+			user.ID, user.Name, user.IsActive); err != nil {
+			return fmt.Errorf("%s: user %s: %w", op, user.ID, err)
+		}
+	}
+	for _, team := range snapshot.Teams {
+		if _, err := tx.Exec(`insert into teams (name) values ($1)`, team.Name); err != nil {
+			return fmt.Errorf("%s: team %s: %w", op, team.Name, err)
+		}
+		for _, user := range team.Users {
+			if _, err := tx.Exec(`insert into teams_users (team_name, user_id) values ($1, $2)`,
+				team.Name, user.ID); err != nil {
+				return fmt.Errorf("%s: team %s: %w", op, team.Name, err)
+			}
+		}
+	}
+	for _, pr := range snapshot.PullRequests {
+		if _, err := tx.Exec(`
+		insert into pull_requests (id, name, author_id, status, created_at, merged_at)
+		values ($1, $2, $3, $4, $5, $6)`,
+			pr.ID, pr.Name, pr.Author.ID, pr.Status, nullTime(pr.CreatedAt), nullTime(pr.MergedAt)); err != nil {
+			return fmt.Errorf("%s: pull request %s: %w", op, pr.ID, err)
+		}
+	}
+	for _, review := range snapshot.Reviewers {
+		if _, err := tx.Exec(`
+		insert into pr_reviewers (pull_request_id, reviewer_id, assigned_at, due_at, overdue_at)
+		values ($1, $2, $3, $4, $5)`,
+			review.PullRequestID, review.ReviewerID, review.AssignedAt,
+			nullTime(review.DueAt), nullTime(review.OverdueAt)); err != nil {
+			return fmt.Errorf("%s: reviewer %s of %s: %w", op, review.ReviewerID, review.PullRequestID, err)
+		}
+	}
+
+	if err := addAuditEvent(tx, meta, domain.AuditEvent{
+		Type: domain.AuditBackupRestored,
+		After: map[string]any{
+			"users":         len(snapshot.Users),
+			"teams":         len(snapshot.Teams),
+			"pull_requests": len(snapshot.PullRequests),
+			"reviewers":     len(snapshot.Reviewers),
+		},
+	}); err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+	return nil
+}
+
+// eachRow Выполнение запроса в транзакции с обработкой каждой строки
+func eachRow(tx *sql.Tx, query string, fn func(rows *sql.Rows) error, args ...any) error {
+	rows, err := tx.Query(query, args...)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		if err := rows.Close(); err != nil {
+			log.Printf("rows close failed: %v", err)
+		}
+	}()
+	for rows.Next() {
+		if err := fn(rows); err != nil {
+			return err
+		}
+	}
+	return rows.Err()
+}
@@ -0,0 +1,183 @@
+package postgresql
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/sudo-odner/Backend-trainee-assignment-avito-2025/internal/domain"
+	"github.com/sudo-odner/Backend-trainee-assignment-avito-2025/internal/storage"
+)
+
+// DeclineReview Отказ reviewer от ревью с причиной и автоматической заменой
+func (s *Storage) DeclineReview(prID, reviewerID, reason string, meta domain.ChangeMeta) (*domain.PullRequest, string, error) {
+	const op = "storage.postgresql.DeclineReview"
+
+	tx, err := s.db.BeginTx(context.Background(), nil)
+	if err != nil {
+		return nil, "", fmt.Errorf("%s: %w", op, err)
+	}
+	defer func() {
+		if err := tx.Rollback(); err != nil && err != sql.ErrTxDone {
+			log.Printf("tx rollback failed: %v", err)
+		}
+	}()
+
+	// Получаем пользователя (проверка на его существования)
+	if _, err := s.GetUserByID(reviewerID); err != nil {
+		return nil, "", fmt.Errorf("%s: %w", op, err)
+	}
+
+	// Блокируем PR и проверка на MERGE PR
+	status, err := lockPR(tx, prID, meta)
+	if err != nil {
+		return nil, "", fmt.Errorf("%s: %w", op, err)
+	}
+	if status == "MERGED" {
+		return nil, "", storage.ErrPRAlreadyMerged
+	}
+
+	// Проверка на то что пользователь назначен как reviewer
+	if err := s.IsUserReviewerPR(prID, reviewerID); err != nil {
+		return nil, "", fmt.Errorf("%s: %w", op, err)
+	}
+
+	// Получаем название команды у reviewer
+	teamName, err := s.GetUserTeamByID(reviewerID)
+	if err != nil {
+		return nil, "", fmt.Errorf("%s: %w", op, err)
+	}
+
+	// Находим случайного активного пользователя из команды, исключая автора,
+	// текущих reviewer и всех, кто уже отказывался от этого PR (включая текущего)
+	var newReviewerID sql.NullString
+	err = tx.QueryRow(`
+	select u.id
+	from teams_users tu
+	join users u on tu.user_id = u.id
+	where tu.team_name = $1 and u.is_active = true and u.id <> $2
+	and u.id <> (select author_id from pull_requests where id = $3)
+	and u.id not in (select reviewer_id from pr_reviewers where pull_request_id = $3)
+	and u.id not in (select reviewer_id from pr_reviewer_declines where pull_request_id = $3)
+	order by random() limit 1`, teamName, reviewerID, prID).Scan(&newReviewerID)
+	if err != nil && !errors.Is(err, sql.ErrNoRows) {
+		return nil, "", fmt.Errorf("%s: %w", op, err)
+	}
+
+	// Заменяем reviewer, если кандидата нет - просто снимаем отказавшегося
+	var dueAt sql.NullTime
+	if newReviewerID.Valid {
+		assignedAt := time.Now()
+		dueAt = s.reviewDueAt(teamName, assignedAt)
+		_, err = tx.Exec(
+			`update pr_reviewers set reviewer_id = $1, assigned_at = $4, due_at = $5, overdue_at = null
+			where pull_request_id = $2 and reviewer_id = $3`,
+			newReviewerID.String, prID, reviewerID, assignedAt, dueAt)
+	} else {
+		_, err = tx.Exec(
+			`delete from pr_reviewers where pull_request_id = $1 and reviewer_id = $2`,
+			prID, reviewerID)
+	}
+	if err != nil {
+		return nil, "", fmt.Errorf("%s: %w", op, err)
+	}
+	if err := bumpPRVersion(tx, prID); err != nil {
+		return nil, "", fmt.Errorf("%s: %w", op, err)
+	}
+
+	// Записываем отказ в историю
+	_, err = tx.Exec(
+		`insert into pr_reviewer_declines (pull_request_id, reviewer_id, reason, replaced_by) values ($1, $2, $3, $4)`,
+		prID, reviewerID, reason, newReviewerID)
+	if err != nil {
+		return nil, "", fmt.Errorf("%s: %w", op, err)
+	}
+
+	var replacedBy *string
+	if newReviewerID.Valid {
+		replacedBy = &newReviewerID.String
+	}
+	if err := addOutboxEvent(tx, domain.EventReviewerDeclined, reviewerDeclinedPayload{
+		PullRequestID: prID,
+		ReviewerID:    reviewerID,
+		Reason:        reason,
+		ReplacedBy:    replacedBy,
+	}); err != nil {
+		return nil, "", fmt.Errorf("%s: %w", op, err)
+	}
+
+	// В аудите отказ фиксируется с причиной, пользователь - отказавшийся и его замена
+	userIDs := []string{reviewerID}
+	if newReviewerID.Valid {
+		userIDs = append(userIDs, newReviewerID.String)
+	}
+	meta.Reason = reason
+	if err := addAuditEvent(tx, meta, domain.AuditEvent{
+		Type:          domain.AuditReviewerDeclined,
+		PullRequestID: prID,
+		UserIDs:       userIDs,
+		TeamName:      teamName,
+		Before:        map[string]any{"reviewer_id": reviewerID},
+		After:         map[string]any{"reviewer_id": replacedBy, "due_at": nullTimeValue(dueAt)},
+	}); err != nil {
+		return nil, "", fmt.Errorf("%s: %w", op, err)
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, "", fmt.Errorf("%s: %w", op, err)
+	}
+
+	// Получаем обновлённый PR с reviewer
+	pr, err := s.GetPRByID(prID)
+	if err != nil {
+		return nil, "", fmt.Errorf("%s: %w", op, err)
+	}
+	return pr, newReviewerID.String, nil
+}
+
+// GetPRDeclines История отказов reviewer от PR
+func (s *Storage) GetPRDeclines(prID string) ([]domain.ReviewerDecline, error) {
+	const op = "storage.postgresql.GetPRDeclines"
+
+	// Проверка на существование PR
+	var exists bool
+	if err := s.db.QueryRow(`select exists(select 1 from pull_requests where id = $1)`, prID).Scan(&exists); err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	if !exists {
+		return nil, storage.ErrPRNotFound
+	}
+
+	rows, err := s.db.Query(`
+	select pull_request_id, reviewer_id, reason, replaced_by, declined_at
+	from pr_reviewer_declines
+	where pull_request_id = $1
+	order by declined_at, internal_id`, prID)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	defer func() {
+		if err := rows.Close(); err != nil {
+			log.Printf("rows close failed: %v", err)
+		}
+	}()
+
+	declines := make([]domain.ReviewerDecline, 0)
+	for rows.Next() {
+		var decline domain.ReviewerDecline
+		var replacedBy sql.NullString
+		if err := rows.Scan(&decline.PullRequestID, &decline.ReviewerID, &decline.Reason, &replacedBy, &decline.DeclinedAt); err != nil {
+			return nil, fmt.Errorf("%s: %w", op, err)
+		}
+		decline.ReplacedBy = replacedBy.String
+		declines = append(declines, decline)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return declines, nil
+}
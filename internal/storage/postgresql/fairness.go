@@ -0,0 +1,181 @@
+package postgresql
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/lib/pq"
+	"github.com/sudo-odner/Backend-trainee-assignment-avito-2025/internal/domain"
+	"github.com/sudo-odner/Backend-trainee-assignment-avito-2025/internal/fairness"
+)
+
+// GetTeamLoads Количество назначений на ревью у активных участников команд (окно по времени назначения)
+func (s *Storage) GetTeamLoads(filter domain.StatFilter) ([]domain.TeamLoad, error) {
+	const op = "storage.postgresql.GetTeamLoads"
+
+	rows, err := s.db.Query(`
+	select tu.team_name, u.id, count(x.reviewer_id)
+	from teams_users tu
+	join users u on u.id = tu.user_id and u.is_active = true
+	left join (
+	    select r.reviewer_id
+	    from pr_reviewers r
+	    join pull_requests pr on pr.id = r.pull_request_id
+	    where ($1::timestamp is null or r.assigned_at >= $1)
+	    and ($2::timestamp is null or r.assigned_at < $2)
+	    and ($3 = '' or pr.status = $3)
+	) x on x.reviewer_id = u.id
+	where ($4 = '' or tu.team_name = $4)
+	group by tu.team_name, u.id
+	order by tu.team_name, u.id`, nullTime(filter.From), nullTime(filter.To), filter.Status, filter.TeamName)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	defer func() {
+		if err := rows.Close(); err != nil {
+			log.Printf("rows close failed: %v", err)
+		}
+	}()
+
+	teams := make([]domain.TeamLoad, 0)
+	for rows.Next() {
+		var teamName string
+		var load domain.UserLoad
+		if err := rows.Scan(&teamName, &load.UserID, &load.ReviewCount); err != nil {
+			return nil, fmt.Errorf("%s: %w", op, err)
+		}
+		if len(teams) == 0 || teams[len(teams)-1].TeamName != teamName {
+			teams = append(teams, domain.TeamLoad{TeamName: teamName})
+		}
+		teams[len(teams)-1].Members = append(teams[len(teams)-1].Members, load)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	return teams, nil
+}
+
+// RebalanceTeam Перенос открытых ревью от самых загруженных активных участников команды к наименее загруженным.
+// При dryRun изменения не применяются, возвращается только план
+func (s *Storage) RebalanceTeam(teamName string, dryRun bool, meta domain.ChangeMeta) ([]domain.RebalanceMove, error) {
+	const op = "storage.postgresql.RebalanceTeam"
+
+	tx, err := s.db.BeginTx(context.Background(), nil)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	defer func() {
+		if err := tx.Rollback(); err != nil && err != sql.ErrTxDone {
+			log.Printf("tx rollback failed: %v", err)
+		}
+	}()
+
+	// Проверка на существоание команды
+	if err := s.IsTeamExists(teamName); err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	// Блокируем активных участников, чтобы состав не менялся во время переноса
+	activeIDs, err := queryStrings(tx, `
+	select u.id
+	from users u
+	join teams_users tu on u.id = tu.user_id
+	where tu.team_name = $1 and u.is_active = true
+	order by u.id
+	for update of u`, teamName)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	// Нагрузка участников по открытым PR
+	members := make([]domain.UserLoad, 0, len(activeIDs))
+	for _, userID := range activeIDs {
+		load := domain.UserLoad{UserID: userID}
+		err := tx.QueryRow(`
+		select count(*)
+		from pr_reviewers r
+		join pull_requests pr on pr.id = r.pull_request_id
+		where r.reviewer_id = $1 and pr.status = 'OPEN'`, userID).Scan(&load.ReviewCount)
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", op, err)
+		}
+		members = append(members, load)
+	}
+
+	// Открытые PR, на которые назначены участники команды
+	rows, err := tx.Query(`
+	select pr.id, pr.author_id, array_agg(r.reviewer_id order by r.reviewer_id)
+	from pull_requests pr
+	join pr_reviewers r on r.pull_request_id = pr.id
+	where pr.status = 'OPEN'
+	and pr.id in (select pull_request_id from pr_reviewers where reviewer_id = any($1))
+	group by pr.id, pr.author_id
+	order by pr.id`, pq.Array(activeIDs))
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	reviews := make([]fairness.Review, 0)
+	for rows.Next() {
+		var review fairness.Review
+		if err := rows.Scan(&review.PullRequestID, &review.AuthorID, pq.Array(&review.Reviewers)); err != nil {
+			_ = rows.Close()
+			return nil, fmt.Errorf("%s: %w", op, err)
+		}
+		reviews = append(reviews, review)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	moves := fairness.Plan(members, reviews)
+	if dryRun || len(moves) == 0 {
+		return moves, nil
+	}
+
+	if meta.Reason == "" {
+		meta.Reason = "team rebalance"
+	}
+	for _, move := range moves {
+		// Новое назначение получает новый срок ревью
+		assignedAt := time.Now()
+		dueAt := s.reviewDueAt(teamName, assignedAt)
+		_, err = tx.Exec(
+			`update pr_reviewers set reviewer_id=$1, assigned_at=$4, due_at=$5, overdue_at=null where pull_request_id=$2 AND reviewer_id=$3`,
+			move.ToReviewerID, move.PullRequestID, move.FromReviewerID, assignedAt, dueAt)
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", op, err)
+		}
+		if err := bumpPRVersion(tx, move.PullRequestID); err != nil {
+			return nil, fmt.Errorf("%s: %w", op, err)
+		}
+
+		if err := addOutboxEvent(tx, domain.EventReviewerReassigned, reviewerReassignedPayload{
+			PullRequestID: move.PullRequestID,
+			OldReviewerID: move.FromReviewerID,
+			NewReviewerID: move.ToReviewerID,
+		}); err != nil {
+			return nil, fmt.Errorf("%s: %w", op, err)
+		}
+		if err := addAuditEvent(tx, meta, domain.AuditEvent{
+			Type:          domain.AuditReviewerReassigned,
+			PullRequestID: move.PullRequestID,
+			UserIDs:       []string{move.FromReviewerID, move.ToReviewerID},
+			TeamName:      teamName,
+			Before:        map[string]any{"reviewer_id": move.FromReviewerID},
+			After:         map[string]any{"reviewer_id": move.ToReviewerID, "due_at": nullTimeValue(dueAt)},
+		}); err != nil {
+			return nil, fmt.Errorf("%s: %w", op, err)
+		}
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	return moves, nil
+}
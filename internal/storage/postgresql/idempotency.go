@@ -0,0 +1,96 @@
+package postgresql
+
+import (
+	"database/sql"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/sudo-odner/Backend-trainee-assignment-avito-2025/internal/domain"
+)
+
+// ReserveIdempotencyKey Резервирование ключа клиента principal для первого запроса.
+// Если ключ уже есть (и не истёк), возвращается сохранённый ответ или признак обработки
+func (s *Storage) ReserveIdempotencyKey(key, principal, route, bodyHash string, now time.Time, ttl time.Duration) (*domain.IdempotentResponse, error) {
+	const op = "storage.postgresql.ReserveIdempotencyKey"
+
+	// Истёкший ключ можно использовать заново
+	if _, err := s.db.Exec(
+		`delete from idempotency_keys where key = $1 and principal = $2 and route = $3 and expires_at <= $4`,
+		key, principal, route, now); err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	res, err := s.db.Exec(`
+	insert into idempotency_keys (key, principal, route, body_hash, created_at, expires_at)
+	values ($1, $2, $3, $4, $5, $6)
+	on conflict (key, principal, route) do nothing`, key, principal, route, bodyHash, now, now.Add(ttl))
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	reserved, err := res.RowsAffected()
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	if reserved > 0 {
+		return nil, nil
+	}
+
+	var response domain.IdempotentResponse
+	var statusCode sql.NullInt64
+	var contentType sql.NullString
+	err = s.db.QueryRow(`
+	select body_hash, status_code, content_type, response_body
+	from idempotency_keys
+	where key = $1 and principal = $2 and route = $3`, key, principal, route).Scan(
+		&response.BodyHash, &statusCode, &contentType, &response.Body)
+	if err != nil {
+		// Ключ удалили между вставкой и чтением (ответ с ошибкой сервера) - считаем обработкой
+		if errors.Is(err, sql.ErrNoRows) {
+			return &domain.IdempotentResponse{BodyHash: bodyHash, InProgress: true}, nil
+		}
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	response.StatusCode = int(statusCode.Int64)
+	response.ContentType = contentType.String
+	response.InProgress = !statusCode.Valid
+	return &response, nil
+}
+
+// CompleteIdempotencyKey Сохранение ответа на первый запрос
+func (s *Storage) CompleteIdempotencyKey(key, principal, route string, statusCode int, contentType string, body []byte) error {
+	const op = "storage.postgresql.CompleteIdempotencyKey"
+
+	_, err := s.db.Exec(`
+	update idempotency_keys set status_code = $4, content_type = $5, response_body = $6
+	where key = $1 and principal = $2 and route = $3`, key, principal, route, statusCode, contentType, body)
+	if err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+	return nil
+}
+
+// ReleaseIdempotencyKey Освобождение ключа (первый запрос не выполнен, повтор допустим)
+func (s *Storage) ReleaseIdempotencyKey(key, principal, route string) error {
+	const op = "storage.postgresql.ReleaseIdempotencyKey"
+
+	if _, err := s.db.Exec(`delete from idempotency_keys where key = $1 and principal = $2 and route = $3`, key, principal, route); err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+	return nil
+}
+
+// PurgeIdempotencyKeys Удаление истёкших ключей
+func (s *Storage) PurgeIdempotencyKeys(now time.Time) (int, error) {
+	const op = "storage.postgresql.PurgeIdempotencyKeys"
+
+	res, err := s.db.Exec(`delete from idempotency_keys where expires_at <= $1`, now)
+	if err != nil {
+		return 0, fmt.Errorf("%s: %w", op, err)
+	}
+	purged, err := res.RowsAffected()
+	if err != nil {
+		return 0, fmt.Errorf("%s: %w", op, err)
+	}
+	return int(purged), nil
+}
@@ -0,0 +1,175 @@
+package postgresql
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"log"
+
+	"github.com/sudo-odner/Backend-trainee-assignment-avito-2025/internal/domain"
+)
+
+// ImportTeams Импорт состава команд одной транзакцией: создание команд, создание/обновление пользователей
+// и перенос их между командами. При dryRun изменения откатываются, возвращается только итог
+func (s *Storage) ImportTeams(teams []domain.Team, dryRun bool, meta domain.ChangeMeta) (*domain.ImportSummary, error) {
+	const op = "storage.postgresql.ImportTeams"
+
+	tx, err := s.db.BeginTx(context.Background(), nil)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	defer func() {
+		if err := tx.Rollback(); err != nil && err != sql.ErrTxDone {
+			log.Printf("tx rollback failed: %v", err)
+		}
+	}()
+
+	summary := &domain.ImportSummary{
+		CreatedTeams: []string{},
+		Created:      []string{},
+		Updated:      []string{},
+		Moved:        []string{},
+		Unchanged:    []string{},
+	}
+	for _, team := range teams {
+		res, err := tx.Exec(`insert into teams (name) values ($1) on conflict(name) do nothing`, team.Name)
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", op, err)
+		}
+		created, err := res.RowsAffected()
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", op, err)
+		}
+		if created > 0 {
+			summary.CreatedTeams = append(summary.CreatedTeams, team.Name)
+		}
+
+		changed := map[string][]string{}
+		changedIDs := make([]string, 0)
+		deactivatedIDs := make([]string, 0)
+		for _, user := range team.Users {
+			change, deactivated, err := importUser(tx, team.Name, user)
+			if err != nil {
+				return nil, fmt.Errorf("%s: %w", op, err)
+			}
+			if deactivated {
+				deactivatedIDs = append(deactivatedIDs, user.ID)
+			}
+			switch change {
+			case "created":
+				summary.Created = append(summary.Created, user.ID)
+			case "moved":
+				summary.Moved = append(summary.Moved, user.ID)
+			case "updated":
+				summary.Updated = append(summary.Updated, user.ID)
+			default:
+				summary.Unchanged = append(summary.Unchanged, user.ID)
+				continue
+			}
+			changed[change] = append(changed[change], user.ID)
+			changedIDs = append(changedIDs, user.ID)
+		}
+
+		if created == 0 && len(changedIDs) == 0 {
+			continue
+		}
+		after := map[string]any{"team_created": created > 0}
+		for change, userIDs := range changed {
+			after[change] = userIDs
+		}
+		if err := addAuditEvent(tx, meta, domain.AuditEvent{
+			Type:     domain.AuditTeamImported,
+			UserIDs:  changedIDs,
+			TeamName: team.Name,
+			After:    after,
+		}); err != nil {
+			return nil, fmt.Errorf("%s: %w", op, err)
+		}
+
+		// Деактивированные импортом снимаются с ревью так же, как при деактивации команды
+		if err := deactivateImportedUsers(tx, team.Name, deactivatedIDs, meta); err != nil {
+			return nil, fmt.Errorf("%s: %w", op, err)
+		}
+	}
+
+	if dryRun {
+		return summary, nil
+	}
+	if err := tx.Commit(); err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	return summary, nil
+}
+
+// deactivateImportedUsers Аудит деактивации пользователей и снятие их с ревью открытых PR
+func deactivateImportedUsers(tx *sql.Tx, teamName string, userIDs []string, meta domain.ChangeMeta) error {
+	if len(userIDs) == 0 {
+		return nil
+	}
+	if meta.Reason == "" {
+		meta.Reason = "deactivated by import"
+	}
+	for _, userID := range userIDs {
+		if err := addAuditEvent(tx, meta, domain.AuditEvent{
+			Type:     domain.AuditUserDeactivated,
+			UserIDs:  []string{userID},
+			TeamName: teamName,
+			Before:   map[string]any{"is_active": true},
+			After:    map[string]any{"is_active": false},
+		}); err != nil {
+			return err
+		}
+	}
+	_, err := removeDeactivatedReviewers(tx, teamName, userIDs, meta)
+	return err
+}
+
+// importUser Создание/обновление пользователя и его членства в команде, возвращает вид изменения
+// (created, moved, updated или unchanged) и признак деактивации активного пользователя
+func importUser(tx *sql.Tx, teamName string, user domain.User) (string, bool, error) {
+	var name, currentTeam string
+	var isActive bool
+	err := tx.QueryRow(`
+	select u.name, u.is_active, coalesce(tu.team_name, '')
+	from users u
+	left join teams_users tu on tu.user_id = u.id
+	where u.id = $1
+	limit 1
+	for update of u`, user.ID).Scan(&name, &isActive, &currentTeam)
+	if err != nil && !errors.Is(err, sql.ErrNoRows) {
+		return "", false, err
+	}
+	exists := err == nil
+
+	change := "unchanged"
+	switch {
+	case !exists:
+		change = "created"
+	case currentTeam != teamName:
+		change = "moved"
+	case name != user.Name || isActive != user.IsActive:
+		change = "updated"
+	}
+	if change == "unchanged" {
+		return change, false, nil
+	}
+
+	if _, err := tx.Exec(`
+	insert into users (id, name, is_active) values ($1, $2, $3)
+	on conflict (id) do update set
+	    name = EXCLUDED.name,
+	    is_active = EXCLUDED.is_active`, user.ID, user.Name, user.IsActive); err != nil {
+		return "", false, err
+	}
+	// У пользователя одна команда
+	if currentTeam != teamName {
+		if _, err := tx.Exec(`delete from teams_users where user_id = $1`, user.ID); err != nil {
+			return "", false, err
+		}
+		if _, err := tx.Exec(`insert into teams_users (team_name, user_id) values ($1, $2)`, teamName, user.ID); err != nil {
+			return "", false, err
+		}
+	}
+	return change, exists && isActive && !user.IsActive, nil
+}
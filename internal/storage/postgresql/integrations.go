@@ -0,0 +1,34 @@
+package postgresql
+
+import (
+	"fmt"
+	"time"
+)
+
+// RegisterIntegrationDelivery Регистрация доставки внешнего webhook (false - уже обрабатывалась)
+func (s *Storage) RegisterIntegrationDelivery(provider, deliveryID string) (bool, error) {
+	const op = "storage.postgresql.RegisterIntegrationDelivery"
+
+	res, err := s.db.Exec(`
+	insert into integration_deliveries (provider, delivery_id, received_at) values ($1, $2, $3)
+	on conflict do nothing`, provider, deliveryID, time.Now())
+	if err != nil {
+		return false, fmt.Errorf("%s: %w", op, err)
+	}
+	rowsAffected, err := res.RowsAffected()
+	if err != nil {
+		return false, fmt.Errorf("%s: %w", op, err)
+	}
+	return rowsAffected > 0, nil
+}
+
+// ForgetIntegrationDelivery Удаление регистрации доставки (для повторной обработки после ошибки)
+func (s *Storage) ForgetIntegrationDelivery(provider, deliveryID string) error {
+	const op = "storage.postgresql.ForgetIntegrationDelivery"
+
+	_, err := s.db.Exec(`delete from integration_deliveries where provider = $1 and delivery_id = $2`, provider, deliveryID)
+	if err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+	return nil
+}
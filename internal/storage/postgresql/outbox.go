@@ -0,0 +1,59 @@
+package postgresql
+
+import (
+	"database/sql"
+	"encoding/json"
+	"fmt"
+	"time"
+)
+
+// Payload доменных событий, записываемых в outbox
+type reviewersAssignedPayload struct {
+	PullRequestID   string   `json:"pull_request_id"`
+	PullRequestName string   `json:"pull_request_name"`
+	AuthorID        string   `json:"author_id"`
+	Reviewers       []string `json:"reviewers"`
+}
+
+type reviewerReassignedPayload struct {
+	PullRequestID string `json:"pull_request_id"`
+	OldReviewerID string `json:"old_reviewer_id"`
+	NewReviewerID string `json:"new_reviewer_id"`
+}
+
+type reviewerDeclinedPayload struct {
+	PullRequestID string  `json:"pull_request_id"`
+	ReviewerID    string  `json:"reviewer_id"`
+	Reason        string  `json:"reason"`
+	ReplacedBy    *string `json:"replaced_by"`
+}
+
+type prMergedPayload struct {
+	PullRequestID string    `json:"pull_request_id"`
+	MergedAt      time.Time `json:"merged_at"`
+}
+
+type removedReview struct {
+	PullRequestID string `json:"pull_request_id"`
+	ReviewerID    string `json:"reviewer_id"`
+}
+
+type teamDeactivatedPayload struct {
+	TeamName       string          `json:"team_name"`
+	UserIDs        []string        `json:"user_ids"`
+	RemovedReviews []removedReview `json:"removed_reviews"`
+}
+
+// addOutboxEvent Запись доменного события в outbox в рамках транзакции изменения
+func addOutboxEvent(tx *sql.Tx, eventType string, payload any) error {
+	data, err := json.Marshal(payload)
+	if err != nil {
+		return fmt.Errorf("marshal event %s: %w", eventType, err)
+	}
+	if _, err := tx.Exec(
+		`insert into outbox_events (event_type, payload, created_at) values ($1, $2, $3)`,
+		eventType, string(data), time.Now()); err != nil {
+		return fmt.Errorf("insert event %s: %w", eventType, err)
+	}
+	return nil
+}
@@ -5,11 +5,13 @@ import (
 
 	"github.com/jmoiron/sqlx"
 	_ "github.com/lib/pq"
+	"github.com/sudo-odner/Backend-trainee-assignment-avito-2025/internal/sla"
 	"github.com/sudo-odner/Backend-trainee-assignment-avito-2025/internal/storage"
 )
 
 type Storage struct {
-	db *sqlx.DB
+	db  *sqlx.DB
+	sla *sla.Policy
 }
 
 func New(host, port, user, password, dbName, sslMode string) (*Storage, error) {
@@ -27,3 +29,13 @@ func New(host, port, user, password, dbName, sslMode string) (*Storage, error) {
 	}
 	return &Storage{db: db}, nil
 }
+
+// SetReviewSLA Установка правил расчёта срока ревью для новых назначений
+func (s *Storage) SetReviewSLA(policy *sla.Policy) {
+	s.sla = policy
+}
+
+// Close Закрытие соединения с БД
+func (s *Storage) Close() error {
+	return s.db.Close()
+}
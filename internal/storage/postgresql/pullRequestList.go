@@ -0,0 +1,280 @@
+package postgresql
+
+import (
+	"database/sql"
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/lib/pq"
+	"github.com/sudo-odner/Backend-trainee-assignment-avito-2025/internal/domain"
+)
+
+// ListPRs Страница списка PR по фильтрам (keyset по ключу сортировки и id).
+// Возвращает курсор следующей страницы, если страница заполнена целиком
+func (s *Storage) ListPRs(filter domain.PRFilter) ([]*domain.PullRequest, *domain.PRCursor, error) {
+	const op = "storage.postgresql.ListPRs"
+
+	// PR без значения ключа сортировки идут первыми (выражения совпадают с индексами)
+	sortExpr := "coalesce(pr.created_at, 'epoch'::timestamp)"
+	if filter.SortBy == domain.PRSortMergedAt {
+		sortExpr = "coalesce(pr.merged_at, 'epoch'::timestamp)"
+	}
+	compare, order := ">", "asc"
+	if filter.Desc {
+		compare, order = "<", "desc"
+	}
+	var afterAt sql.NullTime
+	var afterID string
+	if filter.After != nil {
+		afterAt = sql.NullTime{Time: filter.After.SortAt, Valid: true}
+		afterID = filter.After.ID
+	}
+
+	rows, err := s.db.Query(fmt.Sprintf(`
+	select pr.id, pr.name, pr.status, pr.created_at, pr.merged_at, a.id, a.name, a.is_active,
+	       %[1]s as sort_at
+	from pull_requests pr
+	left join users a on a.id = pr.author_id
+	where ($1 = '' or pr.author_id = $1)
+	and ($2 = '' or exists (
+	    select 1 from pr_reviewers r where r.pull_request_id = pr.id and r.reviewer_id = $2))
+	and ($3 = '' or pr.status = $3)
+	and ($4::timestamp is null or (%[1]s, pr.id) %[2]s ($4::timestamp, $5))
+	and ($7 = '' or exists (
+	    select 1 from teams_users tu where tu.user_id = pr.author_id and tu.team_name = $7))
+	and ($8 = '' or pr.name ilike '%%' || $8 || '%%')
+	and ($9::timestamp is null or pr.created_at >= $9)
+	and ($10::timestamp is null or pr.created_at < $10)
+	and ($11::timestamp is null or pr.merged_at >= $11)
+	and ($12::timestamp is null or pr.merged_at < $12)
+	order by sort_at %[3]s, pr.id %[3]s
+	limit $6`, sortExpr, compare, order),
+		filter.AuthorID, filter.ReviewerID, filter.Status, afterAt, afterID, filter.Limit,
+		filter.TeamName, escapeLike(filter.NameQuery),
+		nullTime(filter.CreatedFrom), nullTime(filter.CreatedTo),
+		nullTime(filter.MergedFrom), nullTime(filter.MergedTo))
+	if err != nil {
+		return nil, nil, fmt.Errorf("%s: %w", op, err)
+	}
+	defer func() {
+		if err := rows.Close(); err != nil {
+			log.Printf("rows close failed: %v", err)
+		}
+	}()
+
+	prs := make([]*domain.PullRequest, 0, filter.Limit)
+	prByID := make(map[string]*domain.PullRequest, filter.Limit)
+	var last domain.PRCursor
+	for rows.Next() {
+		var authorID, authorName sql.NullString
+		var authorIsActive sql.NullBool
+		var createdAt, mergedAt sql.NullTime
+		pr := &domain.PullRequest{Reviewers: []domain.User{}}
+		if err := rows.Scan(
+			&pr.ID, &pr.Name, &pr.Status, &createdAt, &mergedAt,
+			&authorID, &authorName, &authorIsActive, &last.SortAt); err != nil {
+			return nil, nil, fmt.Errorf("%s: %w", op, err)
+		}
+		pr.Author = domain.User{ID: authorID.String, Name: authorName.String, IsActive: authorIsActive.Bool}
+		pr.CreatedAt = createdAt.Time
+		pr.MergedAt = mergedAt.Time
+		last.ID = pr.ID
+		prs = append(prs, pr)
+		prByID[pr.ID] = pr
+	}
+	if err := rows.Err(); err != nil {
+		return nil, nil, fmt.Errorf("%s: %w", op, err)
+	}
+	if len(prs) == 0 {
+		return prs, nil, nil
+	}
+
+	// Reviewers для PR страницы одним запросом
+	if err := s.loadPRReviewers(prByID); err != nil {
+		return nil, nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	if len(prs) < filter.Limit {
+		return prs, nil, nil
+	}
+	return prs, &last, nil
+}
+
+// ListUsersPRs Последние PR сразу нескольких пользователей (reviewer или автор) одним запросом,
+// не больше filter.Limit PR на пользователя, новые первыми
+func (s *Storage) ListUsersPRs(filter domain.UsersPRFilter) (map[string][]*domain.PullRequest, error) {
+	const op = "storage.postgresql.ListUsersPRs"
+
+	match := "exists (select 1 from pr_reviewers r where r.pull_request_id = p.id and r.reviewer_id = u.user_id)"
+	if filter.Role == domain.PRRoleAuthor {
+		match = "p.author_id = u.user_id"
+	}
+	rows, err := s.db.Query(fmt.Sprintf(`
+	select u.user_id, pr.id, pr.name, pr.status, pr.created_at, pr.merged_at, a.id, a.name, a.is_active
+	from unnest($1::text[]) as u(user_id)
+	cross join lateral (
+	    select p.id, p.name, p.status, p.created_at, p.merged_at, p.author_id
+	    from pull_requests p
+	    where %s
+	    and ($2 = '' or p.status = $2)
+	    order by coalesce(p.created_at, 'epoch'::timestamp) desc, p.id desc
+	    limit $3) pr
+	left join users a on a.id = pr.author_id
+	order by u.user_id, coalesce(pr.created_at, 'epoch'::timestamp) desc, pr.id desc`, match),
+		pq.Array(filter.UserIDs), filter.Status, filter.Limit)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	defer func() {
+		if err := rows.Close(); err != nil {
+			log.Printf("rows close failed: %v", err)
+		}
+	}()
+
+	// PR может относиться к нескольким пользователям, такие PR загружаются один раз
+	prsByUser := make(map[string][]*domain.PullRequest, len(filter.UserIDs))
+	prByID := make(map[string]*domain.PullRequest)
+	for rows.Next() {
+		var userID string
+		var authorID, authorName sql.NullString
+		var authorIsActive sql.NullBool
+		var createdAt, mergedAt sql.NullTime
+		pr := &domain.PullRequest{Reviewers: []domain.User{}}
+		if err := rows.Scan(
+			&userID, &pr.ID, &pr.Name, &pr.Status, &createdAt, &mergedAt,
+			&authorID, &authorName, &authorIsActive); err != nil {
+			return nil, fmt.Errorf("%s: %w", op, err)
+		}
+		if loaded, ok := prByID[pr.ID]; ok {
+			pr = loaded
+		} else {
+			pr.Author = domain.User{ID: authorID.String, Name: authorName.String, IsActive: authorIsActive.Bool}
+			pr.CreatedAt = createdAt.Time
+			pr.MergedAt = mergedAt.Time
+			prByID[pr.ID] = pr
+		}
+		prsByUser[userID] = append(prsByUser[userID], pr)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	if len(prByID) == 0 {
+		return prsByUser, nil
+	}
+
+	if err := s.loadPRReviewers(prByID); err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	return prsByUser, nil
+}
+
+// loadPRReviewers Reviewers для PR одним запросом (в порядке назначения)
+func (s *Storage) loadPRReviewers(prByID map[string]*domain.PullRequest) error {
+	ids := make([]string, 0, len(prByID))
+	for id := range prByID {
+		ids = append(ids, id)
+	}
+	rows, err := s.db.Query(`
+	select r.pull_request_id, u.id, u.name, u.is_active
+	from pr_reviewers r
+	join users u on u.id = r.reviewer_id
+	where r.pull_request_id = any($1)
+	order by r.assigned_at, r.internal_id`, pq.Array(ids))
+	if err != nil {
+		return err
+	}
+	defer func() {
+		if err := rows.Close(); err != nil {
+			log.Printf("rows close failed: %v", err)
+		}
+	}()
+	for rows.Next() {
+		var prID string
+		var reviewer domain.User
+		if err := rows.Scan(&prID, &reviewer.ID, &reviewer.Name, &reviewer.IsActive); err != nil {
+			return err
+		}
+		prByID[prID].Reviewers = append(prByID[prID].Reviewers, reviewer)
+	}
+	return rows.Err()
+}
+
+// escapeLike Экранирование спецсимволов шаблона like
+func escapeLike(value string) string {
+	return strings.NewReplacer(`\`, `\\`, "%", `\%`, "_", `\_`).Replace(value)
+}
+
+// EachPullRequest Построчный обход PRs (с reviewers) без загрузки всех в память, authorID "" - все PR
+func (s *Storage) EachPullRequest(authorID string, fn func(pr *domain.PullRequest) error) error {
+	const op = "storage.postgresql.EachPullRequest"
+
+	// Получение PRs + информация об авторе и reviewers (строки одного PR идут подряд)
+	rows, err := s.db.Query(
+		`
+		select pr.id, pr.name, pr.status, pr.created_at, pr.merged_at, a.id, a.name, a.is_active, ru.id, ru.name, ru.is_active
+		from pull_requests pr
+		left join users a on a.id = pr.author_id
+		left join pr_reviewers r on r.pull_request_id = pr.id
+		left join users ru on ru.id = r.reviewer_id
+		where ($1 = '' or pr.author_id = $1)
+		order by pr.id, r.assigned_at, r.internal_id
+		`, authorID)
+	if err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+	defer func() {
+		if err := rows.Close(); err != nil {
+			log.Printf("rows close failed: %v", err)
+		}
+	}()
+
+	var pr *domain.PullRequest
+	for rows.Next() {
+		var prID, prName, prStatus, authorID, authorName, reviewerID, reviewerName sql.NullString
+		var prCreatedAt, prMergedAt sql.NullTime
+		var authorIsActive, reviewerIsActive sql.NullBool
+
+		if err := rows.Scan(
+			&prID, &prName, &prStatus, &prCreatedAt, &prMergedAt,
+			&authorID, &authorName, &authorIsActive,
+			&reviewerID, &reviewerName, &reviewerIsActive); err != nil {
+			return fmt.Errorf("%s: %w", op, err)
+		}
+
+		// Начался следующий PR - отдаём собранный
+		if pr == nil || pr.ID != prID.String {
+			if pr != nil {
+				if err := fn(pr); err != nil {
+					return err
+				}
+			}
+			pr = &domain.PullRequest{
+				ID:   prID.String,
+				Name: prName.String,
+				Author: domain.User{
+					ID:       authorID.String,
+					Name:     authorName.String,
+					IsActive: authorIsActive.Bool,
+				},
+				Status:    prStatus.String,
+				Reviewers: []domain.User{},
+				CreatedAt: prCreatedAt.Time,
+				MergedAt:  prMergedAt.Time,
+			}
+		}
+		if reviewerID.Valid {
+			pr.Reviewers = append(pr.Reviewers, domain.User{
+				ID:       reviewerID.String,
+				Name:     reviewerName.String,
+				IsActive: reviewerIsActive.Bool})
+		}
+	}
+	if err := rows.Err(); err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+	if pr != nil {
+		return fn(pr)
+	}
+	return nil
+}
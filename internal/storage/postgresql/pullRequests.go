@@ -32,54 +32,137 @@ func (s *Storage) IsMergePR(prID string) error {
 	return nil
 }
 
+// lockPR Блокировка PR до конца транзакции и проверка ожидаемой версии (If-Match), возвращает статус PR
+func lockPR(tx *sql.Tx, prID string, meta domain.ChangeMeta) (string, error) {
+	var status string
+	var version int64
+	err := tx.QueryRow(`select status, version from pull_requests where id = $1 for update`, prID).Scan(&status, &version)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return "", storage.ErrPRNotFound
+		}
+		return "", err
+	}
+	if meta.IfVersion != 0 && meta.IfVersion != version {
+		return "", storage.ErrVersionMismatch
+	}
+	return status, nil
+}
+
+// bumpPRVersion Увеличение версии PR после изменения reviewer или статуса
+func bumpPRVersion(tx *sql.Tx, prIDs ...string) error {
+	_, err := tx.Exec(`update pull_requests set version = version + 1 where id = any($1)`, pq.Array(prIDs))
+	return err
+}
+
 // MergePR Создание мердж для pr
-func (s *Storage) MergePR(prID string) error {
+func (s *Storage) MergePR(prID string, meta domain.ChangeMeta) error {
 	const op = "storage.postgresql.MergePR"
 
-	// Проверяем что PR, еще не merged
-	if err := s.IsMergePR(prID); err != nil {
+	tx, err := s.db.BeginTx(context.Background(), nil)
+	if err != nil {
 		return fmt.Errorf("%s: %w", op, err)
 	}
-	// Обновляем merge
-	_, err := s.db.Exec(`update pull_requests set status = $2, merged_at = $3 where id = $1`, prID, "MERGED", time.Now())
+	defer func() {
+		if err := tx.Rollback(); err != nil && err != sql.ErrTxDone {
+			log.Printf("tx rollback failed: %v", err)
+		}
+	}()
+
+	// Блокируем PR и проверяем что он еще не merged
+	status, err := lockPR(tx, prID, meta)
 	if err != nil {
 		return fmt.Errorf("%s: %w", op, err)
 	}
+	if status == "MERGED" {
+		return storage.ErrPRAlreadyMerged
+	}
+
+	// Обновляем merge
+	mergedAt := time.Now()
+	if _, err := tx.Exec(
+		`update pull_requests set status = $2, merged_at = $3, version = version + 1 where id = $1`,
+		prID, "MERGED", mergedAt); err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	if err := addOutboxEvent(tx, domain.EventPRMerged, prMergedPayload{
+		PullRequestID: prID,
+		MergedAt:      mergedAt,
+	}); err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+	if err := addAuditEvent(tx, meta, domain.AuditEvent{
+		Type:          domain.AuditPRMerged,
+		PullRequestID: prID,
+		Before:        map[string]any{"status": "OPEN"},
+		After:         map[string]any{"status": "MERGED", "merged_at": mergedAt},
+	}); err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
 
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
 	return nil
 }
 
+// IsPRInTeam Проверка, что автор PR состоит в команде
+func (s *Storage) IsPRInTeam(prID, teamName string) (bool, error) {
+	const op = "storage.postgresql.IsPRInTeam"
+
+	var exists bool
+	err := s.db.QueryRow(`
+	select exists(
+		select 1 from pull_requests pr
+		join teams_users tu on tu.user_id = pr.author_id
+		where pr.id = $1 and tu.team_name = $2
+	)`, prID, teamName).Scan(&exists)
+	if err != nil {
+		return false, fmt.Errorf("%s: %w", op, err)
+	}
+	return exists, nil
+}
+
 // GetPRByID Получение PR по id
 func (s *Storage) GetPRByID(pullRequestID string) (*domain.PullRequest, error) {
 	const op = "storage.postgresql.GetPRByID"
 
 	querySelectPR := `
-	select pr.id, pr.name, a.id, a.name, a.is_active, pr.status, pr.merged_at, ru.id, ru.name, ru.is_active
+	select pr.id, pr.name, a.id, a.name, a.is_active, pr.status, pr.created_at, pr.merged_at, pr.version,
+	       ru.id, ru.name, ru.is_active
 	from pull_requests pr
 	left join users a on a.id = pr.author_id
 	left join pr_reviewers r on pr.id = r.pull_request_id
 	left join users ru on r.reviewer_id = ru.id
 	where pr.id = $1
-	order by pr.id
+	order by r.assigned_at, r.internal_id
 	`
 
 	rows, err := s.db.Query(querySelectPR, pullRequestID)
 	if err != nil {
 		return nil, fmt.Errorf("%s: %w", op, err)
 	}
+	defer func() {
+		if err := rows.Close(); err != nil {
+			log.Printf("rows close failed: %v", err)
+		}
+	}()
 
 	var pr *domain.PullRequest
 
+	// Одна строка на каждого reviewer
 	reviewersMap := make(map[string]domain.User)
-	if rows.Next() {
+	for rows.Next() {
 		var prID, prName, authorID, authorName, prStatus, reviewerID, reviewerName sql.NullString
 		var authorIsActive, reviewerIsActive sql.NullBool
-		var prMergedAt sql.NullTime
+		var prCreatedAt, prMergedAt sql.NullTime
+		var prVersion int64
 		if err := rows.Scan(
 			&prID,
 			&prName,
 			&authorID, &authorName, &authorIsActive,
-			&prStatus, &prMergedAt,
+			&prStatus, &prCreatedAt, &prMergedAt, &prVersion,
 			&reviewerID, &reviewerName, &reviewerIsActive); err != nil {
 			return nil, fmt.Errorf("%s: %w", op, err)
 		}
@@ -94,7 +177,9 @@ func (s *Storage) GetPRByID(pullRequestID string) (*domain.PullRequest, error) {
 				},
 				Status:    prStatus.String,
 				Reviewers: []domain.User{},
+				CreatedAt: prCreatedAt.Time,
 				MergedAt:  prMergedAt.Time,
+				Version:   prVersion,
 			}
 		}
 
@@ -122,7 +207,7 @@ func (s *Storage) GetPRByID(pullRequestID string) (*domain.PullRequest, error) {
 }
 
 // CreatePRWithReviewers Создание PR c автоматически рандомно назначеными reviewer
-func (s *Storage) CreatePRWithReviewers(prID, prName, authorID string) (*domain.PullRequest, error) {
+func (s *Storage) CreatePRWithReviewers(prID, prName, authorID string, meta domain.ChangeMeta) (*domain.PullRequest, error) {
 	const op = "storage.postgresql.CreatePRWithReviewers"
 	tx, err := s.db.BeginTx(context.Background(), nil)
 	if err != nil {
@@ -141,9 +226,10 @@ func (s *Storage) CreatePRWithReviewers(prID, prName, authorID string) (*domain.
 	}
 
 	// Создаем пулреквест, если уже создан то отменяем все
+	createdAt := time.Now()
 	_, err = tx.Exec(
-		`insert into pull_requests (id, name, author_id, status) values ($1, $2, $3, $4)`,
-		prID, prName, authorID, "OPEN")
+		`insert into pull_requests (id, name, author_id, status, created_at) values ($1, $2, $3, $4, $5)`,
+		prID, prName, authorID, "OPEN", createdAt)
 	if err != nil {
 		if pqErr, ok := err.(*pq.Error); ok && pqErr.Code == "23505" {
 			return nil, storage.ErrPRAlreadyExists
@@ -188,9 +274,48 @@ func (s *Storage) CreatePRWithReviewers(prID, prName, authorID string) (*domain.
 		return nil, fmt.Errorf("%s: %w", op, err)
 	}
 
-	// Создаем связи
+	// Создаем связи со сроком ревью по SLA команды
+	assignedAt := time.Now()
+	dueAt := s.reviewDueAt(nameTeam, assignedAt)
+	for _, r := range reviewers {
+		if _, err := tx.Exec(
+			`insert into pr_reviewers(pull_request_id, reviewer_id, assigned_at, due_at) values($1, $2, $3, $4)`,
+			prID, r.ID, assignedAt, dueAt); err != nil {
+			return nil, fmt.Errorf("%s: %w", op, err)
+		}
+	}
+
+	reviewerIDs := make([]string, 0, len(reviewers))
+	for _, r := range reviewers {
+		reviewerIDs = append(reviewerIDs, r.ID)
+	}
+	if err := addOutboxEvent(tx, domain.EventReviewersAssigned, reviewersAssignedPayload{
+		PullRequestID:   prID,
+		PullRequestName: prName,
+		AuthorID:        authorID,
+		Reviewers:       reviewerIDs,
+	}); err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	// Аудит создания PR и назначения каждого reviewer
+	if err := addAuditEvent(tx, meta, domain.AuditEvent{
+		Type:          domain.AuditPRCreated,
+		PullRequestID: prID,
+		UserIDs:       []string{authorID},
+		TeamName:      nameTeam,
+		After:         map[string]any{"name": prName, "author_id": authorID, "status": "OPEN"},
+	}); err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
 	for _, r := range reviewers {
-		if _, err := tx.Exec(`insert into pr_reviewers(pull_request_id, reviewer_id) values($1, $2)`, prID, r.ID); err != nil {
+		if err := addAuditEvent(tx, meta, domain.AuditEvent{
+			Type:          domain.AuditReviewerAssigned,
+			PullRequestID: prID,
+			UserIDs:       []string{r.ID},
+			TeamName:      nameTeam,
+			After:         map[string]any{"reviewer_id": r.ID, "due_at": nullTimeValue(dueAt)},
+		}); err != nil {
 			return nil, fmt.Errorf("%s: %w", op, err)
 		}
 	}
@@ -204,11 +329,13 @@ func (s *Storage) CreatePRWithReviewers(prID, prName, authorID string) (*domain.
 		Author:    *author,
 		Status:    "OPEN",
 		Reviewers: reviewers,
+		CreatedAt: createdAt,
+		Version:   1,
 	}, nil
 }
 
 // ReassignReviewer Переназначение reviewer, если это возможно
-func (s *Storage) ReassignReviewer(prID, oldReviewerID string) (*domain.PullRequest, string, error) {
+func (s *Storage) ReassignReviewer(prID, oldReviewerID string, meta domain.ChangeMeta) (*domain.PullRequest, string, error) {
 	const op = "storage.postgresql.ReassignReviewer"
 
 	tx, err := s.db.BeginTx(context.Background(), nil)
@@ -227,10 +354,14 @@ func (s *Storage) ReassignReviewer(prID, oldReviewerID string) (*domain.PullRequ
 		return nil, "", fmt.Errorf("%s: %w", op, err)
 	}
 
-	// Проверка на MERGE PR
-	if err := s.IsMergePR(prID); err != nil {
+	// Блокируем PR (параллельные переназначения выполняются по очереди) и проверка на MERGE PR
+	status, err := lockPR(tx, prID, meta)
+	if err != nil {
 		return nil, "", fmt.Errorf("%s: %w", op, err)
 	}
+	if status == "MERGED" {
+		return nil, "", storage.ErrPRAlreadyMerged
+	}
 
 	// Проверка на то что пользователь назначен как reviewer
 	if err := s.IsUserReviewerPR(prID, oldReviewerID); err != nil {
@@ -243,13 +374,14 @@ func (s *Storage) ReassignReviewer(prID, oldReviewerID string) (*domain.PullRequ
 		return nil, "", fmt.Errorf("%s: %w", op, err)
 	}
 
-	// Находим случайного активного пользователя из команды (кроме старого reviewer)
+	// Находим случайного активного пользователя из команды (кроме старого reviewer и уже назначенных)
 	var newReviewerID string
 	err = tx.QueryRow(`
 	select u.id 
     from teams_users tu
     join users u ON tu.user_id = u.id
     where tu.team_name=$1 and u.is_active=true and u.id <> $2 and u.id <> (select author_id from pull_requests where id = $3)
+    and u.id not in (select reviewer_id from pr_reviewers where pull_request_id = $3)
     order by random() limit 1`, teamName, oldReviewerID, prID).Scan(&newReviewerID)
 	if err != nil {
 		if errors.Is(err, sql.ErrNoRows) {
@@ -258,22 +390,45 @@ func (s *Storage) ReassignReviewer(prID, oldReviewerID string) (*domain.PullRequ
 		return nil, "", fmt.Errorf("%s: %w", op, err)
 	}
 
-	// Обновляем reviewer
+	// Обновляем reviewer (новое назначение получает новый срок ревью)
+	assignedAt := time.Now()
+	dueAt := s.reviewDueAt(teamName, assignedAt)
 	_, err = tx.Exec(
-		`update pr_reviewers set reviewer_id=$1 where pull_request_id=$2 AND reviewer_id=$3`,
-		newReviewerID, prID, oldReviewerID)
+		`update pr_reviewers set reviewer_id=$1, assigned_at=$4, due_at=$5, overdue_at=null where pull_request_id=$2 AND reviewer_id=$3`,
+		newReviewerID, prID, oldReviewerID, assignedAt, dueAt)
 	if err != nil {
 		return nil, "", fmt.Errorf("%s: %w", op, err)
 	}
+	if err := bumpPRVersion(tx, prID); err != nil {
+		return nil, "", fmt.Errorf("%s: %w", op, err)
+	}
 
-	// Получаем обновлённый PR с reviewer
-	pr, err := s.GetPRByID(prID)
-	if err != nil {
+	if err := addOutboxEvent(tx, domain.EventReviewerReassigned, reviewerReassignedPayload{
+		PullRequestID: prID,
+		OldReviewerID: oldReviewerID,
+		NewReviewerID: newReviewerID,
+	}); err != nil {
+		return nil, "", fmt.Errorf("%s: %w", op, err)
+	}
+	if err := addAuditEvent(tx, meta, domain.AuditEvent{
+		Type:          domain.AuditReviewerReassigned,
+		PullRequestID: prID,
+		UserIDs:       []string{oldReviewerID, newReviewerID},
+		TeamName:      teamName,
+		Before:        map[string]any{"reviewer_id": oldReviewerID},
+		After:         map[string]any{"reviewer_id": newReviewerID, "due_at": nullTimeValue(dueAt)},
+	}); err != nil {
 		return nil, "", fmt.Errorf("%s: %w", op, err)
 	}
 
 	if err := tx.Commit(); err != nil {
 		return nil, "", fmt.Errorf("%s: %w", op, err)
 	}
+
+	// Получаем обновлённый PR с reviewer
+	pr, err := s.GetPRByID(prID)
+	if err != nil {
+		return nil, "", fmt.Errorf("%s: %w", op, err)
+	}
 	return pr, newReviewerID, nil
 }
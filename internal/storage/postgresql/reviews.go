@@ -0,0 +1,82 @@
+package postgresql
+
+import (
+	"database/sql"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/sudo-odner/Backend-trainee-assignment-avito-2025/internal/domain"
+)
+
+// reviewDueAt Срок ревью по SLA команды (null, если SLA не задан)
+func (s *Storage) reviewDueAt(teamName string, assignedAt time.Time) sql.NullTime {
+	if s.sla == nil {
+		return sql.NullTime{}
+	}
+	dueAt, ok := s.sla.DueAt(teamName, assignedAt)
+	return sql.NullTime{Time: dueAt, Valid: ok}
+}
+
+// MarkOverdueReviews Отметка просроченных ревью по открытым PR
+func (s *Storage) MarkOverdueReviews(now time.Time) (int, error) {
+	const op = "storage.postgresql.MarkOverdueReviews"
+
+	res, err := s.db.Exec(`
+	update pr_reviewers r
+	set overdue_at = $1
+	from pull_requests pr
+	where pr.id = r.pull_request_id and pr.status = 'OPEN'
+	and r.overdue_at is null and r.due_at is not null and r.due_at < $1`, now)
+	if err != nil {
+		return 0, fmt.Errorf("%s: %w", op, err)
+	}
+
+	rowsAffected, err := res.RowsAffected()
+	if err != nil {
+		return 0, fmt.Errorf("%s: %w", op, err)
+	}
+	return int(rowsAffected), nil
+}
+
+// GetOverdueReviews Получение просроченных ревью по открытым PR
+func (s *Storage) GetOverdueReviews(filter domain.OverdueFilter) ([]domain.ReviewAssignment, error) {
+	const op = "storage.postgresql.GetOverdueReviews"
+
+	rows, err := s.db.Query(`
+	select pr.id, pr.name, pr.author_id, r.reviewer_id, coalesce(tu.team_name, ''), r.assigned_at, r.due_at, r.overdue_at
+	from pr_reviewers r
+	join pull_requests pr on pr.id = r.pull_request_id
+	left join teams_users tu on tu.user_id = r.reviewer_id
+	where pr.status = 'OPEN' and r.due_at is not null and r.due_at < $1
+	and ($2 = '' or tu.team_name = $2)
+	and ($3 = '' or r.reviewer_id = $3)
+	order by r.due_at, pr.id`, filter.DueBefore, filter.TeamName, filter.ReviewerID)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	defer func() {
+		if err := rows.Close(); err != nil {
+			log.Printf("rows close failed: %v", err)
+		}
+	}()
+
+	reviews := make([]domain.ReviewAssignment, 0)
+	for rows.Next() {
+		var review domain.ReviewAssignment
+		var overdueAt sql.NullTime
+		if err := rows.Scan(
+			&review.PullRequestID, &review.PullRequestName, &review.AuthorID,
+			&review.ReviewerID, &review.TeamName,
+			&review.AssignedAt, &review.DueAt, &overdueAt); err != nil {
+			return nil, fmt.Errorf("%s: %w", op, err)
+		}
+		review.OverdueAt = overdueAt.Time
+		reviews = append(reviews, review)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return reviews, nil
+}
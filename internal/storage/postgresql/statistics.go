@@ -1,23 +1,50 @@
 package postgresql
 
 import (
+	"database/sql"
 	"fmt"
 	"log"
+	"time"
 
 	"github.com/sudo-odner/Backend-trainee-assignment-avito-2025/internal/domain"
 )
 
-func (s *Storage) GetReviewStat() ([]domain.UserReviewStat, error) {
+// GetReviewStat Количество назначений на ревью по пользователям (окно по времени назначения)
+func (s *Storage) GetReviewStat(filter domain.StatFilter) ([]domain.UserReviewStat, error) {
 	const op = "storage.GetReviewsStat"
+
+	stats := make([]domain.UserReviewStat, 0)
+	err := s.EachReviewStat(filter, func(stat domain.UserReviewStat) error {
+		stats = append(stats, stat)
+		return nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	return stats, nil
+}
+
+// EachReviewStat Построчный обход статистики назначений без загрузки всей выборки в память
+func (s *Storage) EachReviewStat(filter domain.StatFilter, fn func(stat domain.UserReviewStat) error) error {
+	const op = "storage.postgresql.EachReviewStat"
 	rows, err := s.db.Query(`
-        select u.id as user_id, count(r.reviewer_id) AS review_count
+        select u.id as user_id, count(x.reviewer_id) AS review_count
         from users u
-        left join pr_reviewers r on u.id = r.reviewer_id
+        left join teams_users tu on tu.user_id = u.id
+        left join (
+            select r.reviewer_id
+            from pr_reviewers r
+            join pull_requests pr on pr.id = r.pull_request_id
+            where ($1::timestamp is null or r.assigned_at >= $1)
+            and ($2::timestamp is null or r.assigned_at < $2)
+            and ($3 = '' or pr.status = $3)
+        ) x on u.id = x.reviewer_id
+        where ($4 = '' or tu.team_name = $4)
         group by u.id
-        order by review_count desc;
-    `)
+        order by review_count desc, u.id;
+    `, nullTime(filter.From), nullTime(filter.To), filter.Status, filter.TeamName)
 	if err != nil {
-		return nil, fmt.Errorf("%s: %w", op, err)
+		return fmt.Errorf("%s: %w", op, err)
 	}
 	defer func() {
 		if err := rows.Close(); err != nil {
@@ -26,19 +53,120 @@ func (s *Storage) GetReviewStat() ([]domain.UserReviewStat, error) {
 	}()
 
 	// Переброр всех reviewer
-	stats := make([]domain.UserReviewStat, 0)
 	for rows.Next() {
 		var stat domain.UserReviewStat
 		err := rows.Scan(&stat.UserID, &stat.ReviewCount)
 		if err != nil {
-			return nil, err
+			return err
+		}
+		if err := fn(stat); err != nil {
+			return err
 		}
-		stats = append(stats, stat)
 	}
 
 	if err := rows.Err(); err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	return nil
+}
+
+// GetTeamStat Статистика по командам (окно по времени создания PR)
+func (s *Storage) GetTeamStat(filter domain.StatFilter) ([]domain.TeamStat, error) {
+	const op = "storage.postgresql.GetTeamStat"
+
+	rows, err := s.db.Query(`
+	select t.name,
+	       (select count(*) from teams_users m where m.team_name = t.name),
+	       (select count(*) from teams_users m join users u on u.id = m.user_id
+	        where m.team_name = t.name and u.is_active = true),
+	       count(pr.id),
+	       count(pr.id) filter (where pr.status = 'OPEN'),
+	       count(pr.id) filter (where pr.status = 'MERGED'),
+	       coalesce(sum(rc.cnt), 0),
+	       coalesce(avg(rc.cnt), 0)
+	from teams t
+	left join teams_users tu on tu.team_name = t.name
+	left join pull_requests pr on pr.author_id = tu.user_id
+	    and ($1::timestamp is null or pr.created_at >= $1)
+	    and ($2::timestamp is null or pr.created_at < $2)
+	    and ($3 = '' or pr.status = $3)
+	left join lateral (
+	    select count(*) as cnt from pr_reviewers r where r.pull_request_id = pr.id
+	) rc on pr.id is not null
+	where ($4 = '' or t.name = $4)
+	group by t.name
+	order by t.name`, nullTime(filter.From), nullTime(filter.To), filter.Status, filter.TeamName)
+	if err != nil {
 		return nil, fmt.Errorf("%s: %w", op, err)
 	}
+	defer func() {
+		if err := rows.Close(); err != nil {
+			log.Printf("rows close failed: %v", err)
+		}
+	}()
 
+	stats := make([]domain.TeamStat, 0)
+	for rows.Next() {
+		var stat domain.TeamStat
+		if err := rows.Scan(
+			&stat.TeamName, &stat.Members, &stat.ActiveMembers,
+			&stat.PullRequests, &stat.OpenPullRequests, &stat.MergedPullRequests,
+			&stat.ReviewAssignments, &stat.AvgReviewersPerPR); err != nil {
+			return nil, fmt.Errorf("%s: %w", op, err)
+		}
+		stats = append(stats, stat)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
 	return stats, nil
 }
+
+// GetPRStat Сводная статистика по PR (окно по времени создания PR)
+func (s *Storage) GetPRStat(filter domain.StatFilter) (*domain.PRStat, error) {
+	const op = "storage.postgresql.GetPRStat"
+
+	var stat domain.PRStat
+	var p50, p90, p99 sql.NullFloat64
+	err := s.db.QueryRow(`
+	with prs as (
+	    select pr.id, pr.status, pr.created_at, pr.merged_at,
+	           (select count(*) from pr_reviewers r where r.pull_request_id = pr.id) as reviewers
+	    from pull_requests pr
+	    left join teams_users tu on tu.user_id = pr.author_id
+	    where ($1::timestamp is null or pr.created_at >= $1)
+	    and ($2::timestamp is null or pr.created_at < $2)
+	    and ($3 = '' or pr.status = $3)
+	    and ($4 = '' or tu.team_name = $4)
+	), merge_times as (
+	    select extract(epoch from merged_at - created_at) as seconds
+	    from prs
+	    where status = 'MERGED' and created_at is not null and merged_at is not null
+	)
+	select (select count(*) from prs),
+	       (select count(*) from prs where status = 'OPEN'),
+	       (select count(*) from prs where status = 'MERGED'),
+	       (select coalesce(avg(reviewers), 0) from prs),
+	       (select percentile_cont(0.5) within group (order by seconds) from merge_times),
+	       (select percentile_cont(0.9) within group (order by seconds) from merge_times),
+	       (select percentile_cont(0.99) within group (order by seconds) from merge_times)`,
+		nullTime(filter.From), nullTime(filter.To), filter.Status, filter.TeamName).Scan(
+		&stat.PullRequests, &stat.OpenPullRequests, &stat.MergedPullRequests, &stat.AvgReviewersPerPR,
+		&p50, &p90, &p99)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	stat.TimeToMergeP50 = secondsToDuration(p50)
+	stat.TimeToMergeP90 = secondsToDuration(p90)
+	stat.TimeToMergeP99 = secondsToDuration(p99)
+	return &stat, nil
+}
+
+func secondsToDuration(seconds sql.NullFloat64) time.Duration {
+	if !seconds.Valid {
+		return 0
+	}
+	return time.Duration(seconds.Float64 * float64(time.Second))
+}
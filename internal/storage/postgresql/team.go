@@ -7,12 +7,13 @@ import (
 	"fmt"
 	"log"
 
+	"github.com/lib/pq"
 	"github.com/sudo-odner/Backend-trainee-assignment-avito-2025/internal/domain"
 	"github.com/sudo-odner/Backend-trainee-assignment-avito-2025/internal/storage"
 )
 
 // DeactivateTeamUsers Массовая деактивация пользователей команды
-func (s *Storage) DeactivateTeamUsers(teamName string) (int, error) {
+func (s *Storage) DeactivateTeamUsers(teamName string, meta domain.ChangeMeta) (int, error) {
 	tx, err := s.db.BeginTx(context.Background(), nil)
 	if err != nil {
 		return -1, err
@@ -28,40 +29,142 @@ func (s *Storage) DeactivateTeamUsers(teamName string) (int, error) {
 		return -1, err
 	}
 
+	// Запоминаем активных до деактивации (для аудита)
+	activeIDs, err := queryStrings(tx, `
+        select u.id
+        from users u
+        join teams_users tu on u.id = tu.user_id
+        where tu.team_name = $1 and u.is_active = true
+        for update of u
+    `, teamName)
+	if err != nil {
+		return -1, err
+	}
+
 	// Деактивируем пользователей
-	res, err := tx.Exec(`
+	userIDs, err := queryStrings(tx, `
         update users u
         set is_active = false
         from teams_users tu
         where tu.team_name = $1 and u.id = tu.user_id
+        returning u.id
     `, teamName)
 	if err != nil {
 		return -1, err
 	}
 
-	rowsAffected, err := res.RowsAffected()
-	if err != nil {
+	// Аудит деактивации пользователей и снятия их с ревью
+	if meta.Reason == "" {
+		meta.Reason = "team deactivated"
+	}
+	for _, userID := range activeIDs {
+		if err := addAuditEvent(tx, meta, domain.AuditEvent{
+			Type:     domain.AuditUserDeactivated,
+			UserIDs:  []string{userID},
+			TeamName: teamName,
+			Before:   map[string]any{"is_active": true},
+			After:    map[string]any{"is_active": false},
+		}); err != nil {
+			return -1, err
+		}
+	}
+	if _, err := removeDeactivatedReviewers(tx, teamName, userIDs, meta); err != nil {
 		return -1, err
 	}
 
-	// Удаляем их из pr_reviewers для открытых PR
-	_, err = tx.Exec(`
+	if err := tx.Commit(); err != nil {
+		return -1, err
+	}
+
+	return len(userIDs), nil
+}
+
+// removeDeactivatedReviewers Снятие деактивированных пользователей команды с ревью открытых PR:
+// версия PR, событие outbox и аудит снятия
+func removeDeactivatedReviewers(tx *sql.Tx, teamName string, userIDs []string, meta domain.ChangeMeta) ([]removedReview, error) {
+	rows, err := tx.Query(`
 		delete from pr_reviewers
-		using pull_requests, teams_users
-		where pr_reviewers.reviewer_id = teams_users.user_id
-		and teams_users.team_name = $1
+		using pull_requests
+		where pr_reviewers.reviewer_id = any($1)
 		and pr_reviewers.pull_request_id = pull_requests.id
-		and pull_requests.status = 'OPEN';
-    `, teamName)
+		and pull_requests.status = 'OPEN'
+		returning pr_reviewers.pull_request_id, pr_reviewers.reviewer_id;
+    `, pq.Array(userIDs))
 	if err != nil {
-		return -1, err
+		return nil, err
+	}
+	removed := make([]removedReview, 0)
+	for rows.Next() {
+		var review removedReview
+		if err := rows.Scan(&review.PullRequestID, &review.ReviewerID); err != nil {
+			_ = rows.Close()
+			return nil, err
+		}
+		removed = append(removed, review)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
 	}
 
-	if err := tx.Commit(); err != nil {
-		return -1, err
+	// Снятие reviewer меняет версию PR
+	removedPRIDs := make([]string, 0, len(removed))
+	for _, review := range removed {
+		removedPRIDs = append(removedPRIDs, review.PullRequestID)
+	}
+	if err := bumpPRVersion(tx, removedPRIDs...); err != nil {
+		return nil, err
+	}
+
+	if err := addOutboxEvent(tx, domain.EventTeamDeactivated, teamDeactivatedPayload{
+		TeamName:       teamName,
+		UserIDs:        userIDs,
+		RemovedReviews: removed,
+	}); err != nil {
+		return nil, err
 	}
 
-	return int(rowsAffected), nil
+	for _, review := range removed {
+		if err := addAuditEvent(tx, meta, domain.AuditEvent{
+			Type:          domain.AuditReviewerRemoved,
+			PullRequestID: review.PullRequestID,
+			UserIDs:       []string{review.ReviewerID},
+			TeamName:      teamName,
+			Before:        map[string]any{"reviewer_id": review.ReviewerID},
+			After:         map[string]any{"reviewer_id": nil},
+		}); err != nil {
+			return nil, err
+		}
+	}
+	return removed, nil
+}
+
+// queryStrings Выполнение запроса, возвращающего одну текстовую колонку
+func queryStrings(tx *sql.Tx, query string, args ...any) ([]string, error) {
+	rows, err := tx.Query(query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer func() {
+		if err := rows.Close(); err != nil {
+			log.Printf("rows close failed: %v", err)
+		}
+	}()
+
+	values := make([]string, 0)
+	for rows.Next() {
+		var value string
+		if err := rows.Scan(&value); err != nil {
+			return nil, err
+		}
+		values = append(values, value)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return values, nil
 }
 
 // IsTeamExists Проверка существования команды
@@ -123,8 +226,52 @@ func (s *Storage) GetTeam(nameTeam string) (*domain.Team, error) {
 	return &team, nil
 }
 
+// GetTeamsByNames Команды с участниками одним запросом (несуществующих команд нет в результате)
+func (s *Storage) GetTeamsByNames(names []string) (map[string]*domain.Team, error) {
+	const op = "storage.postgresql.GetTeamsByNames"
+
+	rows, err := s.db.Query(`
+		select t.name, u.id, u.name, u.is_active
+			from teams t
+			left join teams_users tu on tu.team_name = t.name
+			left join users u on u.id = tu.user_id
+			where t.name = any($1)
+			order by t.name, tu.internal_id`,
+		pq.Array(names))
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	defer func() {
+		if err := rows.Close(); err != nil {
+			log.Printf("rows close failed: %v", err)
+		}
+	}()
+
+	teams := make(map[string]*domain.Team, len(names))
+	for rows.Next() {
+		var teamName string
+		var userID, userName sql.NullString
+		var isActive sql.NullBool
+		if err := rows.Scan(&teamName, &userID, &userName, &isActive); err != nil {
+			return nil, fmt.Errorf("%s: %w", op, err)
+		}
+		team, ok := teams[teamName]
+		if !ok {
+			team = &domain.Team{Name: teamName, Users: make([]domain.User, 0)}
+			teams[teamName] = team
+		}
+		if userID.Valid {
+			team.Users = append(team.Users, domain.User{ID: userID.String, Name: userName.String, IsActive: isActive.Bool})
+		}
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	return teams, nil
+}
+
 // CreateTeamWithUser Создание команды и добавление пользователь в нее
-func (s *Storage) CreateTeamWithUser(nameTeam string, users []domain.User) error {
+func (s *Storage) CreateTeamWithUser(nameTeam string, users []domain.User, meta domain.ChangeMeta) error {
 	const op = "storage.postgresql.CreateTeamWithUser"
 	tx, err := s.db.BeginTx(context.Background(), nil)
 	if err != nil {
@@ -175,6 +322,21 @@ func (s *Storage) CreateTeamWithUser(nameTeam string, users []domain.User) error
 		}
 	}
 
+	members := make([]map[string]any, 0, len(users))
+	userIDs := make([]string, 0, len(users))
+	for _, user := range users {
+		members = append(members, map[string]any{"user_id": user.ID, "username": user.Name, "is_active": user.IsActive})
+		userIDs = append(userIDs, user.ID)
+	}
+	if err := addAuditEvent(tx, meta, domain.AuditEvent{
+		Type:     domain.AuditTeamCreated,
+		UserIDs:  userIDs,
+		TeamName: nameTeam,
+		After:    map[string]any{"members": members},
+	}); err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
 	if err := tx.Commit(); err != nil {
 		return fmt.Errorf("%s: %w", op, err)
 	}
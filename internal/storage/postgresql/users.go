@@ -1,11 +1,13 @@
 package postgresql
 
 import (
+	"context"
 	"database/sql"
 	"errors"
 	"fmt"
 	"log"
 
+	"github.com/lib/pq"
 	"github.com/sudo-odner/Backend-trainee-assignment-avito-2025/internal/domain"
 	"github.com/sudo-odner/Backend-trainee-assignment-avito-2025/internal/storage"
 )
@@ -57,26 +59,39 @@ func (s *Storage) GetUserTeamByID(userID string) (string, error) {
 	return team, nil
 }
 
-// GetUserPRsByID Получить PRs пользователя
-func (s *Storage) GetUserPRsByID(userID string) ([]*domain.PullRequest, error) {
-	const op = "storage.postgresql.getUserPRsByID"
+// GetUsersByIDs Пользователи одним запросом (несуществующих пользователей нет в результате)
+func (s *Storage) GetUsersByIDs(userIDs []string) (map[string]*domain.User, error) {
+	const op = "storage.postgresql.GetUsersByIDs"
 
-	// Получение информации о пользователе
-	user, err := s.GetUserByID(userID)
+	rows, err := s.db.Query(`select id, name, is_active from users where id = any($1)`, pq.Array(userIDs))
 	if err != nil {
 		return nil, fmt.Errorf("%s: %w", op, err)
 	}
+	defer func() {
+		if err := rows.Close(); err != nil {
+			log.Printf("rows close failed: %v", err)
+		}
+	}()
 
-	// Получение PRs + информация о reviewers
-	rows, err := s.db.Query(
-		`
-		select pr.id, pr.name, pr.status, pr.merged_at, ru.id, ru.name, ru.is_active
-		from pull_requests pr
-		left join pr_reviewers r on r.pull_request_id = pr.id
-		left join users ru on ru.id = r.reviewer_id
-		where author_id = $1
-		order by pr.id
-		`, userID)
+	users := make(map[string]*domain.User, len(userIDs))
+	for rows.Next() {
+		var user domain.User
+		if err := rows.Scan(&user.ID, &user.Name, &user.IsActive); err != nil {
+			return nil, fmt.Errorf("%s: %w", op, err)
+		}
+		users[user.ID] = &user
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	return users, nil
+}
+
+// GetUserTeamsByIDs Команды пользователей одним запросом (пользователей без команды нет в результате)
+func (s *Storage) GetUserTeamsByIDs(userIDs []string) (map[string]string, error) {
+	const op = "storage.postgresql.GetUserTeamsByIDs"
+
+	rows, err := s.db.Query(`select user_id, team_name from teams_users where user_id = any($1)`, pq.Array(userIDs))
 	if err != nil {
 		return nil, fmt.Errorf("%s: %w", op, err)
 	}
@@ -86,66 +101,85 @@ func (s *Storage) GetUserPRsByID(userID string) ([]*domain.PullRequest, error) {
 		}
 	}()
 
-	prMap := make(map[string]*domain.PullRequest)
+	teams := make(map[string]string, len(userIDs))
 	for rows.Next() {
-		var prID, prName, prStatus, reviewerID, reviewerName sql.NullString
-		var prMergedAt sql.NullTime
-		var reviewerIsActive sql.NullBool
-
-		if err := rows.Scan(
-			&prID, &prName, &prStatus, &prMergedAt,
-			&reviewerID, &reviewerName, &reviewerIsActive); err != nil {
+		var userID, teamName string
+		if err := rows.Scan(&userID, &teamName); err != nil {
 			return nil, fmt.Errorf("%s: %w", op, err)
 		}
-
-		pr, exists := prMap[prID.String]
-		if !exists {
-			pr = &domain.PullRequest{
-				ID:        prID.String,
-				Name:      prName.String,
-				Author:    *user,
-				Status:    prStatus.String,
-				Reviewers: []domain.User{},
-				MergedAt:  prMergedAt.Time,
-			}
-			prMap[prID.String] = pr
-		}
-		if reviewerID.Valid {
-			pr.Reviewers = append(pr.Reviewers, domain.User{
-				ID:       reviewerID.String,
-				Name:     reviewerName.String,
-				IsActive: reviewerIsActive.Bool})
-		}
+		teams[userID] = teamName
 	}
 	if err := rows.Err(); err != nil {
 		return nil, fmt.Errorf("%s: %w", op, err)
 	}
+	return teams, nil
+}
+
+// IsUserInTeam Проверка, что пользователь состоит в команде
+func (s *Storage) IsUserInTeam(userID, teamName string) (bool, error) {
+	const op = "storage.postgresql.IsUserInTeam"
 
-	userPRs := make([]*domain.PullRequest, 0, len(prMap))
-	for _, pr := range prMap {
-		userPRs = append(userPRs, pr)
+	var exists bool
+	err := s.db.QueryRow(
+		`select exists(select 1 from teams_users where user_id = $1 and team_name = $2)`,
+		userID, teamName).Scan(&exists)
+	if err != nil {
+		return false, fmt.Errorf("%s: %w", op, err)
 	}
-	return userPRs, nil
+	return exists, nil
 }
 
 // SetUserIsActive Метод обновления статуса у пользователя
-func (s *Storage) SetUserIsActive(userID string, isActive bool) error {
+func (s *Storage) SetUserIsActive(userID string, isActive bool, meta domain.ChangeMeta) error {
 	const op = "storage.postgresql.SetUserIsActive"
 
-	query := `update users set is_active = $1 where id = $2`
-	res, err := s.db.Exec(query, isActive, userID)
+	tx, err := s.db.BeginTx(context.Background(), nil)
 	if err != nil {
 		return fmt.Errorf("%s: %w", op, err)
 	}
+	defer func() {
+		if err := tx.Rollback(); err != nil && err != sql.ErrTxDone {
+			log.Printf("tx rollback failed: %v", err)
+		}
+	}()
 
-	rowsAffected, err := res.RowsAffected()
+	// Текущий статус (для аудита)
+	var wasActive bool
+	err = tx.QueryRow(`select is_active from users where id = $1 for update`, userID).Scan(&wasActive)
 	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return fmt.Errorf("%s: %w", op, storage.ErrUserNotFound)
+		}
 		return fmt.Errorf("%s: %w", op, err)
 	}
 
-	if rowsAffected == 0 {
-		return fmt.Errorf("%s: %w", op, storage.ErrUserNotFound)
+	if _, err := tx.Exec(`update users set is_active = $1 where id = $2`, isActive, userID); err != nil {
+		return fmt.Errorf("%s: %w", op, err)
 	}
 
+	// В аудит попадает только фактическое изменение статуса
+	if wasActive != isActive {
+		eventType := domain.AuditUserDeactivated
+		if isActive {
+			eventType = domain.AuditUserActivated
+		}
+		teamName, err := s.GetUserTeamByID(userID)
+		if err != nil && !errors.Is(err, storage.ErrTeamNotFound) {
+			return fmt.Errorf("%s: %w", op, err)
+		}
+		if err := addAuditEvent(tx, meta, domain.AuditEvent{
+			Type:     eventType,
+			UserIDs:  []string{userID},
+			TeamName: teamName,
+			Before:   map[string]any{"is_active": wasActive},
+			After:    map[string]any{"is_active": isActive},
+		}); err != nil {
+			return fmt.Errorf("%s: %w", op, err)
+		}
+	}
+
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
 	return nil
 }
@@ -0,0 +1,266 @@
+package postgresql
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/lib/pq"
+	"github.com/sudo-odner/Backend-trainee-assignment-avito-2025/internal/domain"
+	"github.com/sudo-odner/Backend-trainee-assignment-avito-2025/internal/storage"
+)
+
+// CreateWebhook Регистрация webhook (пустой список событий - подписка на все)
+func (s *Storage) CreateWebhook(url, secret string, eventTypes []string) (*domain.Webhook, error) {
+	const op = "storage.postgresql.CreateWebhook"
+
+	if eventTypes == nil {
+		eventTypes = []string{}
+	}
+	webhook := domain.Webhook{
+		URL:        url,
+		Secret:     secret,
+		EventTypes: eventTypes,
+		IsActive:   true,
+		CreatedAt:  time.Now(),
+	}
+	err := s.db.QueryRow(
+		`insert into webhooks (url, secret, event_types, created_at) values ($1, $2, $3, $4) returning id`,
+		url, secret, pq.Array(eventTypes), webhook.CreatedAt).Scan(&webhook.ID)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	return &webhook, nil
+}
+
+// GetWebhooks Список активных webhook
+func (s *Storage) GetWebhooks() ([]domain.Webhook, error) {
+	const op = "storage.postgresql.GetWebhooks"
+
+	rows, err := s.db.Query(`
+	select id, url, secret, event_types, is_active, created_at
+	from webhooks
+	where is_active = true
+	order by id`)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	defer func() {
+		if err := rows.Close(); err != nil {
+			log.Printf("rows close failed: %v", err)
+		}
+	}()
+
+	webhooks := make([]domain.Webhook, 0)
+	for rows.Next() {
+		var webhook domain.Webhook
+		if err := rows.Scan(
+			&webhook.ID, &webhook.URL, &webhook.Secret,
+			pq.Array(&webhook.EventTypes), &webhook.IsActive, &webhook.CreatedAt); err != nil {
+			return nil, fmt.Errorf("%s: %w", op, err)
+		}
+		webhooks = append(webhooks, webhook)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	return webhooks, nil
+}
+
+// DeactivateWebhook Отключение webhook (история доставок сохраняется)
+func (s *Storage) DeactivateWebhook(webhookID int64) error {
+	const op = "storage.postgresql.DeactivateWebhook"
+
+	res, err := s.db.Exec(`update webhooks set is_active = false where id = $1 and is_active = true`, webhookID)
+	if err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+	rowsAffected, err := res.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+	if rowsAffected == 0 {
+		return storage.ErrWebhookNotFound
+	}
+
+	// Недоставленные события отключенному webhook больше не отправляем
+	_, err = s.db.Exec(`
+	update webhook_deliveries
+	set status = 'DEAD', last_error = 'webhook deactivated', updated_at = $2
+	where webhook_id = $1 and status = 'PENDING'`, webhookID, time.Now())
+	if err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+	return nil
+}
+
+// FanOutOutboxEvents Создание доставок для новых событий outbox по подходящим webhook
+func (s *Storage) FanOutOutboxEvents(now time.Time, limit int) (int, error) {
+	const op = "storage.postgresql.FanOutOutboxEvents"
+
+	// Data-modifying CTE выполняется целиком в одной транзакции
+	res, err := s.db.Exec(`
+	with events as (
+		select id, event_type
+		from outbox_events
+		where dispatched_at is null
+		order by id
+		limit $2
+		for update skip locked
+	), deliveries as (
+		insert into webhook_deliveries (event_id, webhook_id, status, next_attempt_at, updated_at)
+		select e.id, w.id, 'PENDING', $1, $1
+		from events e
+		join webhooks w on w.is_active = true
+		and (cardinality(w.event_types) = 0 or e.event_type = any(w.event_types))
+	)
+	update outbox_events set dispatched_at = $1
+	where id in (select id from events)`, now, limit)
+	if err != nil {
+		return 0, fmt.Errorf("%s: %w", op, err)
+	}
+	rowsAffected, err := res.RowsAffected()
+	if err != nil {
+		return 0, fmt.Errorf("%s: %w", op, err)
+	}
+	return int(rowsAffected), nil
+}
+
+// ClaimDueDeliveries Захват доставок, время попытки которых наступило (на время lease)
+func (s *Storage) ClaimDueDeliveries(now time.Time, lease time.Duration, limit int) ([]domain.WebhookDelivery, error) {
+	const op = "storage.postgresql.ClaimDueDeliveries"
+
+	rows, err := s.db.Query(`
+	with due as (
+		select id
+		from webhook_deliveries
+		where status = 'PENDING' and next_attempt_at <= $1
+		order by next_attempt_at
+		limit $3
+		for update skip locked
+	)
+	update webhook_deliveries d
+	set next_attempt_at = $2
+	from due, webhooks w, outbox_events e
+	where d.id = due.id and w.id = d.webhook_id and e.id = d.event_id
+	returning d.id, d.webhook_id, w.url, w.secret, e.id, e.event_type, e.payload, e.created_at, d.attempts`,
+		now, now.Add(lease), limit)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	defer func() {
+		if err := rows.Close(); err != nil {
+			log.Printf("rows close failed: %v", err)
+		}
+	}()
+
+	deliveries := make([]domain.WebhookDelivery, 0)
+	for rows.Next() {
+		var delivery domain.WebhookDelivery
+		if err := rows.Scan(
+			&delivery.ID, &delivery.WebhookID, &delivery.URL, &delivery.Secret,
+			&delivery.EventID, &delivery.EventType, &delivery.Payload, &delivery.OccurredAt,
+			&delivery.Attempts); err != nil {
+			return nil, fmt.Errorf("%s: %w", op, err)
+		}
+		delivery.Status = "PENDING"
+		deliveries = append(deliveries, delivery)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	return deliveries, nil
+}
+
+// MarkDeliverySucceeded Отметка успешной доставки
+func (s *Storage) MarkDeliverySucceeded(deliveryID int64, now time.Time) error {
+	const op = "storage.postgresql.MarkDeliverySucceeded"
+
+	_, err := s.db.Exec(`
+	update webhook_deliveries
+	set status = 'DELIVERED', attempts = attempts + 1, last_error = null, updated_at = $2
+	where id = $1`, deliveryID, now)
+	if err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+	return nil
+}
+
+// MarkDeliveryFailed Отметка неудачной попытки: повтор в nextAttemptAt или dead-letter
+func (s *Storage) MarkDeliveryFailed(deliveryID int64, lastError string, nextAttemptAt time.Time, dead bool, now time.Time) error {
+	const op = "storage.postgresql.MarkDeliveryFailed"
+
+	status := "PENDING"
+	if dead {
+		status = "DEAD"
+	}
+	_, err := s.db.Exec(`
+	update webhook_deliveries
+	set status = $2, attempts = attempts + 1, last_error = $3, next_attempt_at = $4, updated_at = $5
+	where id = $1`, deliveryID, status, lastError, nextAttemptAt, now)
+	if err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+	return nil
+}
+
+// GetDeadDeliveries Dead-letter: доставки, исчерпавшие попытки
+func (s *Storage) GetDeadDeliveries(webhookID int64) ([]domain.WebhookDelivery, error) {
+	const op = "storage.postgresql.GetDeadDeliveries"
+
+	rows, err := s.db.Query(`
+	select d.id, d.webhook_id, w.url, e.id, e.event_type, e.payload, e.created_at,
+	       d.status, d.attempts, d.next_attempt_at, coalesce(d.last_error, ''), d.updated_at
+	from webhook_deliveries d
+	join webhooks w on w.id = d.webhook_id
+	join outbox_events e on e.id = d.event_id
+	where d.status = 'DEAD' and ($1::bigint = 0 or d.webhook_id = $1)
+	order by d.updated_at desc, d.id desc`, webhookID)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	defer func() {
+		if err := rows.Close(); err != nil {
+			log.Printf("rows close failed: %v", err)
+		}
+	}()
+
+	deliveries := make([]domain.WebhookDelivery, 0)
+	for rows.Next() {
+		var delivery domain.WebhookDelivery
+		if err := rows.Scan(
+			&delivery.ID, &delivery.WebhookID, &delivery.URL,
+			&delivery.EventID, &delivery.EventType, &delivery.Payload, &delivery.OccurredAt,
+			&delivery.Status, &delivery.Attempts, &delivery.NextAttemptAt, &delivery.LastError, &delivery.UpdatedAt); err != nil {
+			return nil, fmt.Errorf("%s: %w", op, err)
+		}
+		deliveries = append(deliveries, delivery)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	return deliveries, nil
+}
+
+// RetryDeadDelivery Возврат доставки из dead-letter в очередь
+func (s *Storage) RetryDeadDelivery(deliveryID int64) error {
+	const op = "storage.postgresql.RetryDeadDelivery"
+
+	res, err := s.db.Exec(`
+	update webhook_deliveries d
+	set status = 'PENDING', attempts = 0, next_attempt_at = $2, updated_at = $2
+	from webhooks w
+	where d.id = $1 and d.status = 'DEAD' and w.id = d.webhook_id and w.is_active = true`,
+		deliveryID, time.Now())
+	if err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+	rowsAffected, err := res.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+	if rowsAffected == 0 {
+		return storage.ErrDeliveryNotFound
+	}
+	return nil
+}
@@ -0,0 +1,448 @@
+// Package storagetest Хранилище в памяти для тестов транспорта (HTTP, GraphQL, клиент) без БД
+package storagetest
+
+import (
+	"cmp"
+	"errors"
+	"slices"
+	"sync"
+	"time"
+
+	"github.com/sudo-odner/Backend-trainee-assignment-avito-2025/internal/domain"
+	"github.com/sudo-odner/Backend-trainee-assignment-avito-2025/internal/storage"
+)
+
+// ErrUnavailable Ошибка изменения данных после FailNext
+var ErrUnavailable = errors.New("storage unavailable")
+
+// createdAt Время создания данных набора New
+var createdAt = time.Date(2025, 11, 5, 10, 0, 0, 0, time.UTC)
+
+// Storage Команды, PR, токены и webhook в памяти со счётчиком обращений по методам.
+// Реализует только методы, нужные тестам; остальные методы интерфейсов транспорта встраивает тест
+type Storage struct {
+	mu       sync.Mutex
+	teams    []domain.Team
+	prs      []domain.PullRequest
+	tokens   map[string]domain.APIToken
+	webhooks []domain.Webhook
+	calls    map[string]int
+	failures int
+}
+
+// Empty Хранилище без данных
+func Empty() *Storage {
+	return &Storage{tokens: make(map[string]domain.APIToken), calls: make(map[string]int)}
+}
+
+// New Хранилище с командами backend (u1 Alice, u2 Bob) и frontend (u3 Carol), PR pr-2 "Fix"
+// (автор Alice, reviewer Bob) и pr-1 "Feature" (автор Carol, reviewer Alice и Bob) в порядке от новых к старым
+// и одним webhook
+func New() *Storage {
+	alice := domain.User{ID: "u1", Name: "Alice", IsActive: true}
+	bob := domain.User{ID: "u2", Name: "Bob", IsActive: true}
+	carol := domain.User{ID: "u3", Name: "Carol", IsActive: true}
+
+	s := Empty()
+	s.teams = []domain.Team{
+		{Name: "backend", Users: []domain.User{alice, bob}},
+		{Name: "frontend", Users: []domain.User{carol}},
+	}
+	s.prs = []domain.PullRequest{
+		{ID: "pr-2", Name: "Fix", Author: alice, Status: "OPEN", Reviewers: []domain.User{bob},
+			CreatedAt: createdAt.Add(time.Hour), Version: 1},
+		{ID: "pr-1", Name: "Feature", Author: carol, Status: "OPEN", Reviewers: []domain.User{alice, bob},
+			CreatedAt: createdAt, Version: 1},
+	}
+	s.webhooks = []domain.Webhook{{ID: 1, URL: "https://example.com/hook", EventTypes: []string{}, IsActive: true, CreatedAt: createdAt}}
+	return s
+}
+
+// FailNext Следующие n изменений данных завершатся ErrUnavailable
+func (s *Storage) FailNext(n int) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.failures = n
+}
+
+// Count Число обращений к методу
+func (s *Storage) Count(method string) int {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.calls[method]
+}
+
+// Calls Число обращений ко всем методам
+func (s *Storage) Calls() int {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	var total int
+	for _, count := range s.calls {
+		total += count
+	}
+	return total
+}
+
+// call Учёт обращения (mu уже захвачен)
+func (s *Storage) call(method string) {
+	s.calls[method]++
+}
+
+// change Учёт изменения данных (mu уже захвачен); ErrUnavailable, пока не исчерпаны failures
+func (s *Storage) change(method string) error {
+	s.call(method)
+	if s.failures > 0 {
+		s.failures--
+		return ErrUnavailable
+	}
+	return nil
+}
+
+// user Пользователь и его команда (mu уже захвачен)
+func (s *Storage) user(userID string) (*domain.User, string, bool) {
+	for i := range s.teams {
+		for j := range s.teams[i].Users {
+			if s.teams[i].Users[j].ID == userID {
+				return &s.teams[i].Users[j], s.teams[i].Name, true
+			}
+		}
+	}
+	return nil, "", false
+}
+
+// pr PR по id (mu уже захвачен)
+func (s *Storage) pr(prID string) (*domain.PullRequest, bool) {
+	for i := range s.prs {
+		if s.prs[i].ID == prID {
+			return &s.prs[i], true
+		}
+	}
+	return nil, false
+}
+
+func (s *Storage) CreateTeamWithUser(teamName string, users []domain.User, _ domain.ChangeMeta) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if err := s.change("CreateTeamWithUser"); err != nil {
+		return err
+	}
+	for _, team := range s.teams {
+		if team.Name == teamName {
+			return storage.ErrTeamAlreadyExists
+		}
+	}
+	s.teams = append(s.teams, domain.Team{Name: teamName, Users: slices.Clone(users)})
+	return nil
+}
+
+func (s *Storage) GetTeam(teamName string) (*domain.Team, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.call("GetTeam")
+	for _, team := range s.teams {
+		if team.Name == teamName {
+			team.Users = slices.Clone(team.Users)
+			return &team, nil
+		}
+	}
+	return nil, storage.ErrTeamNotFound
+}
+
+func (s *Storage) GetTeamsByNames(names []string) (map[string]*domain.Team, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.call("GetTeamsByNames")
+	res := make(map[string]*domain.Team)
+	for _, team := range s.teams {
+		if slices.Contains(names, team.Name) {
+			team.Users = slices.Clone(team.Users)
+			res[team.Name] = &team
+		}
+	}
+	return res, nil
+}
+
+func (s *Storage) GetUserByID(userID string) (*domain.User, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.call("GetUserByID")
+	user, _, ok := s.user(userID)
+	if !ok {
+		return nil, storage.ErrUserNotFound
+	}
+	res := *user
+	return &res, nil
+}
+
+func (s *Storage) GetUsersByIDs(userIDs []string) (map[string]*domain.User, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.call("GetUsersByIDs")
+	res := make(map[string]*domain.User)
+	for _, id := range userIDs {
+		if user, _, ok := s.user(id); ok {
+			found := *user
+			res[id] = &found
+		}
+	}
+	return res, nil
+}
+
+func (s *Storage) GetUserTeamByID(userID string) (string, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.call("GetUserTeamByID")
+	_, teamName, ok := s.user(userID)
+	if !ok {
+		return "", storage.ErrTeamNotFound
+	}
+	return teamName, nil
+}
+
+func (s *Storage) GetUserTeamsByIDs(userIDs []string) (map[string]string, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.call("GetUserTeamsByIDs")
+	res := make(map[string]string)
+	for _, id := range userIDs {
+		if _, teamName, ok := s.user(id); ok {
+			res[id] = teamName
+		}
+	}
+	return res, nil
+}
+
+func (s *Storage) IsUserInTeam(userID, teamName string) (bool, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.call("IsUserInTeam")
+	_, userTeam, ok := s.user(userID)
+	return ok && userTeam == teamName, nil
+}
+
+// IsPRInTeam PR принадлежит команде своего автора
+func (s *Storage) IsPRInTeam(prID, teamName string) (bool, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.call("IsPRInTeam")
+	pr, ok := s.pr(prID)
+	if !ok {
+		return false, nil
+	}
+	_, authorTeam, ok := s.user(pr.Author.ID)
+	return ok && authorTeam == teamName, nil
+}
+
+func (s *Storage) SetUserIsActive(userID string, isActive bool, _ domain.ChangeMeta) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if err := s.change("SetUserIsActive"); err != nil {
+		return err
+	}
+	user, _, ok := s.user(userID)
+	if !ok {
+		return storage.ErrUserNotFound
+	}
+	user.IsActive = isActive
+	return nil
+}
+
+// CreatePRWithReviewers Создание PR без назначения reviewer
+func (s *Storage) CreatePRWithReviewers(prID, prName, authorID string, _ domain.ChangeMeta) (*domain.PullRequest, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if err := s.change("CreatePRWithReviewers"); err != nil {
+		return nil, err
+	}
+	if _, ok := s.pr(prID); ok {
+		return nil, storage.ErrPRAlreadyExists
+	}
+	author, _, ok := s.user(authorID)
+	if !ok {
+		return nil, storage.ErrUserNotFound
+	}
+	pr := domain.PullRequest{
+		ID:        prID,
+		Name:      prName,
+		Author:    *author,
+		Status:    "OPEN",
+		Reviewers: []domain.User{},
+		CreatedAt: time.Now(),
+		Version:   1,
+	}
+	s.prs = append([]domain.PullRequest{pr}, s.prs...)
+	return &pr, nil
+}
+
+func (s *Storage) MergePR(prID string, meta domain.ChangeMeta) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if err := s.change("MergePR"); err != nil {
+		return err
+	}
+	pr, ok := s.pr(prID)
+	if !ok {
+		return storage.ErrPRNotFound
+	}
+	if meta.IfVersion != 0 && meta.IfVersion != pr.Version {
+		return storage.ErrVersionMismatch
+	}
+	if pr.Status == "MERGED" {
+		return storage.ErrPRAlreadyMerged
+	}
+	pr.Status = "MERGED"
+	pr.MergedAt = time.Now()
+	pr.Version++
+	return nil
+}
+
+func (s *Storage) GetPRByID(prID string) (*domain.PullRequest, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.call("GetPRByID")
+	pr, ok := s.pr(prID)
+	if !ok {
+		return nil, storage.ErrPRNotFound
+	}
+	res := *pr
+	return &res, nil
+}
+
+// ListPRs PR по автору, reviewer и статусу в порядке хранения, без постраничной выдачи
+func (s *Storage) ListPRs(filter domain.PRFilter) ([]*domain.PullRequest, *domain.PRCursor, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.call("ListPRs")
+	res := make([]*domain.PullRequest, 0, len(s.prs))
+	for _, pr := range s.prs {
+		switch {
+		case filter.AuthorID != "" && pr.Author.ID != filter.AuthorID,
+			filter.ReviewerID != "" && !hasReviewer(pr, filter.ReviewerID),
+			filter.Status != "" && pr.Status != filter.Status:
+			continue
+		}
+		res = append(res, &pr)
+	}
+	return res, nil, nil
+}
+
+func (s *Storage) ListUsersPRs(filter domain.UsersPRFilter) (map[string][]*domain.PullRequest, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.call("ListUsersPRs")
+	res := make(map[string][]*domain.PullRequest)
+	for _, id := range filter.UserIDs {
+		for _, pr := range s.prs {
+			if filter.Role == domain.PRRoleAuthor && pr.Author.ID == id ||
+				filter.Role != domain.PRRoleAuthor && hasReviewer(pr, id) {
+				res[id] = append(res[id], &pr)
+			}
+		}
+	}
+	return res, nil
+}
+
+func hasReviewer(pr domain.PullRequest, userID string) bool {
+	return slices.ContainsFunc(pr.Reviewers, func(reviewer domain.User) bool { return reviewer.ID == userID })
+}
+
+// GetReviewStat Число назначений по reviewer за всё время
+func (s *Storage) GetReviewStat(_ domain.StatFilter) ([]domain.UserReviewStat, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.call("GetReviewStat")
+	counts := make(map[string]int)
+	for _, pr := range s.prs {
+		for _, reviewer := range pr.Reviewers {
+			counts[reviewer.ID]++
+		}
+	}
+	res := make([]domain.UserReviewStat, 0, len(counts))
+	for userID, count := range counts {
+		res = append(res, domain.UserReviewStat{UserID: userID, ReviewCount: count})
+	}
+	slices.SortFunc(res, func(a, b domain.UserReviewStat) int { return cmp.Compare(a.UserID, b.UserID) })
+	return res, nil
+}
+
+func (s *Storage) EachReviewStat(filter domain.StatFilter, fn func(stat domain.UserReviewStat) error) error {
+	stat, err := s.GetReviewStat(filter)
+	if err != nil {
+		return err
+	}
+	for _, row := range stat {
+		if err := fn(row); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// GetAuditEvents Событие создания для каждого PR, подходящего под фильтр
+func (s *Storage) GetAuditEvents(filter domain.AuditFilter) ([]domain.AuditEvent, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.call("GetAuditEvents")
+	var res []domain.AuditEvent
+	for i, pr := range s.prs {
+		if filter.PullRequestID != "" && pr.ID != filter.PullRequestID {
+			continue
+		}
+		res = append(res, domain.AuditEvent{
+			ID:            int64(i + 1),
+			Type:          domain.AuditPRCreated,
+			PullRequestID: pr.ID,
+			UserIDs:       []string{pr.Author.ID},
+			Actor:         pr.Author.ID,
+			After:         map[string]any{"status": "OPEN"},
+			CreatedAt:     pr.CreatedAt,
+		})
+	}
+	return res, nil
+}
+
+func (s *Storage) GetWebhooks() ([]domain.Webhook, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.call("GetWebhooks")
+	return slices.Clone(s.webhooks), nil
+}
+
+func (s *Storage) CreateAPIToken(name, tokenHash, role, userID, teamName string) (*domain.APIToken, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.call("CreateAPIToken")
+	token := domain.APIToken{
+		ID:        int64(len(s.tokens) + 1),
+		Name:      name,
+		Role:      role,
+		UserID:    userID,
+		TeamName:  teamName,
+		CreatedAt: time.Now(),
+	}
+	s.tokens[tokenHash] = token
+	return &token, nil
+}
+
+func (s *Storage) GetAPITokenByHash(tokenHash string) (*domain.APIToken, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.call("GetAPITokenByHash")
+	token, ok := s.tokens[tokenHash]
+	if !ok || !token.RevokedAt.IsZero() {
+		return nil, storage.ErrTokenNotFound
+	}
+	return &token, nil
+}
+
+func (s *Storage) GetAPITokens() ([]domain.APIToken, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.call("GetAPITokens")
+	res := make([]domain.APIToken, 0, len(s.tokens))
+	for _, token := range s.tokens {
+		res = append(res, token)
+	}
+	slices.SortFunc(res, func(a, b domain.APIToken) int { return cmp.Compare(a.ID, b.ID) })
+	return res, nil
+}
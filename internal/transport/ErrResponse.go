@@ -9,9 +9,25 @@ const (
 	PR_MERGED    = "PR_MERGED"
 	NO_CANDIDATE = "NO_CANDIDATE"
 	NOT_ASSIGNED = "NOT_ASSIGNED"
+	UNAUTHORIZED = "UNAUTHORIZED"
+	FORBIDDEN    = "FORBIDDEN"
+	NOT_EMPTY    = "NOT_EMPTY"
+	// Версия PR не совпала с If-Match
+	PRECONDITION_FAILED = "PRECONDITION_FAILED"
+	// Превышен лимит частоты запросов
+	RATE_LIMITED = "RATE_LIMITED"
+	// Idempotency-Key повторно использован с другим телом или первый запрос ещё обрабатывается
+	IDEMPOTENCY_CONFLICT = "IDEMPOTENCY_CONFLICT"
 )
 
+// ErrResponse Ответ с ошибкой по спецификации (ErrorResponse в task/openapi.yml)
 type ErrResponse struct {
+	Error ErrBody `json:"error"`
+}
+
+type ErrBody struct {
 	Code    string `json:"code"`
 	Message string `json:"message"`
+	// Идентификатор запроса (X-Request-Id) для поиска в логах
+	RequestID string `json:"request_id,omitempty"`
 }
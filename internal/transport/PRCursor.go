@@ -0,0 +1,45 @@
+package transport
+
+import (
+	"encoding/base64"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/sudo-odner/Backend-trainee-assignment-avito-2025/internal/domain"
+)
+
+// Размер страницы списка PR
+const (
+	DefaultPRListLimit = 100
+	MaxPRListLimit     = 1000
+)
+
+// prCursorTimeLayout Время в курсоре хранится без зоны, как и в БД
+const prCursorTimeLayout = "2006-01-02T15:04:05.999999"
+
+// EncodePRCursor Непрозрачный курсор следующей страницы (nil, если страниц больше нет)
+func EncodePRCursor(cursor *domain.PRCursor) *string {
+	if cursor == nil {
+		return nil
+	}
+	encoded := base64.RawURLEncoding.EncodeToString(
+		[]byte(cursor.SortAt.Format(prCursorTimeLayout) + "|" + cursor.ID))
+	return &encoded
+}
+
+func DecodePRCursor(raw string) (*domain.PRCursor, error) {
+	data, err := base64.RawURLEncoding.DecodeString(raw)
+	if err != nil {
+		return nil, err
+	}
+	rawTime, id, ok := strings.Cut(string(data), "|")
+	if !ok {
+		return nil, fmt.Errorf("malformed cursor")
+	}
+	sortAt, err := time.ParseInLocation(prCursorTimeLayout, rawTime, time.UTC)
+	if err != nil {
+		return nil, err
+	}
+	return &domain.PRCursor{SortAt: sortAt, ID: id}, nil
+}
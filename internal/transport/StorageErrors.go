@@ -0,0 +1,45 @@
+package transport
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/sudo-odner/Backend-trainee-assignment-avito-2025/internal/storage"
+)
+
+// ErrorInfo HTTP статус, код и сообщение ответа для ошибки
+type ErrorInfo struct {
+	Status  int
+	Code    string
+	Message string
+}
+
+// storageErrors Ответ для известных ошибок storage (общий для HTTP и gRPC)
+var storageErrors = []struct {
+	err  error
+	info ErrorInfo
+}{
+	{storage.ErrTeamAlreadyExists, ErrorInfo{http.StatusBadRequest, TEAM_EXISTS, "team_name already exists"}},
+	{storage.ErrPRAlreadyExists, ErrorInfo{http.StatusConflict, PR_EXISTS, "PR id already exists"}},
+	{storage.ErrPRAlreadyMerged, ErrorInfo{http.StatusConflict, PR_MERGED, "pull request is already merged"}},
+	{storage.ErrReviewerNotAssigned, ErrorInfo{http.StatusConflict, NOT_ASSIGNED, "reviewer is not assigned to this PR"}},
+	{storage.ErrNoCandidate, ErrorInfo{http.StatusConflict, NO_CANDIDATE, "no active replacement candidate in team"}},
+	{storage.ErrStorageNotEmpty, ErrorInfo{http.StatusConflict, NOT_EMPTY, "restore is only allowed into an empty database"}},
+	{storage.ErrVersionMismatch, ErrorInfo{http.StatusPreconditionFailed, PRECONDITION_FAILED, "pull request was modified, reload it and retry"}},
+	{storage.ErrTeamNotFound, ErrorInfo{http.StatusNotFound, NOT_FOUND, "resource not found"}},
+	{storage.ErrUserNotFound, ErrorInfo{http.StatusNotFound, NOT_FOUND, "resource not found"}},
+	{storage.ErrPRNotFound, ErrorInfo{http.StatusNotFound, NOT_FOUND, "resource not found"}},
+	{storage.ErrWebhookNotFound, ErrorInfo{http.StatusNotFound, NOT_FOUND, "resource not found"}},
+	{storage.ErrDeliveryNotFound, ErrorInfo{http.StatusNotFound, NOT_FOUND, "resource not found"}},
+	{storage.ErrTokenNotFound, ErrorInfo{http.StatusNotFound, NOT_FOUND, "resource not found"}},
+}
+
+// StorageError Ответ для ошибки storage (false - ошибка неизвестна, отвечать SERVER_ERROR без деталей)
+func StorageError(err error) (ErrorInfo, bool) {
+	for _, known := range storageErrors {
+		if errors.Is(err, known.err) {
+			return known.info, true
+		}
+	}
+	return ErrorInfo{}, false
+}
@@ -0,0 +1,5856 @@
+// Package api provides primitives to interact with the openapi HTTP API.
+//
+// Code generated by github.com/oapi-codegen/oapi-codegen/v2 version v2.7.2 DO NOT EDIT.
+package api
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"time"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/oapi-codegen/runtime"
+)
+
+// Defines values for ErrorResponseErrorCode.
+const (
+	BADREQUEST          ErrorResponseErrorCode = "BAD_REQUEST"
+	FORBIDDEN           ErrorResponseErrorCode = "FORBIDDEN"
+	IDEMPOTENCYCONFLICT ErrorResponseErrorCode = "IDEMPOTENCY_CONFLICT"
+	NOCANDIDATE         ErrorResponseErrorCode = "NO_CANDIDATE"
+	NOTASSIGNED         ErrorResponseErrorCode = "NOT_ASSIGNED"
+	NOTEMPTY            ErrorResponseErrorCode = "NOT_EMPTY"
+	NOTFOUND            ErrorResponseErrorCode = "NOT_FOUND"
+	PRECONDITIONFAILED  ErrorResponseErrorCode = "PRECONDITION_FAILED"
+	PREXISTS            ErrorResponseErrorCode = "PR_EXISTS"
+	PRMERGED            ErrorResponseErrorCode = "PR_MERGED"
+	RATELIMITED         ErrorResponseErrorCode = "RATE_LIMITED"
+	SERVERERROR         ErrorResponseErrorCode = "SERVER_ERROR"
+	TEAMEXISTS          ErrorResponseErrorCode = "TEAM_EXISTS"
+	UNAUTHORIZED        ErrorResponseErrorCode = "UNAUTHORIZED"
+)
+
+// Valid indicates whether the value is a known member of the ErrorResponseErrorCode enum.
+func (e ErrorResponseErrorCode) Valid() bool {
+	switch e {
+	case BADREQUEST:
+		return true
+	case FORBIDDEN:
+		return true
+	case IDEMPOTENCYCONFLICT:
+		return true
+	case NOCANDIDATE:
+		return true
+	case NOTASSIGNED:
+		return true
+	case NOTEMPTY:
+		return true
+	case NOTFOUND:
+		return true
+	case PRECONDITIONFAILED:
+		return true
+	case PREXISTS:
+		return true
+	case PRMERGED:
+		return true
+	case RATELIMITED:
+		return true
+	case SERVERERROR:
+		return true
+	case TEAMEXISTS:
+		return true
+	case UNAUTHORIZED:
+		return true
+	default:
+		return false
+	}
+}
+
+// Defines values for PullRequestStatus.
+const (
+	PullRequestStatusMERGED PullRequestStatus = "MERGED"
+	PullRequestStatusOPEN   PullRequestStatus = "OPEN"
+)
+
+// Valid indicates whether the value is a known member of the PullRequestStatus enum.
+func (e PullRequestStatus) Valid() bool {
+	switch e {
+	case PullRequestStatusMERGED:
+		return true
+	case PullRequestStatusOPEN:
+		return true
+	default:
+		return false
+	}
+}
+
+// Defines values for PullRequestDetailsStatus.
+const (
+	PullRequestDetailsStatusMERGED PullRequestDetailsStatus = "MERGED"
+	PullRequestDetailsStatusOPEN   PullRequestDetailsStatus = "OPEN"
+)
+
+// Valid indicates whether the value is a known member of the PullRequestDetailsStatus enum.
+func (e PullRequestDetailsStatus) Valid() bool {
+	switch e {
+	case PullRequestDetailsStatusMERGED:
+		return true
+	case PullRequestDetailsStatusOPEN:
+		return true
+	default:
+		return false
+	}
+}
+
+// Defines values for PullRequestShortStatus.
+const (
+	PullRequestShortStatusMERGED PullRequestShortStatus = "MERGED"
+	PullRequestShortStatusOPEN   PullRequestShortStatus = "OPEN"
+)
+
+// Valid indicates whether the value is a known member of the PullRequestShortStatus enum.
+func (e PullRequestShortStatus) Valid() bool {
+	switch e {
+	case PullRequestShortStatusMERGED:
+		return true
+	case PullRequestShortStatusOPEN:
+		return true
+	default:
+		return false
+	}
+}
+
+// Defines values for TokenRole.
+const (
+	TokenRoleAdmin    TokenRole = "admin"
+	TokenRoleTeamLead TokenRole = "team_lead"
+	TokenRoleUser     TokenRole = "user"
+)
+
+// Valid indicates whether the value is a known member of the TokenRole enum.
+func (e TokenRole) Valid() bool {
+	switch e {
+	case TokenRoleAdmin:
+		return true
+	case TokenRoleTeamLead:
+		return true
+	case TokenRoleUser:
+		return true
+	default:
+		return false
+	}
+}
+
+// Defines values for ExportFormatQuery.
+const (
+	ExportFormatQueryCsv    ExportFormatQuery = "csv"
+	ExportFormatQueryNdjson ExportFormatQuery = "ndjson"
+)
+
+// Valid indicates whether the value is a known member of the ExportFormatQuery enum.
+func (e ExportFormatQuery) Valid() bool {
+	switch e {
+	case ExportFormatQueryCsv:
+		return true
+	case ExportFormatQueryNdjson:
+		return true
+	default:
+		return false
+	}
+}
+
+// Defines values for OrderQuery.
+const (
+	OrderQueryAsc  OrderQuery = "asc"
+	OrderQueryDesc OrderQuery = "desc"
+)
+
+// Valid indicates whether the value is a known member of the OrderQuery enum.
+func (e OrderQuery) Valid() bool {
+	switch e {
+	case OrderQueryAsc:
+		return true
+	case OrderQueryDesc:
+		return true
+	default:
+		return false
+	}
+}
+
+// Defines values for StatusFilter.
+const (
+	StatusFilterMERGED StatusFilter = "MERGED"
+	StatusFilterOPEN   StatusFilter = "OPEN"
+)
+
+// Valid indicates whether the value is a known member of the StatusFilter enum.
+func (e StatusFilter) Valid() bool {
+	switch e {
+	case StatusFilterMERGED:
+		return true
+	case StatusFilterOPEN:
+		return true
+	default:
+		return false
+	}
+}
+
+// Defines values for GetExportAssignmentsParamsFormat.
+const (
+	GetExportAssignmentsParamsFormatCsv    GetExportAssignmentsParamsFormat = "csv"
+	GetExportAssignmentsParamsFormatNdjson GetExportAssignmentsParamsFormat = "ndjson"
+)
+
+// Valid indicates whether the value is a known member of the GetExportAssignmentsParamsFormat enum.
+func (e GetExportAssignmentsParamsFormat) Valid() bool {
+	switch e {
+	case GetExportAssignmentsParamsFormatCsv:
+		return true
+	case GetExportAssignmentsParamsFormatNdjson:
+		return true
+	default:
+		return false
+	}
+}
+
+// Defines values for GetExportPullRequestsParamsFormat.
+const (
+	GetExportPullRequestsParamsFormatCsv    GetExportPullRequestsParamsFormat = "csv"
+	GetExportPullRequestsParamsFormatNdjson GetExportPullRequestsParamsFormat = "ndjson"
+)
+
+// Valid indicates whether the value is a known member of the GetExportPullRequestsParamsFormat enum.
+func (e GetExportPullRequestsParamsFormat) Valid() bool {
+	switch e {
+	case GetExportPullRequestsParamsFormatCsv:
+		return true
+	case GetExportPullRequestsParamsFormatNdjson:
+		return true
+	default:
+		return false
+	}
+}
+
+// Defines values for GetExportReviewsParamsStatus.
+const (
+	GetExportReviewsParamsStatusMERGED GetExportReviewsParamsStatus = "MERGED"
+	GetExportReviewsParamsStatusOPEN   GetExportReviewsParamsStatus = "OPEN"
+)
+
+// Valid indicates whether the value is a known member of the GetExportReviewsParamsStatus enum.
+func (e GetExportReviewsParamsStatus) Valid() bool {
+	switch e {
+	case GetExportReviewsParamsStatusMERGED:
+		return true
+	case GetExportReviewsParamsStatusOPEN:
+		return true
+	default:
+		return false
+	}
+}
+
+// Defines values for GetExportReviewsParamsFormat.
+const (
+	GetExportReviewsParamsFormatCsv    GetExportReviewsParamsFormat = "csv"
+	GetExportReviewsParamsFormatNdjson GetExportReviewsParamsFormat = "ndjson"
+)
+
+// Valid indicates whether the value is a known member of the GetExportReviewsParamsFormat enum.
+func (e GetExportReviewsParamsFormat) Valid() bool {
+	switch e {
+	case GetExportReviewsParamsFormatCsv:
+		return true
+	case GetExportReviewsParamsFormatNdjson:
+		return true
+	default:
+		return false
+	}
+}
+
+// Defines values for GetPullRequestListParamsStatus.
+const (
+	GetPullRequestListParamsStatusMERGED GetPullRequestListParamsStatus = "MERGED"
+	GetPullRequestListParamsStatusOPEN   GetPullRequestListParamsStatus = "OPEN"
+)
+
+// Valid indicates whether the value is a known member of the GetPullRequestListParamsStatus enum.
+func (e GetPullRequestListParamsStatus) Valid() bool {
+	switch e {
+	case GetPullRequestListParamsStatusMERGED:
+		return true
+	case GetPullRequestListParamsStatusOPEN:
+		return true
+	default:
+		return false
+	}
+}
+
+// Defines values for GetPullRequestListParamsOrder.
+const (
+	GetPullRequestListParamsOrderAsc  GetPullRequestListParamsOrder = "asc"
+	GetPullRequestListParamsOrderDesc GetPullRequestListParamsOrder = "desc"
+)
+
+// Valid indicates whether the value is a known member of the GetPullRequestListParamsOrder enum.
+func (e GetPullRequestListParamsOrder) Valid() bool {
+	switch e {
+	case GetPullRequestListParamsOrderAsc:
+		return true
+	case GetPullRequestListParamsOrderDesc:
+		return true
+	default:
+		return false
+	}
+}
+
+// Defines values for GetPullRequestListParamsSort.
+const (
+	CreatedAt GetPullRequestListParamsSort = "created_at"
+	MergedAt  GetPullRequestListParamsSort = "merged_at"
+)
+
+// Valid indicates whether the value is a known member of the GetPullRequestListParamsSort enum.
+func (e GetPullRequestListParamsSort) Valid() bool {
+	switch e {
+	case CreatedAt:
+		return true
+	case MergedAt:
+		return true
+	default:
+		return false
+	}
+}
+
+// Defines values for GetStatisticFairnessParamsStatus.
+const (
+	GetStatisticFairnessParamsStatusMERGED GetStatisticFairnessParamsStatus = "MERGED"
+	GetStatisticFairnessParamsStatusOPEN   GetStatisticFairnessParamsStatus = "OPEN"
+)
+
+// Valid indicates whether the value is a known member of the GetStatisticFairnessParamsStatus enum.
+func (e GetStatisticFairnessParamsStatus) Valid() bool {
+	switch e {
+	case GetStatisticFairnessParamsStatusMERGED:
+		return true
+	case GetStatisticFairnessParamsStatusOPEN:
+		return true
+	default:
+		return false
+	}
+}
+
+// Defines values for GetStatisticPullRequestsParamsStatus.
+const (
+	GetStatisticPullRequestsParamsStatusMERGED GetStatisticPullRequestsParamsStatus = "MERGED"
+	GetStatisticPullRequestsParamsStatusOPEN   GetStatisticPullRequestsParamsStatus = "OPEN"
+)
+
+// Valid indicates whether the value is a known member of the GetStatisticPullRequestsParamsStatus enum.
+func (e GetStatisticPullRequestsParamsStatus) Valid() bool {
+	switch e {
+	case GetStatisticPullRequestsParamsStatusMERGED:
+		return true
+	case GetStatisticPullRequestsParamsStatusOPEN:
+		return true
+	default:
+		return false
+	}
+}
+
+// Defines values for GetStatisticReviewsParamsStatus.
+const (
+	GetStatisticReviewsParamsStatusMERGED GetStatisticReviewsParamsStatus = "MERGED"
+	GetStatisticReviewsParamsStatusOPEN   GetStatisticReviewsParamsStatus = "OPEN"
+)
+
+// Valid indicates whether the value is a known member of the GetStatisticReviewsParamsStatus enum.
+func (e GetStatisticReviewsParamsStatus) Valid() bool {
+	switch e {
+	case GetStatisticReviewsParamsStatusMERGED:
+		return true
+	case GetStatisticReviewsParamsStatusOPEN:
+		return true
+	default:
+		return false
+	}
+}
+
+// Defines values for GetStatisticTeamsParamsStatus.
+const (
+	GetStatisticTeamsParamsStatusMERGED GetStatisticTeamsParamsStatus = "MERGED"
+	GetStatisticTeamsParamsStatusOPEN   GetStatisticTeamsParamsStatus = "OPEN"
+)
+
+// Valid indicates whether the value is a known member of the GetStatisticTeamsParamsStatus enum.
+func (e GetStatisticTeamsParamsStatus) Valid() bool {
+	switch e {
+	case GetStatisticTeamsParamsStatusMERGED:
+		return true
+	case GetStatisticTeamsParamsStatusOPEN:
+		return true
+	default:
+		return false
+	}
+}
+
+// Defines values for PostTeamImportParamsFormat.
+const (
+	Csv  PostTeamImportParamsFormat = "csv"
+	Yaml PostTeamImportParamsFormat = "yaml"
+)
+
+// Valid indicates whether the value is a known member of the PostTeamImportParamsFormat enum.
+func (e PostTeamImportParamsFormat) Valid() bool {
+	switch e {
+	case Csv:
+		return true
+	case Yaml:
+		return true
+	default:
+		return false
+	}
+}
+
+// Defines values for GetUsersGetReviewParamsRole.
+const (
+	Author   GetUsersGetReviewParamsRole = "author"
+	Reviewer GetUsersGetReviewParamsRole = "reviewer"
+)
+
+// Valid indicates whether the value is a known member of the GetUsersGetReviewParamsRole enum.
+func (e GetUsersGetReviewParamsRole) Valid() bool {
+	switch e {
+	case Author:
+		return true
+	case Reviewer:
+		return true
+	default:
+		return false
+	}
+}
+
+// Defines values for GetUsersGetReviewParamsStatus.
+const (
+	MERGED GetUsersGetReviewParamsStatus = "MERGED"
+	OPEN   GetUsersGetReviewParamsStatus = "OPEN"
+)
+
+// Valid indicates whether the value is a known member of the GetUsersGetReviewParamsStatus enum.
+func (e GetUsersGetReviewParamsStatus) Valid() bool {
+	switch e {
+	case MERGED:
+		return true
+	case OPEN:
+		return true
+	default:
+		return false
+	}
+}
+
+// Defines values for GetUsersGetReviewParamsOrder.
+const (
+	Asc  GetUsersGetReviewParamsOrder = "asc"
+	Desc GetUsersGetReviewParamsOrder = "desc"
+)
+
+// Valid indicates whether the value is a known member of the GetUsersGetReviewParamsOrder enum.
+func (e GetUsersGetReviewParamsOrder) Valid() bool {
+	switch e {
+	case Asc:
+		return true
+	case Desc:
+		return true
+	default:
+		return false
+	}
+}
+
+// Defines values for PostWebhooksRetry200JSONResponseBodyStatus.
+const (
+	PENDING PostWebhooksRetry200JSONResponseBodyStatus = "PENDING"
+)
+
+// Valid indicates whether the value is a known member of the PostWebhooksRetry200JSONResponseBodyStatus enum.
+func (e PostWebhooksRetry200JSONResponseBodyStatus) Valid() bool {
+	switch e {
+	case PENDING:
+		return true
+	default:
+		return false
+	}
+}
+
+// APIToken defines model for APIToken.
+type APIToken struct {
+	CreatedAt time.Time `json:"created_at"`
+	Name      string    `json:"name"`
+	Role      TokenRole `json:"role"`
+	TeamName  *string   `json:"team_name,omitempty"`
+	TokenId   int64     `json:"token_id"`
+	UserId    *string   `json:"user_id,omitempty"`
+}
+
+// AuditEvent defines model for AuditEvent.
+type AuditEvent struct {
+	Actor string `json:"actor"`
+
+	// After Состояние до или после изменения
+	After *Change `json:"after"`
+
+	// Before Состояние до или после изменения
+	Before        *Change   `json:"before"`
+	CreatedAt     time.Time `json:"created_at"`
+	EventId       int64     `json:"event_id"`
+	PullRequestId *string   `json:"pull_request_id,omitempty"`
+	Reason        *string   `json:"reason,omitempty"`
+	TeamName      *string   `json:"team_name,omitempty"`
+	Type          string    `json:"type"`
+	UserIds       []string  `json:"user_ids"`
+}
+
+// BackupArchive Резервная копия данных сервиса
+type BackupArchive = json.RawMessage
+
+// Change Состояние до или после изменения
+type Change map[string]interface{}
+
+// DeadDelivery defines model for DeadDelivery.
+type DeadDelivery struct {
+	Attempts   int       `json:"attempts"`
+	DeliveryId int64     `json:"delivery_id"`
+	EventId    int64     `json:"event_id"`
+	EventType  string    `json:"event_type"`
+	FailedAt   time.Time `json:"failed_at"`
+	LastError  string    `json:"last_error"`
+	OccurredAt time.Time `json:"occurred_at"`
+
+	// Payload Данные события
+	Payload   json.RawMessage `json:"payload"`
+	Url       string          `json:"url"`
+	WebhookId int64           `json:"webhook_id"`
+}
+
+// ErrorResponse defines model for ErrorResponse.
+type ErrorResponse struct {
+	Error struct {
+		Code    ErrorResponseErrorCode `json:"code"`
+		Message string                 `json:"message"`
+
+		// RequestId Идентификатор запроса для поиска в логах сервиса
+		RequestId *string `json:"request_id,omitempty"`
+	} `json:"error"`
+}
+
+// ErrorResponseErrorCode defines model for ErrorResponse.Error.Code.
+type ErrorResponseErrorCode string
+
+// ImportSummary defines model for ImportSummary.
+type ImportSummary struct {
+	// Created user_id созданных пользователей
+	Created      []string `json:"created"`
+	CreatedTeams []string `json:"created_teams"`
+	DryRun       bool     `json:"dry_run"`
+
+	// Moved user_id пользователей, перешедших из другой команды
+	Moved     []string `json:"moved"`
+	Unchanged []string `json:"unchanged"`
+	Updated   []string `json:"updated"`
+}
+
+// OverdueReview defines model for OverdueReview.
+type OverdueReview struct {
+	AssignedAt time.Time `json:"assigned_at"`
+	AuthorId   string    `json:"author_id"`
+	DueAt      time.Time `json:"due_at"`
+
+	// OverdueAt Когда просрочка отмечена SLA воркером
+	OverdueAt       *time.Time `json:"overdue_at"`
+	PullRequestId   string     `json:"pull_request_id"`
+	PullRequestName string     `json:"pull_request_name"`
+	ReviewerId      string     `json:"reviewer_id"`
+	TeamName        string     `json:"team_name"`
+}
+
+// PullRequest defines model for PullRequest.
+type PullRequest struct {
+	// AssignedReviewers user_id назначенных ревьюверов (0..2)
+	AssignedReviewers []string          `json:"assigned_reviewers"`
+	AuthorId          string            `json:"author_id"`
+	CreatedAt         *time.Time        `json:"createdAt,omitempty"`
+	MergedAt          *time.Time        `json:"mergedAt,omitempty"`
+	PullRequestId     string            `json:"pull_request_id"`
+	PullRequestName   string            `json:"pull_request_name"`
+	Status            PullRequestStatus `json:"status"`
+}
+
+// PullRequestStatus defines model for PullRequest.Status.
+type PullRequestStatus string
+
+// PullRequestDetails defines model for PullRequestDetails.
+type PullRequestDetails struct {
+	AssignedReviewers []string                 `json:"assigned_reviewers"`
+	AuthorId          string                   `json:"author_id"`
+	CreatedAt         *time.Time               `json:"createdAt,omitempty"`
+	MergedAt          *time.Time               `json:"mergedAt,omitempty"`
+	PullRequestId     string                   `json:"pull_request_id"`
+	PullRequestName   string                   `json:"pull_request_name"`
+	Reviewers         []TeamMember             `json:"reviewers"`
+	Status            PullRequestDetailsStatus `json:"status"`
+}
+
+// PullRequestDetailsStatus defines model for PullRequestDetails.Status.
+type PullRequestDetailsStatus string
+
+// PullRequestShort defines model for PullRequestShort.
+type PullRequestShort struct {
+	AuthorId        string                 `json:"author_id"`
+	PullRequestId   string                 `json:"pull_request_id"`
+	PullRequestName string                 `json:"pull_request_name"`
+	Status          PullRequestShortStatus `json:"status"`
+}
+
+// PullRequestShortStatus defines model for PullRequestShort.Status.
+type PullRequestShortStatus string
+
+// PullRequestStat defines model for PullRequestStat.
+type PullRequestStat struct {
+	AvgReviewersPerPr  float64 `json:"avg_reviewers_per_pr"`
+	MergedPullRequests int     `json:"merged_pull_requests"`
+	OpenPullRequests   int     `json:"open_pull_requests"`
+	PullRequests       int     `json:"pull_requests"`
+	TimeToMerge        struct {
+		P50Seconds float64 `json:"p50_seconds"`
+		P90Seconds float64 `json:"p90_seconds"`
+		P99Seconds float64 `json:"p99_seconds"`
+	} `json:"time_to_merge"`
+}
+
+// RebalanceMove defines model for RebalanceMove.
+type RebalanceMove struct {
+	FromReviewerId string `json:"from_reviewer_id"`
+	PullRequestId  string `json:"pull_request_id"`
+	ToReviewerId   string `json:"to_reviewer_id"`
+}
+
+// ReviewStat defines model for ReviewStat.
+type ReviewStat struct {
+	ReviewCount int    `json:"review_count"`
+	UserId      string `json:"user_id"`
+}
+
+// ReviewerDecline defines model for ReviewerDecline.
+type ReviewerDecline struct {
+	DeclinedAt time.Time `json:"declined_at"`
+	Reason     string    `json:"reason"`
+
+	// ReplacedBy user_id замены (null - замены не нашлось)
+	ReplacedBy *string `json:"replaced_by"`
+	ReviewerId string  `json:"reviewer_id"`
+}
+
+// Team defines model for Team.
+type Team struct {
+	Members  []TeamMember `json:"members"`
+	TeamName string       `json:"team_name"`
+}
+
+// TeamFairness defines model for TeamFairness.
+type TeamFairness struct {
+	// Gini Коэффициент Джини нагрузки (0 - равномерно)
+	Gini     float64      `json:"gini"`
+	Max      int          `json:"max"`
+	Mean     float64      `json:"mean"`
+	Members  []ReviewStat `json:"members"`
+	Min      int          `json:"min"`
+	Stddev   float64      `json:"stddev"`
+	TeamName string       `json:"team_name"`
+}
+
+// TeamMember defines model for TeamMember.
+type TeamMember struct {
+	IsActive bool   `json:"is_active"`
+	UserId   string `json:"user_id"`
+	Username string `json:"username"`
+}
+
+// TeamStat defines model for TeamStat.
+type TeamStat struct {
+	ActiveMembers      int     `json:"active_members"`
+	AvgReviewersPerPr  float64 `json:"avg_reviewers_per_pr"`
+	Members            int     `json:"members"`
+	MergedPullRequests int     `json:"merged_pull_requests"`
+	OpenPullRequests   int     `json:"open_pull_requests"`
+	PullRequests       int     `json:"pull_requests"`
+	ReviewAssignments  int     `json:"review_assignments"`
+	TeamName           string  `json:"team_name"`
+}
+
+// TimelineItem defines model for TimelineItem.
+type TimelineItem struct {
+	Actor string `json:"actor"`
+
+	// After Состояние до или после изменения
+	After *Change   `json:"after"`
+	At    time.Time `json:"at"`
+
+	// Before Состояние до или после изменения
+	Before *Change `json:"before"`
+	Reason *string `json:"reason,omitempty"`
+	Type   string  `json:"type"`
+}
+
+// TokenRole defines model for TokenRole.
+type TokenRole string
+
+// User defines model for User.
+type User struct {
+	IsActive bool   `json:"is_active"`
+	TeamName string `json:"team_name"`
+	UserId   string `json:"user_id"`
+	Username string `json:"username"`
+}
+
+// Webhook defines model for Webhook.
+type Webhook struct {
+	CreatedAt time.Time `json:"created_at"`
+
+	// EventTypes Типы событий (пустой список - все события)
+	EventTypes []string `json:"event_types"`
+	IsActive   bool     `json:"is_active"`
+	Url        string   `json:"url"`
+	WebhookId  int64    `json:"webhook_id"`
+}
+
+// CursorQuery defines model for CursorQuery.
+type CursorQuery = string
+
+// ExportFormatQuery defines model for ExportFormatQuery.
+type ExportFormatQuery string
+
+// FromQuery defines model for FromQuery.
+type FromQuery = time.Time
+
+// IfMatchHeader defines model for IfMatchHeader.
+type IfMatchHeader = string
+
+// OrderQuery defines model for OrderQuery.
+type OrderQuery string
+
+// PullRequestIdQuery defines model for PullRequestIdQuery.
+type PullRequestIdQuery = string
+
+// PullRequestLimitQuery defines model for PullRequestLimitQuery.
+type PullRequestLimitQuery = int
+
+// StatusFilter defines model for StatusFilter.
+type StatusFilter string
+
+// TeamNameFilter defines model for TeamNameFilter.
+type TeamNameFilter = string
+
+// TeamNameQuery defines model for TeamNameQuery.
+type TeamNameQuery = string
+
+// ToQuery defines model for ToQuery.
+type ToQuery = time.Time
+
+// UserIdQuery defines model for UserIdQuery.
+type UserIdQuery = string
+
+// GetAuditParams defines parameters for GetAudit.
+type GetAuditParams struct {
+	PullRequestId *string `form:"pull_request_id,omitempty" json:"pull_request_id,omitempty"`
+	UserId        *string `form:"user_id,omitempty" json:"user_id,omitempty"`
+
+	// TeamName Только команда
+	TeamName *TeamNameFilter `form:"team_name,omitempty" json:"team_name,omitempty"`
+
+	// From Начало окна [from, to)
+	From *FromQuery `form:"from,omitempty" json:"from,omitempty"`
+
+	// To Конец окна [from, to)
+	To *ToQuery `form:"to,omitempty" json:"to,omitempty"`
+
+	// AfterId Курсор следующей страницы (next_after_id из предыдущего ответа)
+	AfterId *int64 `form:"after_id,omitempty" json:"after_id,omitempty"`
+	Limit   *int   `form:"limit,omitempty" json:"limit,omitempty"`
+}
+
+// PostAuthTokensCreateJSONBody defines parameters for PostAuthTokensCreate.
+type PostAuthTokensCreateJSONBody struct {
+	Name string    `json:"name"`
+	Role TokenRole `json:"role"`
+
+	// TeamName Команда руководителя (только для роли team_lead)
+	TeamName *string `json:"team_name,omitempty"`
+
+	// UserId Пользователь токена (обязателен для ролей кроме admin)
+	UserId *string `json:"user_id,omitempty"`
+}
+
+// PostAuthTokensRevokeJSONBody defines parameters for PostAuthTokensRevoke.
+type PostAuthTokensRevokeJSONBody struct {
+	TokenId int64 `json:"token_id"`
+}
+
+// GetExportAssignmentsParams defines parameters for GetExportAssignments.
+type GetExportAssignmentsParams struct {
+	PullRequestId *string `form:"pull_request_id,omitempty" json:"pull_request_id,omitempty"`
+	UserId        *string `form:"user_id,omitempty" json:"user_id,omitempty"`
+
+	// TeamName Только команда
+	TeamName *TeamNameFilter `form:"team_name,omitempty" json:"team_name,omitempty"`
+
+	// From Начало окна [from, to)
+	From *FromQuery `form:"from,omitempty" json:"from,omitempty"`
+
+	// To Конец окна [from, to)
+	To *ToQuery `form:"to,omitempty" json:"to,omitempty"`
+
+	// Format Формат выгрузки; без параметра - по заголовку Accept (application/x-ndjson), иначе CSV
+	Format *GetExportAssignmentsParamsFormat `form:"format,omitempty" json:"format,omitempty"`
+}
+
+// GetExportAssignmentsParamsFormat defines parameters for GetExportAssignments.
+type GetExportAssignmentsParamsFormat string
+
+// GetExportPullRequestsParams defines parameters for GetExportPullRequests.
+type GetExportPullRequestsParams struct {
+	// AuthorId Только PR автора
+	AuthorId *string `form:"author_id,omitempty" json:"author_id,omitempty"`
+
+	// Format Формат выгрузки; без параметра - по заголовку Accept (application/x-ndjson), иначе CSV
+	Format *GetExportPullRequestsParamsFormat `form:"format,omitempty" json:"format,omitempty"`
+}
+
+// GetExportPullRequestsParamsFormat defines parameters for GetExportPullRequests.
+type GetExportPullRequestsParamsFormat string
+
+// GetExportReviewsParams defines parameters for GetExportReviews.
+type GetExportReviewsParams struct {
+	// From Начало окна [from, to)
+	From *FromQuery `form:"from,omitempty" json:"from,omitempty"`
+
+	// To Конец окна [from, to)
+	To *ToQuery `form:"to,omitempty" json:"to,omitempty"`
+
+	// TeamName Только команда
+	TeamName *TeamNameFilter               `form:"team_name,omitempty" json:"team_name,omitempty"`
+	Status   *GetExportReviewsParamsStatus `form:"status,omitempty" json:"status,omitempty"`
+
+	// Format Формат выгрузки; без параметра - по заголовку Accept (application/x-ndjson), иначе CSV
+	Format *GetExportReviewsParamsFormat `form:"format,omitempty" json:"format,omitempty"`
+}
+
+// GetExportReviewsParamsStatus defines parameters for GetExportReviews.
+type GetExportReviewsParamsStatus string
+
+// GetExportReviewsParamsFormat defines parameters for GetExportReviews.
+type GetExportReviewsParamsFormat string
+
+// PostPullRequestCreateJSONBody defines parameters for PostPullRequestCreate.
+type PostPullRequestCreateJSONBody struct {
+	AuthorId        string `json:"author_id"`
+	PullRequestId   string `json:"pull_request_id"`
+	PullRequestName string `json:"pull_request_name"`
+}
+
+// PostPullRequestDeclineJSONBody defines parameters for PostPullRequestDecline.
+type PostPullRequestDeclineJSONBody struct {
+	PullRequestId string `json:"pull_request_id"`
+	Reason        string `json:"reason"`
+	ReviewerId    string `json:"reviewer_id"`
+}
+
+// PostPullRequestDeclineParams defines parameters for PostPullRequestDecline.
+type PostPullRequestDeclineParams struct {
+	// IfMatch Версия PR из ETag; при несовпадении изменение не выполняется (412)
+	IfMatch *IfMatchHeader `json:"If-Match,omitempty"`
+}
+
+// GetPullRequestDeclinesParams defines parameters for GetPullRequestDeclines.
+type GetPullRequestDeclinesParams struct {
+	// PullRequestId Идентификатор PR
+	PullRequestId PullRequestIdQuery `form:"pull_request_id" json:"pull_request_id"`
+}
+
+// GetPullRequestGetParams defines parameters for GetPullRequestGet.
+type GetPullRequestGetParams struct {
+	// PullRequestId Идентификатор PR
+	PullRequestId PullRequestIdQuery `form:"pull_request_id" json:"pull_request_id"`
+}
+
+// GetPullRequestListParams defines parameters for GetPullRequestList.
+type GetPullRequestListParams struct {
+	Status *GetPullRequestListParamsStatus `form:"status,omitempty" json:"status,omitempty"`
+
+	// Order Сортировка по времени создания PR
+	Order *GetPullRequestListParamsOrder `form:"order,omitempty" json:"order,omitempty"`
+
+	// Cursor Курсор следующей страницы (next_cursor из предыдущего ответа)
+	Cursor     *CursorQuery           `form:"cursor,omitempty" json:"cursor,omitempty"`
+	Limit      *PullRequestLimitQuery `form:"limit,omitempty" json:"limit,omitempty"`
+	AuthorId   *string                `form:"author_id,omitempty" json:"author_id,omitempty"`
+	ReviewerId *string                `form:"reviewer_id,omitempty" json:"reviewer_id,omitempty"`
+
+	// TeamName Только команда
+	TeamName *TeamNameFilter `form:"team_name,omitempty" json:"team_name,omitempty"`
+
+	// Q Поиск по названию PR
+	Q           *string                       `form:"q,omitempty" json:"q,omitempty"`
+	Sort        *GetPullRequestListParamsSort `form:"sort,omitempty" json:"sort,omitempty"`
+	CreatedFrom *time.Time                    `form:"created_from,omitempty" json:"created_from,omitempty"`
+	CreatedTo   *time.Time                    `form:"created_to,omitempty" json:"created_to,omitempty"`
+	MergedFrom  *time.Time                    `form:"merged_from,omitempty" json:"merged_from,omitempty"`
+	MergedTo    *time.Time                    `form:"merged_to,omitempty" json:"merged_to,omitempty"`
+}
+
+// GetPullRequestListParamsStatus defines parameters for GetPullRequestList.
+type GetPullRequestListParamsStatus string
+
+// GetPullRequestListParamsOrder defines parameters for GetPullRequestList.
+type GetPullRequestListParamsOrder string
+
+// GetPullRequestListParamsSort defines parameters for GetPullRequestList.
+type GetPullRequestListParamsSort string
+
+// PostPullRequestMergeJSONBody defines parameters for PostPullRequestMerge.
+type PostPullRequestMergeJSONBody struct {
+	PullRequestId string `json:"pull_request_id"`
+}
+
+// PostPullRequestMergeParams defines parameters for PostPullRequestMerge.
+type PostPullRequestMergeParams struct {
+	// IfMatch Версия PR из ETag; при несовпадении изменение не выполняется (412)
+	IfMatch *IfMatchHeader `json:"If-Match,omitempty"`
+}
+
+// GetPullRequestOverdueParams defines parameters for GetPullRequestOverdue.
+type GetPullRequestOverdueParams struct {
+	// TeamName Только команда
+	TeamName   *TeamNameFilter `form:"team_name,omitempty" json:"team_name,omitempty"`
+	ReviewerId *string         `form:"reviewer_id,omitempty" json:"reviewer_id,omitempty"`
+}
+
+// PostPullRequestReassignJSONBody defines parameters for PostPullRequestReassign.
+type PostPullRequestReassignJSONBody struct {
+	OldReviewerId string `json:"old_reviewer_id"`
+	PullRequestId string `json:"pull_request_id"`
+}
+
+// PostPullRequestReassignParams defines parameters for PostPullRequestReassign.
+type PostPullRequestReassignParams struct {
+	// IfMatch Версия PR из ETag; при несовпадении изменение не выполняется (412)
+	IfMatch *IfMatchHeader `json:"If-Match,omitempty"`
+}
+
+// GetStatisticFairnessParams defines parameters for GetStatisticFairness.
+type GetStatisticFairnessParams struct {
+	// From Начало окна [from, to)
+	From *FromQuery `form:"from,omitempty" json:"from,omitempty"`
+
+	// To Конец окна [from, to)
+	To *ToQuery `form:"to,omitempty" json:"to,omitempty"`
+
+	// TeamName Только команда
+	TeamName *TeamNameFilter                   `form:"team_name,omitempty" json:"team_name,omitempty"`
+	Status   *GetStatisticFairnessParamsStatus `form:"status,omitempty" json:"status,omitempty"`
+}
+
+// GetStatisticFairnessParamsStatus defines parameters for GetStatisticFairness.
+type GetStatisticFairnessParamsStatus string
+
+// GetStatisticPullRequestsParams defines parameters for GetStatisticPullRequests.
+type GetStatisticPullRequestsParams struct {
+	// From Начало окна [from, to)
+	From *FromQuery `form:"from,omitempty" json:"from,omitempty"`
+
+	// To Конец окна [from, to)
+	To *ToQuery `form:"to,omitempty" json:"to,omitempty"`
+
+	// TeamName Только команда
+	TeamName *TeamNameFilter                       `form:"team_name,omitempty" json:"team_name,omitempty"`
+	Status   *GetStatisticPullRequestsParamsStatus `form:"status,omitempty" json:"status,omitempty"`
+}
+
+// GetStatisticPullRequestsParamsStatus defines parameters for GetStatisticPullRequests.
+type GetStatisticPullRequestsParamsStatus string
+
+// GetStatisticReviewsParams defines parameters for GetStatisticReviews.
+type GetStatisticReviewsParams struct {
+	// From Начало окна [from, to)
+	From *FromQuery `form:"from,omitempty" json:"from,omitempty"`
+
+	// To Конец окна [from, to)
+	To *ToQuery `form:"to,omitempty" json:"to,omitempty"`
+
+	// TeamName Только команда
+	TeamName *TeamNameFilter                  `form:"team_name,omitempty" json:"team_name,omitempty"`
+	Status   *GetStatisticReviewsParamsStatus `form:"status,omitempty" json:"status,omitempty"`
+}
+
+// GetStatisticReviewsParamsStatus defines parameters for GetStatisticReviews.
+type GetStatisticReviewsParamsStatus string
+
+// GetStatisticTeamsParams defines parameters for GetStatisticTeams.
+type GetStatisticTeamsParams struct {
+	// From Начало окна [from, to)
+	From *FromQuery `form:"from,omitempty" json:"from,omitempty"`
+
+	// To Конец окна [from, to)
+	To *ToQuery `form:"to,omitempty" json:"to,omitempty"`
+
+	// TeamName Только команда
+	TeamName *TeamNameFilter                `form:"team_name,omitempty" json:"team_name,omitempty"`
+	Status   *GetStatisticTeamsParamsStatus `form:"status,omitempty" json:"status,omitempty"`
+}
+
+// GetStatisticTeamsParamsStatus defines parameters for GetStatisticTeams.
+type GetStatisticTeamsParamsStatus string
+
+// PostTeamDeactivateJSONBody defines parameters for PostTeamDeactivate.
+type PostTeamDeactivateJSONBody struct {
+	TeamName string `json:"team_name"`
+}
+
+// GetTeamGetParams defines parameters for GetTeamGet.
+type GetTeamGetParams struct {
+	// TeamName Уникальное имя команды
+	TeamName TeamNameQuery `form:"team_name" json:"team_name"`
+}
+
+// PostTeamImportParams defines parameters for PostTeamImport.
+type PostTeamImportParams struct {
+	// Format Формат файла; без параметра - по Content-Type
+	Format *PostTeamImportParamsFormat `form:"format,omitempty" json:"format,omitempty"`
+	DryRun *bool                       `form:"dry_run,omitempty" json:"dry_run,omitempty"`
+}
+
+// PostTeamImportParamsFormat defines parameters for PostTeamImport.
+type PostTeamImportParamsFormat string
+
+// PostTeamRebalanceJSONBody defines parameters for PostTeamRebalance.
+type PostTeamRebalanceJSONBody struct {
+	DryRun   *bool  `json:"dry_run,omitempty"`
+	TeamName string `json:"team_name"`
+}
+
+// GetUsersGetReviewParams defines parameters for GetUsersGetReview.
+type GetUsersGetReviewParams struct {
+	// UserId Идентификатор пользователя
+	UserId UserIdQuery `form:"user_id" json:"user_id"`
+
+	// Role PR'ы, где пользователь ревьювер или автор
+	Role   *GetUsersGetReviewParamsRole   `form:"role,omitempty" json:"role,omitempty"`
+	Status *GetUsersGetReviewParamsStatus `form:"status,omitempty" json:"status,omitempty"`
+
+	// Order Сортировка по времени создания PR
+	Order *GetUsersGetReviewParamsOrder `form:"order,omitempty" json:"order,omitempty"`
+
+	// Cursor Курсор следующей страницы (next_cursor из предыдущего ответа)
+	Cursor *string `form:"cursor,omitempty" json:"cursor,omitempty"`
+	Limit  *int    `form:"limit,omitempty" json:"limit,omitempty"`
+}
+
+// GetUsersGetReviewParamsRole defines parameters for GetUsersGetReview.
+type GetUsersGetReviewParamsRole string
+
+// GetUsersGetReviewParamsStatus defines parameters for GetUsersGetReview.
+type GetUsersGetReviewParamsStatus string
+
+// GetUsersGetReviewParamsOrder defines parameters for GetUsersGetReview.
+type GetUsersGetReviewParamsOrder string
+
+// PostUsersSetIsActiveJSONBody defines parameters for PostUsersSetIsActive.
+type PostUsersSetIsActiveJSONBody struct {
+	IsActive bool   `json:"is_active"`
+	UserId   string `json:"user_id"`
+}
+
+// PostWebhooksAddJSONBody defines parameters for PostWebhooksAdd.
+type PostWebhooksAddJSONBody struct {
+	// EventTypes Типы событий (пусто - все события)
+	EventTypes *[]string `json:"event_types,omitempty"`
+
+	// Secret Ключ подписи X-Signature-256
+	Secret string `json:"secret"`
+	Url    string `json:"url"`
+}
+
+// GetWebhooksDeadLettersParams defines parameters for GetWebhooksDeadLetters.
+type GetWebhooksDeadLettersParams struct {
+	WebhookId *int64 `form:"webhook_id,omitempty" json:"webhook_id,omitempty"`
+}
+
+// PostWebhooksDeleteJSONBody defines parameters for PostWebhooksDelete.
+type PostWebhooksDeleteJSONBody struct {
+	WebhookId int64 `json:"webhook_id"`
+}
+
+// PostWebhooksRetryJSONBody defines parameters for PostWebhooksRetry.
+type PostWebhooksRetryJSONBody struct {
+	DeliveryId int64 `json:"delivery_id"`
+}
+
+// PostWebhooksRetry200JSONResponseBodyStatus defines parameters for PostWebhooksRetry.
+type PostWebhooksRetry200JSONResponseBodyStatus string
+
+// PostAdminRestoreJSONRequestBody defines body for PostAdminRestore for application/json ContentType.
+type PostAdminRestoreJSONRequestBody = BackupArchive
+
+// PostAuthTokensCreateJSONRequestBody defines body for PostAuthTokensCreate for application/json ContentType.
+type PostAuthTokensCreateJSONRequestBody PostAuthTokensCreateJSONBody
+
+// PostAuthTokensRevokeJSONRequestBody defines body for PostAuthTokensRevoke for application/json ContentType.
+type PostAuthTokensRevokeJSONRequestBody PostAuthTokensRevokeJSONBody
+
+// PostPullRequestCreateJSONRequestBody defines body for PostPullRequestCreate for application/json ContentType.
+type PostPullRequestCreateJSONRequestBody PostPullRequestCreateJSONBody
+
+// PostPullRequestDeclineJSONRequestBody defines body for PostPullRequestDecline for application/json ContentType.
+type PostPullRequestDeclineJSONRequestBody PostPullRequestDeclineJSONBody
+
+// PostPullRequestMergeJSONRequestBody defines body for PostPullRequestMerge for application/json ContentType.
+type PostPullRequestMergeJSONRequestBody PostPullRequestMergeJSONBody
+
+// PostPullRequestReassignJSONRequestBody defines body for PostPullRequestReassign for application/json ContentType.
+type PostPullRequestReassignJSONRequestBody PostPullRequestReassignJSONBody
+
+// PostTeamAddJSONRequestBody defines body for PostTeamAdd for application/json ContentType.
+type PostTeamAddJSONRequestBody = Team
+
+// PostTeamDeactivateJSONRequestBody defines body for PostTeamDeactivate for application/json ContentType.
+type PostTeamDeactivateJSONRequestBody PostTeamDeactivateJSONBody
+
+// PostTeamRebalanceJSONRequestBody defines body for PostTeamRebalance for application/json ContentType.
+type PostTeamRebalanceJSONRequestBody PostTeamRebalanceJSONBody
+
+// PostUsersSetIsActiveJSONRequestBody defines body for PostUsersSetIsActive for application/json ContentType.
+type PostUsersSetIsActiveJSONRequestBody PostUsersSetIsActiveJSONBody
+
+// PostWebhooksAddJSONRequestBody defines body for PostWebhooksAdd for application/json ContentType.
+type PostWebhooksAddJSONRequestBody PostWebhooksAddJSONBody
+
+// PostWebhooksDeleteJSONRequestBody defines body for PostWebhooksDelete for application/json ContentType.
+type PostWebhooksDeleteJSONRequestBody PostWebhooksDeleteJSONBody
+
+// PostWebhooksRetryJSONRequestBody defines body for PostWebhooksRetry for application/json ContentType.
+type PostWebhooksRetryJSONRequestBody PostWebhooksRetryJSONBody
+
+// ServerInterface represents all server handlers.
+type ServerInterface interface {
+	// Резервная копия всех данных сервиса
+	// (GET /admin/backup)
+	GetAdminBackup(w http.ResponseWriter, r *http.Request)
+	// Восстановить данные из резервной копии (текущие данные заменяются)
+	// (POST /admin/restore)
+	PostAdminRestore(w http.ResponseWriter, r *http.Request)
+	// Журнал изменений
+	// (GET /audit)
+	GetAudit(w http.ResponseWriter, r *http.Request, params GetAuditParams)
+	// Выпустить API токен (секрет возвращается только в этом ответе)
+	// (POST /auth/tokens/create)
+	PostAuthTokensCreate(w http.ResponseWriter, r *http.Request)
+	// Действующие API токены
+	// (GET /auth/tokens/list)
+	GetAuthTokensList(w http.ResponseWriter, r *http.Request)
+	// Отозвать API токен
+	// (POST /auth/tokens/revoke)
+	PostAuthTokensRevoke(w http.ResponseWriter, r *http.Request)
+	// Выгрузка истории назначений reviewer из аудита (CSV или NDJSON)
+	// (GET /export/assignments)
+	GetExportAssignments(w http.ResponseWriter, r *http.Request, params GetExportAssignmentsParams)
+	// Выгрузка PR (CSV или NDJSON)
+	// (GET /export/pullRequests)
+	GetExportPullRequests(w http.ResponseWriter, r *http.Request, params GetExportPullRequestsParams)
+	// Выгрузка назначений по пользователям (CSV или NDJSON)
+	// (GET /export/reviews)
+	GetExportReviews(w http.ResponseWriter, r *http.Request, params GetExportReviewsParams)
+	// Создать PR и автоматически назначить до 2 ревьюверов из команды автора
+	// (POST /pullRequest/create)
+	PostPullRequestCreate(w http.ResponseWriter, r *http.Request)
+	// Отказ reviewer от ревью с автоматическим подбором замены
+	// (POST /pullRequest/decline)
+	PostPullRequestDecline(w http.ResponseWriter, r *http.Request, params PostPullRequestDeclineParams)
+	// История отказов от ревью PR
+	// (GET /pullRequest/declines)
+	GetPullRequestDeclines(w http.ResponseWriter, r *http.Request, params GetPullRequestDeclinesParams)
+	// PR с reviewer и хронологией изменений
+	// (GET /pullRequest/get)
+	GetPullRequestGet(w http.ResponseWriter, r *http.Request, params GetPullRequestGetParams)
+	// Список PR по фильтрам с постраничной выдачей
+	// (GET /pullRequest/list)
+	GetPullRequestList(w http.ResponseWriter, r *http.Request, params GetPullRequestListParams)
+	// Пометить PR как MERGED (идемпотентная операция)
+	// (POST /pullRequest/merge)
+	PostPullRequestMerge(w http.ResponseWriter, r *http.Request, params PostPullRequestMergeParams)
+	// Ревью с истёкшим SLA
+	// (GET /pullRequest/overdue)
+	GetPullRequestOverdue(w http.ResponseWriter, r *http.Request, params GetPullRequestOverdueParams)
+	// Переназначить конкретного ревьювера на другого из его команды
+	// (POST /pullRequest/reassign)
+	PostPullRequestReassign(w http.ResponseWriter, r *http.Request, params PostPullRequestReassignParams)
+	// Равномерность нагрузки на ревью внутри команд
+	// (GET /statistic/fairness)
+	GetStatisticFairness(w http.ResponseWriter, r *http.Request, params GetStatisticFairnessParams)
+	// Сводка по PR и время до merge
+	// (GET /statistic/pullRequests)
+	GetStatisticPullRequests(w http.ResponseWriter, r *http.Request, params GetStatisticPullRequestsParams)
+	// Число назначений на ревью по пользователям в окне [from, to)
+	// (GET /statistic/reviews)
+	GetStatisticReviews(w http.ResponseWriter, r *http.Request, params GetStatisticReviewsParams)
+	// Сводка по командам
+	// (GET /statistic/teams)
+	GetStatisticTeams(w http.ResponseWriter, r *http.Request, params GetStatisticTeamsParams)
+	// Создать команду с участниками (создаёт/обновляет пользователей)
+	// (POST /team/add)
+	PostTeamAdd(w http.ResponseWriter, r *http.Request)
+	// Деактивировать всех участников команды и снять их с ревью открытых PR
+	// (POST /team/deactivate)
+	PostTeamDeactivate(w http.ResponseWriter, r *http.Request)
+	// Получить команду с участниками
+	// (GET /team/get)
+	GetTeamGet(w http.ResponseWriter, r *http.Request, params GetTeamGetParams)
+	// Импорт состава команд из YAML или CSV (dry_run - только итог без применения)
+	// (POST /team/import)
+	PostTeamImport(w http.ResponseWriter, r *http.Request, params PostTeamImportParams)
+	// Выровнять нагрузку на ревью внутри команды (dry_run - только план)
+	// (POST /team/rebalance)
+	PostTeamRebalance(w http.ResponseWriter, r *http.Request)
+	// Получить PR'ы, где пользователь назначен ревьювером
+	// (GET /users/getReview)
+	GetUsersGetReview(w http.ResponseWriter, r *http.Request, params GetUsersGetReviewParams)
+	// Установить флаг активности пользователя
+	// (POST /users/setIsActive)
+	PostUsersSetIsActive(w http.ResponseWriter, r *http.Request)
+	// Зарегистрировать webhook
+	// (POST /webhooks/add)
+	PostWebhooksAdd(w http.ResponseWriter, r *http.Request)
+	// Доставки, исчерпавшие попытки
+	// (GET /webhooks/deadLetters)
+	GetWebhooksDeadLetters(w http.ResponseWriter, r *http.Request, params GetWebhooksDeadLettersParams)
+	// Отключить webhook
+	// (POST /webhooks/delete)
+	PostWebhooksDelete(w http.ResponseWriter, r *http.Request)
+	// Активные webhook
+	// (GET /webhooks/list)
+	GetWebhooksList(w http.ResponseWriter, r *http.Request)
+	// Повторить недоставленное событие
+	// (POST /webhooks/retry)
+	PostWebhooksRetry(w http.ResponseWriter, r *http.Request)
+}
+
+// Unimplemented server implementation that returns http.StatusNotImplemented for each endpoint.
+
+type Unimplemented struct{}
+
+// Резервная копия всех данных сервиса
+// (GET /admin/backup)
+func (_ Unimplemented) GetAdminBackup(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Восстановить данные из резервной копии (текущие данные заменяются)
+// (POST /admin/restore)
+func (_ Unimplemented) PostAdminRestore(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Журнал изменений
+// (GET /audit)
+func (_ Unimplemented) GetAudit(w http.ResponseWriter, r *http.Request, params GetAuditParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Выпустить API токен (секрет возвращается только в этом ответе)
+// (POST /auth/tokens/create)
+func (_ Unimplemented) PostAuthTokensCreate(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Действующие API токены
+// (GET /auth/tokens/list)
+func (_ Unimplemented) GetAuthTokensList(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Отозвать API токен
+// (POST /auth/tokens/revoke)
+func (_ Unimplemented) PostAuthTokensRevoke(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Выгрузка истории назначений reviewer из аудита (CSV или NDJSON)
+// (GET /export/assignments)
+func (_ Unimplemented) GetExportAssignments(w http.ResponseWriter, r *http.Request, params GetExportAssignmentsParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Выгрузка PR (CSV или NDJSON)
+// (GET /export/pullRequests)
+func (_ Unimplemented) GetExportPullRequests(w http.ResponseWriter, r *http.Request, params GetExportPullRequestsParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Выгрузка назначений по пользователям (CSV или NDJSON)
+// (GET /export/reviews)
+func (_ Unimplemented) GetExportReviews(w http.ResponseWriter, r *http.Request, params GetExportReviewsParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Создать PR и автоматически назначить до 2 ревьюверов из команды автора
+// (POST /pullRequest/create)
+func (_ Unimplemented) PostPullRequestCreate(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Отказ reviewer от ревью с автоматическим подбором замены
+// (POST /pullRequest/decline)
+func (_ Unimplemented) PostPullRequestDecline(w http.ResponseWriter, r *http.Request, params PostPullRequestDeclineParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// История отказов от ревью PR
+// (GET /pullRequest/declines)
+func (_ Unimplemented) GetPullRequestDeclines(w http.ResponseWriter, r *http.Request, params GetPullRequestDeclinesParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// PR с reviewer и хронологией изменений
+// (GET /pullRequest/get)
+func (_ Unimplemented) GetPullRequestGet(w http.ResponseWriter, r *http.Request, params GetPullRequestGetParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Список PR по фильтрам с постраничной выдачей
+// (GET /pullRequest/list)
+func (_ Unimplemented) GetPullRequestList(w http.ResponseWriter, r *http.Request, params GetPullRequestListParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Пометить PR как MERGED (идемпотентная операция)
+// (POST /pullRequest/merge)
+func (_ Unimplemented) PostPullRequestMerge(w http.ResponseWriter, r *http.Request, params PostPullRequestMergeParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Ревью с истёкшим SLA
+// (GET /pullRequest/overdue)
+func (_ Unimplemented) GetPullRequestOverdue(w http.ResponseWriter, r *http.Request, params GetPullRequestOverdueParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Переназначить конкретного ревьювера на другого из его команды
+// (POST /pullRequest/reassign)
+func (_ Unimplemented) PostPullRequestReassign(w http.ResponseWriter, r *http.Request, params PostPullRequestReassignParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Равномерность нагрузки на ревью внутри команд
+// (GET /statistic/fairness)
+func (_ Unimplemented) GetStatisticFairness(w http.ResponseWriter, r *http.Request, params GetStatisticFairnessParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Сводка по PR и время до merge
+// (GET /statistic/pullRequests)
+func (_ Unimplemented) GetStatisticPullRequests(w http.ResponseWriter, r *http.Request, params GetStatisticPullRequestsParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Число назначений на ревью по пользователям в окне [from, to)
+// (GET /statistic/reviews)
+func (_ Unimplemented) GetStatisticReviews(w http.ResponseWriter, r *http.Request, params GetStatisticReviewsParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Сводка по командам
+// (GET /statistic/teams)
+func (_ Unimplemented) GetStatisticTeams(w http.ResponseWriter, r *http.Request, params GetStatisticTeamsParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Создать команду с участниками (создаёт/обновляет пользователей)
+// (POST /team/add)
+func (_ Unimplemented) PostTeamAdd(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Деактивировать всех участников команды и снять их с ревью открытых PR
+// (POST /team/deactivate)
+func (_ Unimplemented) PostTeamDeactivate(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Получить команду с участниками
+// (GET /team/get)
+func (_ Unimplemented) GetTeamGet(w http.ResponseWriter, r *http.Request, params GetTeamGetParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Импорт состава команд из YAML или CSV (dry_run - только итог без применения)
+// (POST /team/import)
+func (_ Unimplemented) PostTeamImport(w http.ResponseWriter, r *http.Request, params PostTeamImportParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Выровнять нагрузку на ревью внутри команды (dry_run - только план)
+// (POST /team/rebalance)
+func (_ Unimplemented) PostTeamRebalance(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Получить PR'ы, где пользователь назначен ревьювером
+// (GET /users/getReview)
+func (_ Unimplemented) GetUsersGetReview(w http.ResponseWriter, r *http.Request, params GetUsersGetReviewParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Установить флаг активности пользователя
+// (POST /users/setIsActive)
+func (_ Unimplemented) PostUsersSetIsActive(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Зарегистрировать webhook
+// (POST /webhooks/add)
+func (_ Unimplemented) PostWebhooksAdd(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Доставки, исчерпавшие попытки
+// (GET /webhooks/deadLetters)
+func (_ Unimplemented) GetWebhooksDeadLetters(w http.ResponseWriter, r *http.Request, params GetWebhooksDeadLettersParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Отключить webhook
+// (POST /webhooks/delete)
+func (_ Unimplemented) PostWebhooksDelete(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Активные webhook
+// (GET /webhooks/list)
+func (_ Unimplemented) GetWebhooksList(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Повторить недоставленное событие
+// (POST /webhooks/retry)
+func (_ Unimplemented) PostWebhooksRetry(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// ServerInterfaceWrapper converts contexts to parameters.
+type ServerInterfaceWrapper struct {
+	Handler            ServerInterface
+	HandlerMiddlewares []MiddlewareFunc
+	ErrorHandlerFunc   func(w http.ResponseWriter, r *http.Request, err error)
+}
+
+type MiddlewareFunc func(http.Handler) http.Handler
+
+// GetAdminBackup operation middleware
+func (siw *ServerInterfaceWrapper) GetAdminBackup(w http.ResponseWriter, r *http.Request) {
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetAdminBackup(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// PostAdminRestore operation middleware
+func (siw *ServerInterfaceWrapper) PostAdminRestore(w http.ResponseWriter, r *http.Request) {
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PostAdminRestore(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetAudit operation middleware
+func (siw *ServerInterfaceWrapper) GetAudit(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+	_ = err
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetAuditParams
+
+	// ------------- Optional query parameter "pull_request_id" -------------
+
+	err = runtime.BindQueryParameterWithOptions("form", true, false, "pull_request_id", r.URL.Query(), &params.PullRequestId, runtime.BindQueryParameterOptions{Type: "string", Format: ""})
+	if err != nil {
+		var requiredError *runtime.RequiredParameterError
+		if errors.As(err, &requiredError) {
+			siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "pull_request_id"})
+		} else {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "pull_request_id", Err: err})
+		}
+		return
+	}
+
+	// ------------- Optional query parameter "user_id" -------------
+
+	err = runtime.BindQueryParameterWithOptions("form", true, false, "user_id", r.URL.Query(), &params.UserId, runtime.BindQueryParameterOptions{Type: "string", Format: ""})
+	if err != nil {
+		var requiredError *runtime.RequiredParameterError
+		if errors.As(err, &requiredError) {
+			siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "user_id"})
+		} else {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "user_id", Err: err})
+		}
+		return
+	}
+
+	// ------------- Optional query parameter "team_name" -------------
+
+	err = runtime.BindQueryParameterWithOptions("form", true, false, "team_name", r.URL.Query(), &params.TeamName, runtime.BindQueryParameterOptions{Type: "string", Format: ""})
+	if err != nil {
+		var requiredError *runtime.RequiredParameterError
+		if errors.As(err, &requiredError) {
+			siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "team_name"})
+		} else {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "team_name", Err: err})
+		}
+		return
+	}
+
+	// ------------- Optional query parameter "from" -------------
+
+	err = runtime.BindQueryParameterWithOptions("form", true, false, "from", r.URL.Query(), &params.From, runtime.BindQueryParameterOptions{Type: "string", Format: "date-time"})
+	if err != nil {
+		var requiredError *runtime.RequiredParameterError
+		if errors.As(err, &requiredError) {
+			siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "from"})
+		} else {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "from", Err: err})
+		}
+		return
+	}
+
+	// ------------- Optional query parameter "to" -------------
+
+	err = runtime.BindQueryParameterWithOptions("form", true, false, "to", r.URL.Query(), &params.To, runtime.BindQueryParameterOptions{Type: "string", Format: "date-time"})
+	if err != nil {
+		var requiredError *runtime.RequiredParameterError
+		if errors.As(err, &requiredError) {
+			siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "to"})
+		} else {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "to", Err: err})
+		}
+		return
+	}
+
+	// ------------- Optional query parameter "after_id" -------------
+
+	err = runtime.BindQueryParameterWithOptions("form", true, false, "after_id", r.URL.Query(), &params.AfterId, runtime.BindQueryParameterOptions{Type: "integer", Format: "int64"})
+	if err != nil {
+		var requiredError *runtime.RequiredParameterError
+		if errors.As(err, &requiredError) {
+			siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "after_id"})
+		} else {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "after_id", Err: err})
+		}
+		return
+	}
+
+	// ------------- Optional query parameter "limit" -------------
+
+	err = runtime.BindQueryParameterWithOptions("form", true, false, "limit", r.URL.Query(), &params.Limit, runtime.BindQueryParameterOptions{Type: "integer", Format: ""})
+	if err != nil {
+		var requiredError *runtime.RequiredParameterError
+		if errors.As(err, &requiredError) {
+			siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "limit"})
+		} else {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "limit", Err: err})
+		}
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetAudit(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// PostAuthTokensCreate operation middleware
+func (siw *ServerInterfaceWrapper) PostAuthTokensCreate(w http.ResponseWriter, r *http.Request) {
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PostAuthTokensCreate(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetAuthTokensList operation middleware
+func (siw *ServerInterfaceWrapper) GetAuthTokensList(w http.ResponseWriter, r *http.Request) {
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetAuthTokensList(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// PostAuthTokensRevoke operation middleware
+func (siw *ServerInterfaceWrapper) PostAuthTokensRevoke(w http.ResponseWriter, r *http.Request) {
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PostAuthTokensRevoke(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetExportAssignments operation middleware
+func (siw *ServerInterfaceWrapper) GetExportAssignments(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+	_ = err
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetExportAssignmentsParams
+
+	// ------------- Optional query parameter "pull_request_id" -------------
+
+	err = runtime.BindQueryParameterWithOptions("form", true, false, "pull_request_id", r.URL.Query(), &params.PullRequestId, runtime.BindQueryParameterOptions{Type: "string", Format: ""})
+	if err != nil {
+		var requiredError *runtime.RequiredParameterError
+		if errors.As(err, &requiredError) {
+			siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "pull_request_id"})
+		} else {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "pull_request_id", Err: err})
+		}
+		return
+	}
+
+	// ------------- Optional query parameter "user_id" -------------
+
+	err = runtime.BindQueryParameterWithOptions("form", true, false, "user_id", r.URL.Query(), &params.UserId, runtime.BindQueryParameterOptions{Type: "string", Format: ""})
+	if err != nil {
+		var requiredError *runtime.RequiredParameterError
+		if errors.As(err, &requiredError) {
+			siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "user_id"})
+		} else {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "user_id", Err: err})
+		}
+		return
+	}
+
+	// ------------- Optional query parameter "team_name" -------------
+
+	err = runtime.BindQueryParameterWithOptions("form", true, false, "team_name", r.URL.Query(), &params.TeamName, runtime.BindQueryParameterOptions{Type: "string", Format: ""})
+	if err != nil {
+		var requiredError *runtime.RequiredParameterError
+		if errors.As(err, &requiredError) {
+			siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "team_name"})
+		} else {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "team_name", Err: err})
+		}
+		return
+	}
+
+	// ------------- Optional query parameter "from" -------------
+
+	err = runtime.BindQueryParameterWithOptions("form", true, false, "from", r.URL.Query(), &params.From, runtime.BindQueryParameterOptions{Type: "string", Format: "date-time"})
+	if err != nil {
+		var requiredError *runtime.RequiredParameterError
+		if errors.As(err, &requiredError) {
+			siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "from"})
+		} else {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "from", Err: err})
+		}
+		return
+	}
+
+	// ------------- Optional query parameter "to" -------------
+
+	err = runtime.BindQueryParameterWithOptions("form", true, false, "to", r.URL.Query(), &params.To, runtime.BindQueryParameterOptions{Type: "string", Format: "date-time"})
+	if err != nil {
+		var requiredError *runtime.RequiredParameterError
+		if errors.As(err, &requiredError) {
+			siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "to"})
+		} else {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "to", Err: err})
+		}
+		return
+	}
+
+	// ------------- Optional query parameter "format" -------------
+
+	err = runtime.BindQueryParameterWithOptions("form", true, false, "format", r.URL.Query(), &params.Format, runtime.BindQueryParameterOptions{Type: "string", Format: ""})
+	if err != nil {
+		var requiredError *runtime.RequiredParameterError
+		if errors.As(err, &requiredError) {
+			siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "format"})
+		} else {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "format", Err: err})
+		}
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetExportAssignments(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetExportPullRequests operation middleware
+func (siw *ServerInterfaceWrapper) GetExportPullRequests(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+	_ = err
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetExportPullRequestsParams
+
+	// ------------- Optional query parameter "author_id" -------------
+
+	err = runtime.BindQueryParameterWithOptions("form", true, false, "author_id", r.URL.Query(), &params.AuthorId, runtime.BindQueryParameterOptions{Type: "string", Format: ""})
+	if err != nil {
+		var requiredError *runtime.RequiredParameterError
+		if errors.As(err, &requiredError) {
+			siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "author_id"})
+		} else {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "author_id", Err: err})
+		}
+		return
+	}
+
+	// ------------- Optional query parameter "format" -------------
+
+	err = runtime.BindQueryParameterWithOptions("form", true, false, "format", r.URL.Query(), &params.Format, runtime.BindQueryParameterOptions{Type: "string", Format: ""})
+	if err != nil {
+		var requiredError *runtime.RequiredParameterError
+		if errors.As(err, &requiredError) {
+			siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "format"})
+		} else {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "format", Err: err})
+		}
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetExportPullRequests(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetExportReviews operation middleware
+func (siw *ServerInterfaceWrapper) GetExportReviews(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+	_ = err
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetExportReviewsParams
+
+	// ------------- Optional query parameter "from" -------------
+
+	err = runtime.BindQueryParameterWithOptions("form", true, false, "from", r.URL.Query(), &params.From, runtime.BindQueryParameterOptions{Type: "string", Format: "date-time"})
+	if err != nil {
+		var requiredError *runtime.RequiredParameterError
+		if errors.As(err, &requiredError) {
+			siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "from"})
+		} else {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "from", Err: err})
+		}
+		return
+	}
+
+	// ------------- Optional query parameter "to" -------------
+
+	err = runtime.BindQueryParameterWithOptions("form", true, false, "to", r.URL.Query(), &params.To, runtime.BindQueryParameterOptions{Type: "string", Format: "date-time"})
+	if err != nil {
+		var requiredError *runtime.RequiredParameterError
+		if errors.As(err, &requiredError) {
+			siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "to"})
+		} else {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "to", Err: err})
+		}
+		return
+	}
+
+	// ------------- Optional query parameter "team_name" -------------
+
+	err = runtime.BindQueryParameterWithOptions("form", true, false, "team_name", r.URL.Query(), &params.TeamName, runtime.BindQueryParameterOptions{Type: "string", Format: ""})
+	if err != nil {
+		var requiredError *runtime.RequiredParameterError
+		if errors.As(err, &requiredError) {
+			siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "team_name"})
+		} else {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "team_name", Err: err})
+		}
+		return
+	}
+
+	// ------------- Optional query parameter "status" -------------
+
+	err = runtime.BindQueryParameterWithOptions("form", true, false, "status", r.URL.Query(), &params.Status, runtime.BindQueryParameterOptions{Type: "string", Format: ""})
+	if err != nil {
+		var requiredError *runtime.RequiredParameterError
+		if errors.As(err, &requiredError) {
+			siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "status"})
+		} else {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "status", Err: err})
+		}
+		return
+	}
+
+	// ------------- Optional query parameter "format" -------------
+
+	err = runtime.BindQueryParameterWithOptions("form", true, false, "format", r.URL.Query(), &params.Format, runtime.BindQueryParameterOptions{Type: "string", Format: ""})
+	if err != nil {
+		var requiredError *runtime.RequiredParameterError
+		if errors.As(err, &requiredError) {
+			siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "format"})
+		} else {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "format", Err: err})
+		}
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetExportReviews(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// PostPullRequestCreate operation middleware
+func (siw *ServerInterfaceWrapper) PostPullRequestCreate(w http.ResponseWriter, r *http.Request) {
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PostPullRequestCreate(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// PostPullRequestDecline operation middleware
+func (siw *ServerInterfaceWrapper) PostPullRequestDecline(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+	_ = err
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params PostPullRequestDeclineParams
+
+	headers := r.Header
+
+	// ------------- Optional header parameter "If-Match" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("If-Match")]; found {
+		var IfMatch IfMatchHeader
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandlerFunc(w, r, &TooManyValuesForParamError{ParamName: "If-Match", Count: n})
+			return
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "If-Match", valueList[0], &IfMatch, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: false, Type: "string", Format: ""})
+		if err != nil {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "If-Match", Err: err})
+			return
+		}
+
+		params.IfMatch = &IfMatch
+
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PostPullRequestDecline(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetPullRequestDeclines operation middleware
+func (siw *ServerInterfaceWrapper) GetPullRequestDeclines(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+	_ = err
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetPullRequestDeclinesParams
+
+	// ------------- Required query parameter "pull_request_id" -------------
+
+	err = runtime.BindQueryParameterWithOptions("form", true, true, "pull_request_id", r.URL.Query(), &params.PullRequestId, runtime.BindQueryParameterOptions{Type: "string", Format: ""})
+	if err != nil {
+		var requiredError *runtime.RequiredParameterError
+		if errors.As(err, &requiredError) {
+			siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "pull_request_id"})
+		} else {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "pull_request_id", Err: err})
+		}
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetPullRequestDeclines(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetPullRequestGet operation middleware
+func (siw *ServerInterfaceWrapper) GetPullRequestGet(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+	_ = err
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetPullRequestGetParams
+
+	// ------------- Required query parameter "pull_request_id" -------------
+
+	err = runtime.BindQueryParameterWithOptions("form", true, true, "pull_request_id", r.URL.Query(), &params.PullRequestId, runtime.BindQueryParameterOptions{Type: "string", Format: ""})
+	if err != nil {
+		var requiredError *runtime.RequiredParameterError
+		if errors.As(err, &requiredError) {
+			siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "pull_request_id"})
+		} else {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "pull_request_id", Err: err})
+		}
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetPullRequestGet(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetPullRequestList operation middleware
+func (siw *ServerInterfaceWrapper) GetPullRequestList(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+	_ = err
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetPullRequestListParams
+
+	// ------------- Optional query parameter "status" -------------
+
+	err = runtime.BindQueryParameterWithOptions("form", true, false, "status", r.URL.Query(), &params.Status, runtime.BindQueryParameterOptions{Type: "string", Format: ""})
+	if err != nil {
+		var requiredError *runtime.RequiredParameterError
+		if errors.As(err, &requiredError) {
+			siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "status"})
+		} else {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "status", Err: err})
+		}
+		return
+	}
+
+	// ------------- Optional query parameter "order" -------------
+
+	err = runtime.BindQueryParameterWithOptions("form", true, false, "order", r.URL.Query(), &params.Order, runtime.BindQueryParameterOptions{Type: "string", Format: ""})
+	if err != nil {
+		var requiredError *runtime.RequiredParameterError
+		if errors.As(err, &requiredError) {
+			siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "order"})
+		} else {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "order", Err: err})
+		}
+		return
+	}
+
+	// ------------- Optional query parameter "cursor" -------------
+
+	err = runtime.BindQueryParameterWithOptions("form", true, false, "cursor", r.URL.Query(), &params.Cursor, runtime.BindQueryParameterOptions{Type: "string", Format: ""})
+	if err != nil {
+		var requiredError *runtime.RequiredParameterError
+		if errors.As(err, &requiredError) {
+			siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "cursor"})
+		} else {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "cursor", Err: err})
+		}
+		return
+	}
+
+	// ------------- Optional query parameter "limit" -------------
+
+	err = runtime.BindQueryParameterWithOptions("form", true, false, "limit", r.URL.Query(), &params.Limit, runtime.BindQueryParameterOptions{Type: "integer", Format: ""})
+	if err != nil {
+		var requiredError *runtime.RequiredParameterError
+		if errors.As(err, &requiredError) {
+			siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "limit"})
+		} else {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "limit", Err: err})
+		}
+		return
+	}
+
+	// ------------- Optional query parameter "author_id" -------------
+
+	err = runtime.BindQueryParameterWithOptions("form", true, false, "author_id", r.URL.Query(), &params.AuthorId, runtime.BindQueryParameterOptions{Type: "string", Format: ""})
+	if err != nil {
+		var requiredError *runtime.RequiredParameterError
+		if errors.As(err, &requiredError) {
+			siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "author_id"})
+		} else {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "author_id", Err: err})
+		}
+		return
+	}
+
+	// ------------- Optional query parameter "reviewer_id" -------------
+
+	err = runtime.BindQueryParameterWithOptions("form", true, false, "reviewer_id", r.URL.Query(), &params.ReviewerId, runtime.BindQueryParameterOptions{Type: "string", Format: ""})
+	if err != nil {
+		var requiredError *runtime.RequiredParameterError
+		if errors.As(err, &requiredError) {
+			siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "reviewer_id"})
+		} else {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "reviewer_id", Err: err})
+		}
+		return
+	}
+
+	// ------------- Optional query parameter "team_name" -------------
+
+	err = runtime.BindQueryParameterWithOptions("form", true, false, "team_name", r.URL.Query(), &params.TeamName, runtime.BindQueryParameterOptions{Type: "string", Format: ""})
+	if err != nil {
+		var requiredError *runtime.RequiredParameterError
+		if errors.As(err, &requiredError) {
+			siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "team_name"})
+		} else {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "team_name", Err: err})
+		}
+		return
+	}
+
+	// ------------- Optional query parameter "q" -------------
+
+	err = runtime.BindQueryParameterWithOptions("form", true, false, "q", r.URL.Query(), &params.Q, runtime.BindQueryParameterOptions{Type: "string", Format: ""})
+	if err != nil {
+		var requiredError *runtime.RequiredParameterError
+		if errors.As(err, &requiredError) {
+			siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "q"})
+		} else {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "q", Err: err})
+		}
+		return
+	}
+
+	// ------------- Optional query parameter "sort" -------------
+
+	err = runtime.BindQueryParameterWithOptions("form", true, false, "sort", r.URL.Query(), &params.Sort, runtime.BindQueryParameterOptions{Type: "string", Format: ""})
+	if err != nil {
+		var requiredError *runtime.RequiredParameterError
+		if errors.As(err, &requiredError) {
+			siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "sort"})
+		} else {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "sort", Err: err})
+		}
+		return
+	}
+
+	// ------------- Optional query parameter "created_from" -------------
+
+	err = runtime.BindQueryParameterWithOptions("form", true, false, "created_from", r.URL.Query(), &params.CreatedFrom, runtime.BindQueryParameterOptions{Type: "string", Format: "date-time"})
+	if err != nil {
+		var requiredError *runtime.RequiredParameterError
+		if errors.As(err, &requiredError) {
+			siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "created_from"})
+		} else {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "created_from", Err: err})
+		}
+		return
+	}
+
+	// ------------- Optional query parameter "created_to" -------------
+
+	err = runtime.BindQueryParameterWithOptions("form", true, false, "created_to", r.URL.Query(), &params.CreatedTo, runtime.BindQueryParameterOptions{Type: "string", Format: "date-time"})
+	if err != nil {
+		var requiredError *runtime.RequiredParameterError
+		if errors.As(err, &requiredError) {
+			siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "created_to"})
+		} else {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "created_to", Err: err})
+		}
+		return
+	}
+
+	// ------------- Optional query parameter "merged_from" -------------
+
+	err = runtime.BindQueryParameterWithOptions("form", true, false, "merged_from", r.URL.Query(), &params.MergedFrom, runtime.BindQueryParameterOptions{Type: "string", Format: "date-time"})
+	if err != nil {
+		var requiredError *runtime.RequiredParameterError
+		if errors.As(err, &requiredError) {
+			siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "merged_from"})
+		} else {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "merged_from", Err: err})
+		}
+		return
+	}
+
+	// ------------- Optional query parameter "merged_to" -------------
+
+	err = runtime.BindQueryParameterWithOptions("form", true, false, "merged_to", r.URL.Query(), &params.MergedTo, runtime.BindQueryParameterOptions{Type: "string", Format: "date-time"})
+	if err != nil {
+		var requiredError *runtime.RequiredParameterError
+		if errors.As(err, &requiredError) {
+			siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "merged_to"})
+		} else {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "merged_to", Err: err})
+		}
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetPullRequestList(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// PostPullRequestMerge operation middleware
+func (siw *ServerInterfaceWrapper) PostPullRequestMerge(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+	_ = err
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params PostPullRequestMergeParams
+
+	headers := r.Header
+
+	// ------------- Optional header parameter "If-Match" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("If-Match")]; found {
+		var IfMatch IfMatchHeader
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandlerFunc(w, r, &TooManyValuesForParamError{ParamName: "If-Match", Count: n})
+			return
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "If-Match", valueList[0], &IfMatch, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: false, Type: "string", Format: ""})
+		if err != nil {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "If-Match", Err: err})
+			return
+		}
+
+		params.IfMatch = &IfMatch
+
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PostPullRequestMerge(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetPullRequestOverdue operation middleware
+func (siw *ServerInterfaceWrapper) GetPullRequestOverdue(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+	_ = err
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetPullRequestOverdueParams
+
+	// ------------- Optional query parameter "team_name" -------------
+
+	err = runtime.BindQueryParameterWithOptions("form", true, false, "team_name", r.URL.Query(), &params.TeamName, runtime.BindQueryParameterOptions{Type: "string", Format: ""})
+	if err != nil {
+		var requiredError *runtime.RequiredParameterError
+		if errors.As(err, &requiredError) {
+			siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "team_name"})
+		} else {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "team_name", Err: err})
+		}
+		return
+	}
+
+	// ------------- Optional query parameter "reviewer_id" -------------
+
+	err = runtime.BindQueryParameterWithOptions("form", true, false, "reviewer_id", r.URL.Query(), &params.ReviewerId, runtime.BindQueryParameterOptions{Type: "string", Format: ""})
+	if err != nil {
+		var requiredError *runtime.RequiredParameterError
+		if errors.As(err, &requiredError) {
+			siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "reviewer_id"})
+		} else {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "reviewer_id", Err: err})
+		}
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetPullRequestOverdue(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// PostPullRequestReassign operation middleware
+func (siw *ServerInterfaceWrapper) PostPullRequestReassign(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+	_ = err
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params PostPullRequestReassignParams
+
+	headers := r.Header
+
+	// ------------- Optional header parameter "If-Match" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("If-Match")]; found {
+		var IfMatch IfMatchHeader
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandlerFunc(w, r, &TooManyValuesForParamError{ParamName: "If-Match", Count: n})
+			return
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "If-Match", valueList[0], &IfMatch, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: false, Type: "string", Format: ""})
+		if err != nil {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "If-Match", Err: err})
+			return
+		}
+
+		params.IfMatch = &IfMatch
+
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PostPullRequestReassign(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetStatisticFairness operation middleware
+func (siw *ServerInterfaceWrapper) GetStatisticFairness(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+	_ = err
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetStatisticFairnessParams
+
+	// ------------- Optional query parameter "from" -------------
+
+	err = runtime.BindQueryParameterWithOptions("form", true, false, "from", r.URL.Query(), &params.From, runtime.BindQueryParameterOptions{Type: "string", Format: "date-time"})
+	if err != nil {
+		var requiredError *runtime.RequiredParameterError
+		if errors.As(err, &requiredError) {
+			siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "from"})
+		} else {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "from", Err: err})
+		}
+		return
+	}
+
+	// ------------- Optional query parameter "to" -------------
+
+	err = runtime.BindQueryParameterWithOptions("form", true, false, "to", r.URL.Query(), &params.To, runtime.BindQueryParameterOptions{Type: "string", Format: "date-time"})
+	if err != nil {
+		var requiredError *runtime.RequiredParameterError
+		if errors.As(err, &requiredError) {
+			siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "to"})
+		} else {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "to", Err: err})
+		}
+		return
+	}
+
+	// ------------- Optional query parameter "team_name" -------------
+
+	err = runtime.BindQueryParameterWithOptions("form", true, false, "team_name", r.URL.Query(), &params.TeamName, runtime.BindQueryParameterOptions{Type: "string", Format: ""})
+	if err != nil {
+		var requiredError *runtime.RequiredParameterError
+		if errors.As(err, &requiredError) {
+			siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "team_name"})
+		} else {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "team_name", Err: err})
+		}
+		return
+	}
+
+	// ------------- Optional query parameter "status" -------------
+
+	err = runtime.BindQueryParameterWithOptions("form", true, false, "status", r.URL.Query(), &params.Status, runtime.BindQueryParameterOptions{Type: "string", Format: ""})
+	if err != nil {
+		var requiredError *runtime.RequiredParameterError
+		if errors.As(err, &requiredError) {
+			siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "status"})
+		} else {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "status", Err: err})
+		}
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetStatisticFairness(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetStatisticPullRequests operation middleware
+func (siw *ServerInterfaceWrapper) GetStatisticPullRequests(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+	_ = err
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetStatisticPullRequestsParams
+
+	// ------------- Optional query parameter "from" -------------
+
+	err = runtime.BindQueryParameterWithOptions("form", true, false, "from", r.URL.Query(), &params.From, runtime.BindQueryParameterOptions{Type: "string", Format: "date-time"})
+	if err != nil {
+		var requiredError *runtime.RequiredParameterError
+		if errors.As(err, &requiredError) {
+			siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "from"})
+		} else {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "from", Err: err})
+		}
+		return
+	}
+
+	// ------------- Optional query parameter "to" -------------
+
+	err = runtime.BindQueryParameterWithOptions("form", true, false, "to", r.URL.Query(), &params.To, runtime.BindQueryParameterOptions{Type: "string", Format: "date-time"})
+	if err != nil {
+		var requiredError *runtime.RequiredParameterError
+		if errors.As(err, &requiredError) {
+			siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "to"})
+		} else {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "to", Err: err})
+		}
+		return
+	}
+
+	// ------------- Optional query parameter "team_name" -------------
+
+	err = runtime.BindQueryParameterWithOptions("form", true, false, "team_name", r.URL.Query(), &params.TeamName, runtime.BindQueryParameterOptions{Type: "string", Format: ""})
+	if err != nil {
+		var requiredError *runtime.RequiredParameterError
+		if errors.As(err, &requiredError) {
+			siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "team_name"})
+		} else {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "team_name", Err: err})
+		}
+		return
+	}
+
+	// ------------- Optional query parameter "status" -------------
+
+	err = runtime.BindQueryParameterWithOptions("form", true, false, "status", r.URL.Query(), &params.Status, runtime.BindQueryParameterOptions{Type: "string", Format: ""})
+	if err != nil {
+		var requiredError *runtime.RequiredParameterError
+		if errors.As(err, &requiredError) {
+			siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "status"})
+		} else {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "status", Err: err})
+		}
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetStatisticPullRequests(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetStatisticReviews operation middleware
+func (siw *ServerInterfaceWrapper) GetStatisticReviews(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+	_ = err
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetStatisticReviewsParams
+
+	// ------------- Optional query parameter "from" -------------
+
+	err = runtime.BindQueryParameterWithOptions("form", true, false, "from", r.URL.Query(), &params.From, runtime.BindQueryParameterOptions{Type: "string", Format: "date-time"})
+	if err != nil {
+		var requiredError *runtime.RequiredParameterError
+		if errors.As(err, &requiredError) {
+			siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "from"})
+		} else {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "from", Err: err})
+		}
+		return
+	}
+
+	// ------------- Optional query parameter "to" -------------
+
+	err = runtime.BindQueryParameterWithOptions("form", true, false, "to", r.URL.Query(), &params.To, runtime.BindQueryParameterOptions{Type: "string", Format: "date-time"})
+	if err != nil {
+		var requiredError *runtime.RequiredParameterError
+		if errors.As(err, &requiredError) {
+			siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "to"})
+		} else {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "to", Err: err})
+		}
+		return
+	}
+
+	// ------------- Optional query parameter "team_name" -------------
+
+	err = runtime.BindQueryParameterWithOptions("form", true, false, "team_name", r.URL.Query(), &params.TeamName, runtime.BindQueryParameterOptions{Type: "string", Format: ""})
+	if err != nil {
+		var requiredError *runtime.RequiredParameterError
+		if errors.As(err, &requiredError) {
+			siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "team_name"})
+		} else {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "team_name", Err: err})
+		}
+		return
+	}
+
+	// ------------- Optional query parameter "status" -------------
+
+	err = runtime.BindQueryParameterWithOptions("form", true, false, "status", r.URL.Query(), &params.Status, runtime.BindQueryParameterOptions{Type: "string", Format: ""})
+	if err != nil {
+		var requiredError *runtime.RequiredParameterError
+		if errors.As(err, &requiredError) {
+			siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "status"})
+		} else {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "status", Err: err})
+		}
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetStatisticReviews(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetStatisticTeams operation middleware
+func (siw *ServerInterfaceWrapper) GetStatisticTeams(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+	_ = err
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetStatisticTeamsParams
+
+	// ------------- Optional query parameter "from" -------------
+
+	err = runtime.BindQueryParameterWithOptions("form", true, false, "from", r.URL.Query(), &params.From, runtime.BindQueryParameterOptions{Type: "string", Format: "date-time"})
+	if err != nil {
+		var requiredError *runtime.RequiredParameterError
+		if errors.As(err, &requiredError) {
+			siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "from"})
+		} else {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "from", Err: err})
+		}
+		return
+	}
+
+	// ------------- Optional query parameter "to" -------------
+
+	err = runtime.BindQueryParameterWithOptions("form", true, false, "to", r.URL.Query(), &params.To, runtime.BindQueryParameterOptions{Type: "string", Format: "date-time"})
+	if err != nil {
+		var requiredError *runtime.RequiredParameterError
+		if errors.As(err, &requiredError) {
+			siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "to"})
+		} else {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "to", Err: err})
+		}
+		return
+	}
+
+	// ------------- Optional query parameter "team_name" -------------
+
+	err = runtime.BindQueryParameterWithOptions("form", true, false, "team_name", r.URL.Query(), &params.TeamName, runtime.BindQueryParameterOptions{Type: "string", Format: ""})
+	if err != nil {
+		var requiredError *runtime.RequiredParameterError
+		if errors.As(err, &requiredError) {
+			siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "team_name"})
+		} else {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "team_name", Err: err})
+		}
+		return
+	}
+
+	// ------------- Optional query parameter "status" -------------
+
+	err = runtime.BindQueryParameterWithOptions("form", true, false, "status", r.URL.Query(), &params.Status, runtime.BindQueryParameterOptions{Type: "string", Format: ""})
+	if err != nil {
+		var requiredError *runtime.RequiredParameterError
+		if errors.As(err, &requiredError) {
+			siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "status"})
+		} else {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "status", Err: err})
+		}
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetStatisticTeams(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// PostTeamAdd operation middleware
+func (siw *ServerInterfaceWrapper) PostTeamAdd(w http.ResponseWriter, r *http.Request) {
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PostTeamAdd(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// PostTeamDeactivate operation middleware
+func (siw *ServerInterfaceWrapper) PostTeamDeactivate(w http.ResponseWriter, r *http.Request) {
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PostTeamDeactivate(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetTeamGet operation middleware
+func (siw *ServerInterfaceWrapper) GetTeamGet(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+	_ = err
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetTeamGetParams
+
+	// ------------- Required query parameter "team_name" -------------
+
+	err = runtime.BindQueryParameterWithOptions("form", true, true, "team_name", r.URL.Query(), &params.TeamName, runtime.BindQueryParameterOptions{Type: "string", Format: ""})
+	if err != nil {
+		var requiredError *runtime.RequiredParameterError
+		if errors.As(err, &requiredError) {
+			siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "team_name"})
+		} else {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "team_name", Err: err})
+		}
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetTeamGet(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// PostTeamImport operation middleware
+func (siw *ServerInterfaceWrapper) PostTeamImport(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+	_ = err
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params PostTeamImportParams
+
+	// ------------- Optional query parameter "format" -------------
+
+	err = runtime.BindQueryParameterWithOptions("form", true, false, "format", r.URL.Query(), &params.Format, runtime.BindQueryParameterOptions{Type: "string", Format: ""})
+	if err != nil {
+		var requiredError *runtime.RequiredParameterError
+		if errors.As(err, &requiredError) {
+			siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "format"})
+		} else {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "format", Err: err})
+		}
+		return
+	}
+
+	// ------------- Optional query parameter "dry_run" -------------
+
+	err = runtime.BindQueryParameterWithOptions("form", true, false, "dry_run", r.URL.Query(), &params.DryRun, runtime.BindQueryParameterOptions{Type: "boolean", Format: ""})
+	if err != nil {
+		var requiredError *runtime.RequiredParameterError
+		if errors.As(err, &requiredError) {
+			siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "dry_run"})
+		} else {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "dry_run", Err: err})
+		}
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PostTeamImport(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// PostTeamRebalance operation middleware
+func (siw *ServerInterfaceWrapper) PostTeamRebalance(w http.ResponseWriter, r *http.Request) {
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PostTeamRebalance(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetUsersGetReview operation middleware
+func (siw *ServerInterfaceWrapper) GetUsersGetReview(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+	_ = err
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetUsersGetReviewParams
+
+	// ------------- Required query parameter "user_id" -------------
+
+	err = runtime.BindQueryParameterWithOptions("form", true, true, "user_id", r.URL.Query(), &params.UserId, runtime.BindQueryParameterOptions{Type: "string", Format: ""})
+	if err != nil {
+		var requiredError *runtime.RequiredParameterError
+		if errors.As(err, &requiredError) {
+			siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "user_id"})
+		} else {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "user_id", Err: err})
+		}
+		return
+	}
+
+	// ------------- Optional query parameter "role" -------------
+
+	err = runtime.BindQueryParameterWithOptions("form", true, false, "role", r.URL.Query(), &params.Role, runtime.BindQueryParameterOptions{Type: "string", Format: ""})
+	if err != nil {
+		var requiredError *runtime.RequiredParameterError
+		if errors.As(err, &requiredError) {
+			siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "role"})
+		} else {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "role", Err: err})
+		}
+		return
+	}
+
+	// ------------- Optional query parameter "status" -------------
+
+	err = runtime.BindQueryParameterWithOptions("form", true, false, "status", r.URL.Query(), &params.Status, runtime.BindQueryParameterOptions{Type: "string", Format: ""})
+	if err != nil {
+		var requiredError *runtime.RequiredParameterError
+		if errors.As(err, &requiredError) {
+			siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "status"})
+		} else {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "status", Err: err})
+		}
+		return
+	}
+
+	// ------------- Optional query parameter "order" -------------
+
+	err = runtime.BindQueryParameterWithOptions("form", true, false, "order", r.URL.Query(), &params.Order, runtime.BindQueryParameterOptions{Type: "string", Format: ""})
+	if err != nil {
+		var requiredError *runtime.RequiredParameterError
+		if errors.As(err, &requiredError) {
+			siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "order"})
+		} else {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "order", Err: err})
+		}
+		return
+	}
+
+	// ------------- Optional query parameter "cursor" -------------
+
+	err = runtime.BindQueryParameterWithOptions("form", true, false, "cursor", r.URL.Query(), &params.Cursor, runtime.BindQueryParameterOptions{Type: "string", Format: ""})
+	if err != nil {
+		var requiredError *runtime.RequiredParameterError
+		if errors.As(err, &requiredError) {
+			siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "cursor"})
+		} else {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "cursor", Err: err})
+		}
+		return
+	}
+
+	// ------------- Optional query parameter "limit" -------------
+
+	err = runtime.BindQueryParameterWithOptions("form", true, false, "limit", r.URL.Query(), &params.Limit, runtime.BindQueryParameterOptions{Type: "integer", Format: ""})
+	if err != nil {
+		var requiredError *runtime.RequiredParameterError
+		if errors.As(err, &requiredError) {
+			siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "limit"})
+		} else {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "limit", Err: err})
+		}
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetUsersGetReview(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// PostUsersSetIsActive operation middleware
+func (siw *ServerInterfaceWrapper) PostUsersSetIsActive(w http.ResponseWriter, r *http.Request) {
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PostUsersSetIsActive(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// PostWebhooksAdd operation middleware
+func (siw *ServerInterfaceWrapper) PostWebhooksAdd(w http.ResponseWriter, r *http.Request) {
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PostWebhooksAdd(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetWebhooksDeadLetters operation middleware
+func (siw *ServerInterfaceWrapper) GetWebhooksDeadLetters(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+	_ = err
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetWebhooksDeadLettersParams
+
+	// ------------- Optional query parameter "webhook_id" -------------
+
+	err = runtime.BindQueryParameterWithOptions("form", true, false, "webhook_id", r.URL.Query(), &params.WebhookId, runtime.BindQueryParameterOptions{Type: "integer", Format: "int64"})
+	if err != nil {
+		var requiredError *runtime.RequiredParameterError
+		if errors.As(err, &requiredError) {
+			siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "webhook_id"})
+		} else {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "webhook_id", Err: err})
+		}
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetWebhooksDeadLetters(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// PostWebhooksDelete operation middleware
+func (siw *ServerInterfaceWrapper) PostWebhooksDelete(w http.ResponseWriter, r *http.Request) {
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PostWebhooksDelete(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetWebhooksList operation middleware
+func (siw *ServerInterfaceWrapper) GetWebhooksList(w http.ResponseWriter, r *http.Request) {
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetWebhooksList(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// PostWebhooksRetry operation middleware
+func (siw *ServerInterfaceWrapper) PostWebhooksRetry(w http.ResponseWriter, r *http.Request) {
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PostWebhooksRetry(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+type UnescapedCookieParamError struct {
+	ParamName string
+	Err       error
+}
+
+func (e *UnescapedCookieParamError) Error() string {
+	return fmt.Sprintf("error unescaping cookie parameter '%s'", e.ParamName)
+}
+
+func (e *UnescapedCookieParamError) Unwrap() error {
+	return e.Err
+}
+
+type UnmarshalingParamError struct {
+	ParamName string
+	Err       error
+}
+
+func (e *UnmarshalingParamError) Error() string {
+	return fmt.Sprintf("Error unmarshaling parameter %s as JSON: %s", e.ParamName, e.Err.Error())
+}
+
+func (e *UnmarshalingParamError) Unwrap() error {
+	return e.Err
+}
+
+type RequiredParamError struct {
+	ParamName string
+}
+
+func (e *RequiredParamError) Error() string {
+	return fmt.Sprintf("Query argument %s is required, but not found", e.ParamName)
+}
+
+type RequiredHeaderError struct {
+	ParamName string
+	Err       error
+}
+
+func (e *RequiredHeaderError) Error() string {
+	return fmt.Sprintf("Header parameter %s is required, but not found", e.ParamName)
+}
+
+func (e *RequiredHeaderError) Unwrap() error {
+	return e.Err
+}
+
+type InvalidParamFormatError struct {
+	ParamName string
+	Err       error
+}
+
+func (e *InvalidParamFormatError) Error() string {
+	return fmt.Sprintf("Invalid format for parameter %s: %s", e.ParamName, e.Err.Error())
+}
+
+func (e *InvalidParamFormatError) Unwrap() error {
+	return e.Err
+}
+
+type TooManyValuesForParamError struct {
+	ParamName string
+	Count     int
+}
+
+func (e *TooManyValuesForParamError) Error() string {
+	return fmt.Sprintf("Expected one value for %s, got %d", e.ParamName, e.Count)
+}
+
+// Handler creates http.Handler with routing matching OpenAPI spec.
+func Handler(si ServerInterface) http.Handler {
+	return HandlerWithOptions(si, ChiServerOptions{})
+}
+
+type ChiServerOptions struct {
+	BaseURL          string
+	BaseRouter       chi.Router
+	Middlewares      []MiddlewareFunc
+	ErrorHandlerFunc func(w http.ResponseWriter, r *http.Request, err error)
+}
+
+// HandlerFromMux creates http.Handler with routing matching OpenAPI spec based on the provided mux.
+func HandlerFromMux(si ServerInterface, r chi.Router) http.Handler {
+	return HandlerWithOptions(si, ChiServerOptions{
+		BaseRouter: r,
+	})
+}
+
+func HandlerFromMuxWithBaseURL(si ServerInterface, r chi.Router, baseURL string) http.Handler {
+	return HandlerWithOptions(si, ChiServerOptions{
+		BaseURL:    baseURL,
+		BaseRouter: r,
+	})
+}
+
+// HandlerWithOptions creates http.Handler with additional options
+func HandlerWithOptions(si ServerInterface, options ChiServerOptions) http.Handler {
+	r := options.BaseRouter
+
+	if r == nil {
+		r = chi.NewRouter()
+	}
+	if options.ErrorHandlerFunc == nil {
+		options.ErrorHandlerFunc = func(w http.ResponseWriter, r *http.Request, err error) {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+		}
+	}
+	wrapper := ServerInterfaceWrapper{
+		Handler:            si,
+		HandlerMiddlewares: options.Middlewares,
+		ErrorHandlerFunc:   options.ErrorHandlerFunc,
+	}
+
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/admin/backup", wrapper.GetAdminBackup)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/admin/restore", wrapper.PostAdminRestore)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/audit", wrapper.GetAudit)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/auth/tokens/create", wrapper.PostAuthTokensCreate)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/auth/tokens/list", wrapper.GetAuthTokensList)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/auth/tokens/revoke", wrapper.PostAuthTokensRevoke)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/export/assignments", wrapper.GetExportAssignments)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/export/pullRequests", wrapper.GetExportPullRequests)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/export/reviews", wrapper.GetExportReviews)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/pullRequest/create", wrapper.PostPullRequestCreate)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/pullRequest/decline", wrapper.PostPullRequestDecline)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/pullRequest/declines", wrapper.GetPullRequestDeclines)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/pullRequest/get", wrapper.GetPullRequestGet)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/pullRequest/list", wrapper.GetPullRequestList)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/pullRequest/merge", wrapper.PostPullRequestMerge)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/pullRequest/overdue", wrapper.GetPullRequestOverdue)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/pullRequest/reassign", wrapper.PostPullRequestReassign)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/statistic/fairness", wrapper.GetStatisticFairness)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/statistic/pullRequests", wrapper.GetStatisticPullRequests)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/statistic/reviews", wrapper.GetStatisticReviews)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/statistic/teams", wrapper.GetStatisticTeams)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/team/add", wrapper.PostTeamAdd)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/team/deactivate", wrapper.PostTeamDeactivate)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/team/get", wrapper.GetTeamGet)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/team/import", wrapper.PostTeamImport)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/team/rebalance", wrapper.PostTeamRebalance)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/users/getReview", wrapper.GetUsersGetReview)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/users/setIsActive", wrapper.PostUsersSetIsActive)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/webhooks/add", wrapper.PostWebhooksAdd)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/webhooks/deadLetters", wrapper.GetWebhooksDeadLetters)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/webhooks/delete", wrapper.PostWebhooksDelete)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/webhooks/list", wrapper.GetWebhooksList)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/webhooks/retry", wrapper.PostWebhooksRetry)
+	})
+
+	return r
+}
+
+type GetAdminBackupRequestObject struct {
+}
+
+type GetAdminBackupResponseObject interface {
+	VisitGetAdminBackupResponse(w http.ResponseWriter) error
+}
+
+type GetAdminBackup200ResponseHeaders struct {
+	ContentDisposition *string
+}
+
+type GetAdminBackup200JSONResponse struct {
+	Body    BackupArchive
+	Headers GetAdminBackup200ResponseHeaders
+}
+
+func (response GetAdminBackup200JSONResponse) VisitGetAdminBackupResponse(w http.ResponseWriter) error {
+
+	var buf bytes.Buffer
+	if err := json.NewEncoder(&buf).Encode(response.Body); err != nil {
+		return err
+	}
+	w.Header().Set("Content-Type", "application/json")
+	if response.Headers.ContentDisposition != nil {
+		w.Header().Set("Content-Disposition", fmt.Sprint(*response.Headers.ContentDisposition))
+	}
+	w.WriteHeader(200)
+	_, err := buf.WriteTo(w)
+	return err
+}
+
+type PostAdminRestoreRequestObject struct {
+	Body *PostAdminRestoreJSONRequestBody
+}
+
+type PostAdminRestoreResponseObject interface {
+	VisitPostAdminRestoreResponse(w http.ResponseWriter) error
+}
+
+type PostAdminRestore200JSONResponse struct {
+	PullRequests int `json:"pull_requests"`
+	Reviewers    int `json:"reviewers"`
+	Teams        int `json:"teams"`
+	Users        int `json:"users"`
+}
+
+func (response PostAdminRestore200JSONResponse) VisitPostAdminRestoreResponse(w http.ResponseWriter) error {
+
+	var buf bytes.Buffer
+	if err := json.NewEncoder(&buf).Encode(response); err != nil {
+		return err
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+	_, err := buf.WriteTo(w)
+	return err
+}
+
+type PostAdminRestore400JSONResponse ErrorResponse
+
+func (response PostAdminRestore400JSONResponse) VisitPostAdminRestoreResponse(w http.ResponseWriter) error {
+
+	var buf bytes.Buffer
+	if err := json.NewEncoder(&buf).Encode(response); err != nil {
+		return err
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+	_, err := buf.WriteTo(w)
+	return err
+}
+
+type GetAuditRequestObject struct {
+	Params GetAuditParams
+}
+
+type GetAuditResponseObject interface {
+	VisitGetAuditResponse(w http.ResponseWriter) error
+}
+
+type GetAudit200JSONResponse struct {
+	Events      []AuditEvent `json:"events"`
+	NextAfterId *int64       `json:"next_after_id"`
+}
+
+func (response GetAudit200JSONResponse) VisitGetAuditResponse(w http.ResponseWriter) error {
+
+	var buf bytes.Buffer
+	if err := json.NewEncoder(&buf).Encode(response); err != nil {
+		return err
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+	_, err := buf.WriteTo(w)
+	return err
+}
+
+type GetAudit400JSONResponse ErrorResponse
+
+func (response GetAudit400JSONResponse) VisitGetAuditResponse(w http.ResponseWriter) error {
+
+	var buf bytes.Buffer
+	if err := json.NewEncoder(&buf).Encode(response); err != nil {
+		return err
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+	_, err := buf.WriteTo(w)
+	return err
+}
+
+type PostAuthTokensCreateRequestObject struct {
+	Body *PostAuthTokensCreateJSONRequestBody
+}
+
+type PostAuthTokensCreateResponseObject interface {
+	VisitPostAuthTokensCreateResponse(w http.ResponseWriter) error
+}
+
+type PostAuthTokensCreate201JSONResponse struct {
+	Secret string   `json:"secret"`
+	Token  APIToken `json:"token"`
+}
+
+func (response PostAuthTokensCreate201JSONResponse) VisitPostAuthTokensCreateResponse(w http.ResponseWriter) error {
+
+	var buf bytes.Buffer
+	if err := json.NewEncoder(&buf).Encode(response); err != nil {
+		return err
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(201)
+	_, err := buf.WriteTo(w)
+	return err
+}
+
+type PostAuthTokensCreate400JSONResponse ErrorResponse
+
+func (response PostAuthTokensCreate400JSONResponse) VisitPostAuthTokensCreateResponse(w http.ResponseWriter) error {
+
+	var buf bytes.Buffer
+	if err := json.NewEncoder(&buf).Encode(response); err != nil {
+		return err
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+	_, err := buf.WriteTo(w)
+	return err
+}
+
+type PostAuthTokensCreate404JSONResponse ErrorResponse
+
+func (response PostAuthTokensCreate404JSONResponse) VisitPostAuthTokensCreateResponse(w http.ResponseWriter) error {
+
+	var buf bytes.Buffer
+	if err := json.NewEncoder(&buf).Encode(response); err != nil {
+		return err
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+	_, err := buf.WriteTo(w)
+	return err
+}
+
+type GetAuthTokensListRequestObject struct {
+}
+
+type GetAuthTokensListResponseObject interface {
+	VisitGetAuthTokensListResponse(w http.ResponseWriter) error
+}
+
+type GetAuthTokensList200JSONResponse struct {
+	Tokens []APIToken `json:"tokens"`
+}
+
+func (response GetAuthTokensList200JSONResponse) VisitGetAuthTokensListResponse(w http.ResponseWriter) error {
+
+	var buf bytes.Buffer
+	if err := json.NewEncoder(&buf).Encode(response); err != nil {
+		return err
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+	_, err := buf.WriteTo(w)
+	return err
+}
+
+type PostAuthTokensRevokeRequestObject struct {
+	Body *PostAuthTokensRevokeJSONRequestBody
+}
+
+type PostAuthTokensRevokeResponseObject interface {
+	VisitPostAuthTokensRevokeResponse(w http.ResponseWriter) error
+}
+
+type PostAuthTokensRevoke200JSONResponse struct {
+	Revoked bool  `json:"revoked"`
+	TokenId int64 `json:"token_id"`
+}
+
+func (response PostAuthTokensRevoke200JSONResponse) VisitPostAuthTokensRevokeResponse(w http.ResponseWriter) error {
+
+	var buf bytes.Buffer
+	if err := json.NewEncoder(&buf).Encode(response); err != nil {
+		return err
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+	_, err := buf.WriteTo(w)
+	return err
+}
+
+type PostAuthTokensRevoke400JSONResponse ErrorResponse
+
+func (response PostAuthTokensRevoke400JSONResponse) VisitPostAuthTokensRevokeResponse(w http.ResponseWriter) error {
+
+	var buf bytes.Buffer
+	if err := json.NewEncoder(&buf).Encode(response); err != nil {
+		return err
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+	_, err := buf.WriteTo(w)
+	return err
+}
+
+type PostAuthTokensRevoke404JSONResponse ErrorResponse
+
+func (response PostAuthTokensRevoke404JSONResponse) VisitPostAuthTokensRevokeResponse(w http.ResponseWriter) error {
+
+	var buf bytes.Buffer
+	if err := json.NewEncoder(&buf).Encode(response); err != nil {
+		return err
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+	_, err := buf.WriteTo(w)
+	return err
+}
+
+type GetExportAssignmentsRequestObject struct {
+	Params GetExportAssignmentsParams
+}
+
+type GetExportAssignmentsResponseObject interface {
+	VisitGetExportAssignmentsResponse(w http.ResponseWriter) error
+}
+
+type GetExportAssignments200ResponseHeaders struct {
+	ContentDisposition *string
+}
+
+type GetExportAssignments200ApplicationxNdjsonResponse struct {
+	Body          io.Reader
+	Headers       GetExportAssignments200ResponseHeaders
+	ContentLength int64
+}
+
+func (response GetExportAssignments200ApplicationxNdjsonResponse) VisitGetExportAssignmentsResponse(w http.ResponseWriter) error {
+
+	w.Header().Set("Content-Type", "application/x-ndjson")
+	if response.ContentLength != 0 {
+		w.Header().Set("Content-Length", fmt.Sprint(response.ContentLength))
+	}
+	if response.Headers.ContentDisposition != nil {
+		w.Header().Set("Content-Disposition", fmt.Sprint(*response.Headers.ContentDisposition))
+	}
+	w.WriteHeader(200)
+
+	if closer, ok := response.Body.(io.ReadCloser); ok {
+		defer closer.Close()
+	}
+	flusher, ok := w.(http.Flusher)
+	if !ok {
+		// If w doesn't support flushing, fall back to io.Copy.
+		_, err := io.Copy(w, response.Body)
+		return err
+	}
+	// text/event-stream messages are typically small; use a
+	// modest buffer and flush after each chunk so clients see
+	// events immediately instead of waiting on OS buffering.
+	buf := make([]byte, 4096)
+	for {
+		n, err := response.Body.Read(buf)
+		if n > 0 {
+			if _, writeErr := w.Write(buf[:n]); writeErr != nil {
+				return writeErr
+			}
+			flusher.Flush()
+		}
+		if err != nil {
+			if err == io.EOF {
+				return nil
+			}
+			return err
+		}
+	}
+}
+
+type GetExportAssignments200TextcsvResponse struct {
+	Body          io.Reader
+	Headers       GetExportAssignments200ResponseHeaders
+	ContentLength int64
+}
+
+func (response GetExportAssignments200TextcsvResponse) VisitGetExportAssignmentsResponse(w http.ResponseWriter) error {
+
+	w.Header().Set("Content-Type", "text/csv")
+	if response.ContentLength != 0 {
+		w.Header().Set("Content-Length", fmt.Sprint(response.ContentLength))
+	}
+	if response.Headers.ContentDisposition != nil {
+		w.Header().Set("Content-Disposition", fmt.Sprint(*response.Headers.ContentDisposition))
+	}
+	w.WriteHeader(200)
+
+	if closer, ok := response.Body.(io.ReadCloser); ok {
+		defer closer.Close()
+	}
+	_, err := io.Copy(w, response.Body)
+	return err
+}
+
+type GetExportAssignments400JSONResponse ErrorResponse
+
+func (response GetExportAssignments400JSONResponse) VisitGetExportAssignmentsResponse(w http.ResponseWriter) error {
+
+	var buf bytes.Buffer
+	if err := json.NewEncoder(&buf).Encode(response); err != nil {
+		return err
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+	_, err := buf.WriteTo(w)
+	return err
+}
+
+type GetExportPullRequestsRequestObject struct {
+	Params GetExportPullRequestsParams
+}
+
+type GetExportPullRequestsResponseObject interface {
+	VisitGetExportPullRequestsResponse(w http.ResponseWriter) error
+}
+
+type GetExportPullRequests200ResponseHeaders struct {
+	ContentDisposition *string
+}
+
+type GetExportPullRequests200ApplicationxNdjsonResponse struct {
+	Body          io.Reader
+	Headers       GetExportPullRequests200ResponseHeaders
+	ContentLength int64
+}
+
+func (response GetExportPullRequests200ApplicationxNdjsonResponse) VisitGetExportPullRequestsResponse(w http.ResponseWriter) error {
+
+	w.Header().Set("Content-Type", "application/x-ndjson")
+	if response.ContentLength != 0 {
+		w.Header().Set("Content-Length", fmt.Sprint(response.ContentLength))
+	}
+	if response.Headers.ContentDisposition != nil {
+		w.Header().Set("Content-Disposition", fmt.Sprint(*response.Headers.ContentDisposition))
+	}
+	w.WriteHeader(200)
+
+	if closer, ok := response.Body.(io.ReadCloser); ok {
+		defer closer.Close()
+	}
+	flusher, ok := w.(http.Flusher)
+	if !ok {
+		// If w doesn't support flushing, fall back to io.Copy.
+		_, err := io.Copy(w, response.Body)
+		return err
+	}
+	// text/event-stream messages are typically small; use a
+	// modest buffer and flush after each chunk so clients see
+	// events immediately instead of waiting on OS buffering.
+	buf := make([]byte, 4096)
+	for {
+		n, err := response.Body.Read(buf)
+		if n > 0 {
+			if _, writeErr := w.Write(buf[:n]); writeErr != nil {
+				return writeErr
+			}
+			flusher.Flush()
+		}
+		if err != nil {
+			if err == io.EOF {
+				return nil
+			}
+			return err
+		}
+	}
+}
+
+type GetExportPullRequests200TextcsvResponse struct {
+	Body          io.Reader
+	Headers       GetExportPullRequests200ResponseHeaders
+	ContentLength int64
+}
+
+func (response GetExportPullRequests200TextcsvResponse) VisitGetExportPullRequestsResponse(w http.ResponseWriter) error {
+
+	w.Header().Set("Content-Type", "text/csv")
+	if response.ContentLength != 0 {
+		w.Header().Set("Content-Length", fmt.Sprint(response.ContentLength))
+	}
+	if response.Headers.ContentDisposition != nil {
+		w.Header().Set("Content-Disposition", fmt.Sprint(*response.Headers.ContentDisposition))
+	}
+	w.WriteHeader(200)
+
+	if closer, ok := response.Body.(io.ReadCloser); ok {
+		defer closer.Close()
+	}
+	_, err := io.Copy(w, response.Body)
+	return err
+}
+
+type GetExportPullRequests400JSONResponse ErrorResponse
+
+func (response GetExportPullRequests400JSONResponse) VisitGetExportPullRequestsResponse(w http.ResponseWriter) error {
+
+	var buf bytes.Buffer
+	if err := json.NewEncoder(&buf).Encode(response); err != nil {
+		return err
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+	_, err := buf.WriteTo(w)
+	return err
+}
+
+type GetExportPullRequests404JSONResponse ErrorResponse
+
+func (response GetExportPullRequests404JSONResponse) VisitGetExportPullRequestsResponse(w http.ResponseWriter) error {
+
+	var buf bytes.Buffer
+	if err := json.NewEncoder(&buf).Encode(response); err != nil {
+		return err
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+	_, err := buf.WriteTo(w)
+	return err
+}
+
+type GetExportReviewsRequestObject struct {
+	Params GetExportReviewsParams
+}
+
+type GetExportReviewsResponseObject interface {
+	VisitGetExportReviewsResponse(w http.ResponseWriter) error
+}
+
+type GetExportReviews200ResponseHeaders struct {
+	ContentDisposition *string
+}
+
+type GetExportReviews200ApplicationxNdjsonResponse struct {
+	Body          io.Reader
+	Headers       GetExportReviews200ResponseHeaders
+	ContentLength int64
+}
+
+func (response GetExportReviews200ApplicationxNdjsonResponse) VisitGetExportReviewsResponse(w http.ResponseWriter) error {
+
+	w.Header().Set("Content-Type", "application/x-ndjson")
+	if response.ContentLength != 0 {
+		w.Header().Set("Content-Length", fmt.Sprint(response.ContentLength))
+	}
+	if response.Headers.ContentDisposition != nil {
+		w.Header().Set("Content-Disposition", fmt.Sprint(*response.Headers.ContentDisposition))
+	}
+	w.WriteHeader(200)
+
+	if closer, ok := response.Body.(io.ReadCloser); ok {
+		defer closer.Close()
+	}
+	flusher, ok := w.(http.Flusher)
+	if !ok {
+		// If w doesn't support flushing, fall back to io.Copy.
+		_, err := io.Copy(w, response.Body)
+		return err
+	}
+	// text/event-stream messages are typically small; use a
+	// modest buffer and flush after each chunk so clients see
+	// events immediately instead of waiting on OS buffering.
+	buf := make([]byte, 4096)
+	for {
+		n, err := response.Body.Read(buf)
+		if n > 0 {
+			if _, writeErr := w.Write(buf[:n]); writeErr != nil {
+				return writeErr
+			}
+			flusher.Flush()
+		}
+		if err != nil {
+			if err == io.EOF {
+				return nil
+			}
+			return err
+		}
+	}
+}
+
+type GetExportReviews200TextcsvResponse struct {
+	Body          io.Reader
+	Headers       GetExportReviews200ResponseHeaders
+	ContentLength int64
+}
+
+func (response GetExportReviews200TextcsvResponse) VisitGetExportReviewsResponse(w http.ResponseWriter) error {
+
+	w.Header().Set("Content-Type", "text/csv")
+	if response.ContentLength != 0 {
+		w.Header().Set("Content-Length", fmt.Sprint(response.ContentLength))
+	}
+	if response.Headers.ContentDisposition != nil {
+		w.Header().Set("Content-Disposition", fmt.Sprint(*response.Headers.ContentDisposition))
+	}
+	w.WriteHeader(200)
+
+	if closer, ok := response.Body.(io.ReadCloser); ok {
+		defer closer.Close()
+	}
+	_, err := io.Copy(w, response.Body)
+	return err
+}
+
+type GetExportReviews400JSONResponse ErrorResponse
+
+func (response GetExportReviews400JSONResponse) VisitGetExportReviewsResponse(w http.ResponseWriter) error {
+
+	var buf bytes.Buffer
+	if err := json.NewEncoder(&buf).Encode(response); err != nil {
+		return err
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+	_, err := buf.WriteTo(w)
+	return err
+}
+
+type PostPullRequestCreateRequestObject struct {
+	Body *PostPullRequestCreateJSONRequestBody
+}
+
+type PostPullRequestCreateResponseObject interface {
+	VisitPostPullRequestCreateResponse(w http.ResponseWriter) error
+}
+
+type PostPullRequestCreate201ResponseHeaders struct {
+	ETag *string
+}
+
+type PostPullRequestCreate201JSONResponse struct {
+	Body struct {
+		Pr PullRequest `json:"pr"`
+	}
+	Headers PostPullRequestCreate201ResponseHeaders
+}
+
+func (response PostPullRequestCreate201JSONResponse) VisitPostPullRequestCreateResponse(w http.ResponseWriter) error {
+
+	var buf bytes.Buffer
+	if err := json.NewEncoder(&buf).Encode(response.Body); err != nil {
+		return err
+	}
+	w.Header().Set("Content-Type", "application/json")
+	if response.Headers.ETag != nil {
+		w.Header().Set("ETag", fmt.Sprint(*response.Headers.ETag))
+	}
+	w.WriteHeader(201)
+	_, err := buf.WriteTo(w)
+	return err
+}
+
+type PostPullRequestCreate404JSONResponse ErrorResponse
+
+func (response PostPullRequestCreate404JSONResponse) VisitPostPullRequestCreateResponse(w http.ResponseWriter) error {
+
+	var buf bytes.Buffer
+	if err := json.NewEncoder(&buf).Encode(response); err != nil {
+		return err
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+	_, err := buf.WriteTo(w)
+	return err
+}
+
+type PostPullRequestCreate409JSONResponse ErrorResponse
+
+func (response PostPullRequestCreate409JSONResponse) VisitPostPullRequestCreateResponse(w http.ResponseWriter) error {
+
+	var buf bytes.Buffer
+	if err := json.NewEncoder(&buf).Encode(response); err != nil {
+		return err
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(409)
+	_, err := buf.WriteTo(w)
+	return err
+}
+
+type PostPullRequestDeclineRequestObject struct {
+	Params PostPullRequestDeclineParams
+	Body   *PostPullRequestDeclineJSONRequestBody
+}
+
+type PostPullRequestDeclineResponseObject interface {
+	VisitPostPullRequestDeclineResponse(w http.ResponseWriter) error
+}
+
+type PostPullRequestDecline200ResponseHeaders struct {
+	ETag *string
+}
+
+type PostPullRequestDecline200JSONResponse struct {
+	Body struct {
+		Declines []ReviewerDecline `json:"declines"`
+		Pr       PullRequest       `json:"pr"`
+
+		// ReplacedBy user_id замены (null - замены не нашлось)
+		ReplacedBy *string `json:"replaced_by"`
+	}
+	Headers PostPullRequestDecline200ResponseHeaders
+}
+
+func (response PostPullRequestDecline200JSONResponse) VisitPostPullRequestDeclineResponse(w http.ResponseWriter) error {
+
+	var buf bytes.Buffer
+	if err := json.NewEncoder(&buf).Encode(response.Body); err != nil {
+		return err
+	}
+	w.Header().Set("Content-Type", "application/json")
+	if response.Headers.ETag != nil {
+		w.Header().Set("ETag", fmt.Sprint(*response.Headers.ETag))
+	}
+	w.WriteHeader(200)
+	_, err := buf.WriteTo(w)
+	return err
+}
+
+type PostPullRequestDecline403JSONResponse ErrorResponse
+
+func (response PostPullRequestDecline403JSONResponse) VisitPostPullRequestDeclineResponse(w http.ResponseWriter) error {
+
+	var buf bytes.Buffer
+	if err := json.NewEncoder(&buf).Encode(response); err != nil {
+		return err
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+	_, err := buf.WriteTo(w)
+	return err
+}
+
+type PostPullRequestDecline404JSONResponse ErrorResponse
+
+func (response PostPullRequestDecline404JSONResponse) VisitPostPullRequestDeclineResponse(w http.ResponseWriter) error {
+
+	var buf bytes.Buffer
+	if err := json.NewEncoder(&buf).Encode(response); err != nil {
+		return err
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+	_, err := buf.WriteTo(w)
+	return err
+}
+
+type PostPullRequestDecline409JSONResponse ErrorResponse
+
+func (response PostPullRequestDecline409JSONResponse) VisitPostPullRequestDeclineResponse(w http.ResponseWriter) error {
+
+	var buf bytes.Buffer
+	if err := json.NewEncoder(&buf).Encode(response); err != nil {
+		return err
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(409)
+	_, err := buf.WriteTo(w)
+	return err
+}
+
+type PostPullRequestDecline412JSONResponse ErrorResponse
+
+func (response PostPullRequestDecline412JSONResponse) VisitPostPullRequestDeclineResponse(w http.ResponseWriter) error {
+
+	var buf bytes.Buffer
+	if err := json.NewEncoder(&buf).Encode(response); err != nil {
+		return err
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(412)
+	_, err := buf.WriteTo(w)
+	return err
+}
+
+type GetPullRequestDeclinesRequestObject struct {
+	Params GetPullRequestDeclinesParams
+}
+
+type GetPullRequestDeclinesResponseObject interface {
+	VisitGetPullRequestDeclinesResponse(w http.ResponseWriter) error
+}
+
+type GetPullRequestDeclines200JSONResponse struct {
+	Declines      []ReviewerDecline `json:"declines"`
+	PullRequestId string            `json:"pull_request_id"`
+}
+
+func (response GetPullRequestDeclines200JSONResponse) VisitGetPullRequestDeclinesResponse(w http.ResponseWriter) error {
+
+	var buf bytes.Buffer
+	if err := json.NewEncoder(&buf).Encode(response); err != nil {
+		return err
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+	_, err := buf.WriteTo(w)
+	return err
+}
+
+type GetPullRequestGetRequestObject struct {
+	Params GetPullRequestGetParams
+}
+
+type GetPullRequestGetResponseObject interface {
+	VisitGetPullRequestGetResponse(w http.ResponseWriter) error
+}
+
+type GetPullRequestGet200ResponseHeaders struct {
+	ETag *string
+}
+
+type GetPullRequestGet200JSONResponse struct {
+	Body struct {
+		Pr       PullRequestDetails `json:"pr"`
+		Timeline []TimelineItem     `json:"timeline"`
+	}
+	Headers GetPullRequestGet200ResponseHeaders
+}
+
+func (response GetPullRequestGet200JSONResponse) VisitGetPullRequestGetResponse(w http.ResponseWriter) error {
+
+	var buf bytes.Buffer
+	if err := json.NewEncoder(&buf).Encode(response.Body); err != nil {
+		return err
+	}
+	w.Header().Set("Content-Type", "application/json")
+	if response.Headers.ETag != nil {
+		w.Header().Set("ETag", fmt.Sprint(*response.Headers.ETag))
+	}
+	w.WriteHeader(200)
+	_, err := buf.WriteTo(w)
+	return err
+}
+
+type GetPullRequestGet404JSONResponse ErrorResponse
+
+func (response GetPullRequestGet404JSONResponse) VisitGetPullRequestGetResponse(w http.ResponseWriter) error {
+
+	var buf bytes.Buffer
+	if err := json.NewEncoder(&buf).Encode(response); err != nil {
+		return err
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+	_, err := buf.WriteTo(w)
+	return err
+}
+
+type GetPullRequestListRequestObject struct {
+	Params GetPullRequestListParams
+}
+
+type GetPullRequestListResponseObject interface {
+	VisitGetPullRequestListResponse(w http.ResponseWriter) error
+}
+
+type GetPullRequestList200JSONResponse struct {
+	NextCursor   *string       `json:"next_cursor"`
+	PullRequests []PullRequest `json:"pull_requests"`
+}
+
+func (response GetPullRequestList200JSONResponse) VisitGetPullRequestListResponse(w http.ResponseWriter) error {
+
+	var buf bytes.Buffer
+	if err := json.NewEncoder(&buf).Encode(response); err != nil {
+		return err
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+	_, err := buf.WriteTo(w)
+	return err
+}
+
+type GetPullRequestList400JSONResponse ErrorResponse
+
+func (response GetPullRequestList400JSONResponse) VisitGetPullRequestListResponse(w http.ResponseWriter) error {
+
+	var buf bytes.Buffer
+	if err := json.NewEncoder(&buf).Encode(response); err != nil {
+		return err
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+	_, err := buf.WriteTo(w)
+	return err
+}
+
+type PostPullRequestMergeRequestObject struct {
+	Params PostPullRequestMergeParams
+	Body   *PostPullRequestMergeJSONRequestBody
+}
+
+type PostPullRequestMergeResponseObject interface {
+	VisitPostPullRequestMergeResponse(w http.ResponseWriter) error
+}
+
+type PostPullRequestMerge200ResponseHeaders struct {
+	ETag *string
+}
+
+type PostPullRequestMerge200JSONResponse struct {
+	Body struct {
+		Pr PullRequest `json:"pr"`
+	}
+	Headers PostPullRequestMerge200ResponseHeaders
+}
+
+func (response PostPullRequestMerge200JSONResponse) VisitPostPullRequestMergeResponse(w http.ResponseWriter) error {
+
+	var buf bytes.Buffer
+	if err := json.NewEncoder(&buf).Encode(response.Body); err != nil {
+		return err
+	}
+	w.Header().Set("Content-Type", "application/json")
+	if response.Headers.ETag != nil {
+		w.Header().Set("ETag", fmt.Sprint(*response.Headers.ETag))
+	}
+	w.WriteHeader(200)
+	_, err := buf.WriteTo(w)
+	return err
+}
+
+type PostPullRequestMerge404JSONResponse ErrorResponse
+
+func (response PostPullRequestMerge404JSONResponse) VisitPostPullRequestMergeResponse(w http.ResponseWriter) error {
+
+	var buf bytes.Buffer
+	if err := json.NewEncoder(&buf).Encode(response); err != nil {
+		return err
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+	_, err := buf.WriteTo(w)
+	return err
+}
+
+type PostPullRequestMerge412JSONResponse ErrorResponse
+
+func (response PostPullRequestMerge412JSONResponse) VisitPostPullRequestMergeResponse(w http.ResponseWriter) error {
+
+	var buf bytes.Buffer
+	if err := json.NewEncoder(&buf).Encode(response); err != nil {
+		return err
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(412)
+	_, err := buf.WriteTo(w)
+	return err
+}
+
+type GetPullRequestOverdueRequestObject struct {
+	Params GetPullRequestOverdueParams
+}
+
+type GetPullRequestOverdueResponseObject interface {
+	VisitGetPullRequestOverdueResponse(w http.ResponseWriter) error
+}
+
+type GetPullRequestOverdue200JSONResponse struct {
+	Reviews []OverdueReview `json:"reviews"`
+}
+
+func (response GetPullRequestOverdue200JSONResponse) VisitGetPullRequestOverdueResponse(w http.ResponseWriter) error {
+
+	var buf bytes.Buffer
+	if err := json.NewEncoder(&buf).Encode(response); err != nil {
+		return err
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+	_, err := buf.WriteTo(w)
+	return err
+}
+
+type PostPullRequestReassignRequestObject struct {
+	Params PostPullRequestReassignParams
+	Body   *PostPullRequestReassignJSONRequestBody
+}
+
+type PostPullRequestReassignResponseObject interface {
+	VisitPostPullRequestReassignResponse(w http.ResponseWriter) error
+}
+
+type PostPullRequestReassign200ResponseHeaders struct {
+	ETag *string
+}
+
+type PostPullRequestReassign200JSONResponse struct {
+	Body struct {
+		Pr PullRequest `json:"pr"`
+
+		// ReplacedBy user_id нового ревьювера
+		ReplacedBy string `json:"replaced_by"`
+	}
+	Headers PostPullRequestReassign200ResponseHeaders
+}
+
+func (response PostPullRequestReassign200JSONResponse) VisitPostPullRequestReassignResponse(w http.ResponseWriter) error {
+
+	var buf bytes.Buffer
+	if err := json.NewEncoder(&buf).Encode(response.Body); err != nil {
+		return err
+	}
+	w.Header().Set("Content-Type", "application/json")
+	if response.Headers.ETag != nil {
+		w.Header().Set("ETag", fmt.Sprint(*response.Headers.ETag))
+	}
+	w.WriteHeader(200)
+	_, err := buf.WriteTo(w)
+	return err
+}
+
+type PostPullRequestReassign404JSONResponse ErrorResponse
+
+func (response PostPullRequestReassign404JSONResponse) VisitPostPullRequestReassignResponse(w http.ResponseWriter) error {
+
+	var buf bytes.Buffer
+	if err := json.NewEncoder(&buf).Encode(response); err != nil {
+		return err
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+	_, err := buf.WriteTo(w)
+	return err
+}
+
+type PostPullRequestReassign409JSONResponse ErrorResponse
+
+func (response PostPullRequestReassign409JSONResponse) VisitPostPullRequestReassignResponse(w http.ResponseWriter) error {
+
+	var buf bytes.Buffer
+	if err := json.NewEncoder(&buf).Encode(response); err != nil {
+		return err
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(409)
+	_, err := buf.WriteTo(w)
+	return err
+}
+
+type PostPullRequestReassign412JSONResponse ErrorResponse
+
+func (response PostPullRequestReassign412JSONResponse) VisitPostPullRequestReassignResponse(w http.ResponseWriter) error {
+
+	var buf bytes.Buffer
+	if err := json.NewEncoder(&buf).Encode(response); err != nil {
+		return err
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(412)
+	_, err := buf.WriteTo(w)
+	return err
+}
+
+type GetStatisticFairnessRequestObject struct {
+	Params GetStatisticFairnessParams
+}
+
+type GetStatisticFairnessResponseObject interface {
+	VisitGetStatisticFairnessResponse(w http.ResponseWriter) error
+}
+
+type GetStatisticFairness200JSONResponse struct {
+	Teams []TeamFairness `json:"teams"`
+}
+
+func (response GetStatisticFairness200JSONResponse) VisitGetStatisticFairnessResponse(w http.ResponseWriter) error {
+
+	var buf bytes.Buffer
+	if err := json.NewEncoder(&buf).Encode(response); err != nil {
+		return err
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+	_, err := buf.WriteTo(w)
+	return err
+}
+
+type GetStatisticFairness400JSONResponse ErrorResponse
+
+func (response GetStatisticFairness400JSONResponse) VisitGetStatisticFairnessResponse(w http.ResponseWriter) error {
+
+	var buf bytes.Buffer
+	if err := json.NewEncoder(&buf).Encode(response); err != nil {
+		return err
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+	_, err := buf.WriteTo(w)
+	return err
+}
+
+type GetStatisticPullRequestsRequestObject struct {
+	Params GetStatisticPullRequestsParams
+}
+
+type GetStatisticPullRequestsResponseObject interface {
+	VisitGetStatisticPullRequestsResponse(w http.ResponseWriter) error
+}
+
+type GetStatisticPullRequests200JSONResponse PullRequestStat
+
+func (response GetStatisticPullRequests200JSONResponse) VisitGetStatisticPullRequestsResponse(w http.ResponseWriter) error {
+
+	var buf bytes.Buffer
+	if err := json.NewEncoder(&buf).Encode(response); err != nil {
+		return err
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+	_, err := buf.WriteTo(w)
+	return err
+}
+
+type GetStatisticPullRequests400JSONResponse ErrorResponse
+
+func (response GetStatisticPullRequests400JSONResponse) VisitGetStatisticPullRequestsResponse(w http.ResponseWriter) error {
+
+	var buf bytes.Buffer
+	if err := json.NewEncoder(&buf).Encode(response); err != nil {
+		return err
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+	_, err := buf.WriteTo(w)
+	return err
+}
+
+type GetStatisticReviewsRequestObject struct {
+	Params GetStatisticReviewsParams
+}
+
+type GetStatisticReviewsResponseObject interface {
+	VisitGetStatisticReviewsResponse(w http.ResponseWriter) error
+}
+
+type GetStatisticReviews200JSONResponse struct {
+	ReviewStat []ReviewStat `json:"review_stat"`
+}
+
+func (response GetStatisticReviews200JSONResponse) VisitGetStatisticReviewsResponse(w http.ResponseWriter) error {
+
+	var buf bytes.Buffer
+	if err := json.NewEncoder(&buf).Encode(response); err != nil {
+		return err
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+	_, err := buf.WriteTo(w)
+	return err
+}
+
+type GetStatisticReviews400JSONResponse ErrorResponse
+
+func (response GetStatisticReviews400JSONResponse) VisitGetStatisticReviewsResponse(w http.ResponseWriter) error {
+
+	var buf bytes.Buffer
+	if err := json.NewEncoder(&buf).Encode(response); err != nil {
+		return err
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+	_, err := buf.WriteTo(w)
+	return err
+}
+
+type GetStatisticTeamsRequestObject struct {
+	Params GetStatisticTeamsParams
+}
+
+type GetStatisticTeamsResponseObject interface {
+	VisitGetStatisticTeamsResponse(w http.ResponseWriter) error
+}
+
+type GetStatisticTeams200JSONResponse struct {
+	TeamStat []TeamStat `json:"team_stat"`
+}
+
+func (response GetStatisticTeams200JSONResponse) VisitGetStatisticTeamsResponse(w http.ResponseWriter) error {
+
+	var buf bytes.Buffer
+	if err := json.NewEncoder(&buf).Encode(response); err != nil {
+		return err
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+	_, err := buf.WriteTo(w)
+	return err
+}
+
+type GetStatisticTeams400JSONResponse ErrorResponse
+
+func (response GetStatisticTeams400JSONResponse) VisitGetStatisticTeamsResponse(w http.ResponseWriter) error {
+
+	var buf bytes.Buffer
+	if err := json.NewEncoder(&buf).Encode(response); err != nil {
+		return err
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+	_, err := buf.WriteTo(w)
+	return err
+}
+
+type PostTeamAddRequestObject struct {
+	Body *PostTeamAddJSONRequestBody
+}
+
+type PostTeamAddResponseObject interface {
+	VisitPostTeamAddResponse(w http.ResponseWriter) error
+}
+
+type PostTeamAdd201JSONResponse struct {
+	Team Team `json:"team"`
+}
+
+func (response PostTeamAdd201JSONResponse) VisitPostTeamAddResponse(w http.ResponseWriter) error {
+
+	var buf bytes.Buffer
+	if err := json.NewEncoder(&buf).Encode(response); err != nil {
+		return err
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(201)
+	_, err := buf.WriteTo(w)
+	return err
+}
+
+type PostTeamAdd400JSONResponse ErrorResponse
+
+func (response PostTeamAdd400JSONResponse) VisitPostTeamAddResponse(w http.ResponseWriter) error {
+
+	var buf bytes.Buffer
+	if err := json.NewEncoder(&buf).Encode(response); err != nil {
+		return err
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+	_, err := buf.WriteTo(w)
+	return err
+}
+
+type PostTeamDeactivateRequestObject struct {
+	Body *PostTeamDeactivateJSONRequestBody
+}
+
+type PostTeamDeactivateResponseObject interface {
+	VisitPostTeamDeactivateResponse(w http.ResponseWriter) error
+}
+
+type PostTeamDeactivate200JSONResponse struct {
+	DeactivateCount int    `json:"deactivate_count"`
+	TeamName        string `json:"team_name"`
+}
+
+func (response PostTeamDeactivate200JSONResponse) VisitPostTeamDeactivateResponse(w http.ResponseWriter) error {
+
+	var buf bytes.Buffer
+	if err := json.NewEncoder(&buf).Encode(response); err != nil {
+		return err
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+	_, err := buf.WriteTo(w)
+	return err
+}
+
+type PostTeamDeactivate400JSONResponse ErrorResponse
+
+func (response PostTeamDeactivate400JSONResponse) VisitPostTeamDeactivateResponse(w http.ResponseWriter) error {
+
+	var buf bytes.Buffer
+	if err := json.NewEncoder(&buf).Encode(response); err != nil {
+		return err
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+	_, err := buf.WriteTo(w)
+	return err
+}
+
+type PostTeamDeactivate403JSONResponse ErrorResponse
+
+func (response PostTeamDeactivate403JSONResponse) VisitPostTeamDeactivateResponse(w http.ResponseWriter) error {
+
+	var buf bytes.Buffer
+	if err := json.NewEncoder(&buf).Encode(response); err != nil {
+		return err
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+	_, err := buf.WriteTo(w)
+	return err
+}
+
+type PostTeamDeactivate404JSONResponse ErrorResponse
+
+func (response PostTeamDeactivate404JSONResponse) VisitPostTeamDeactivateResponse(w http.ResponseWriter) error {
+
+	var buf bytes.Buffer
+	if err := json.NewEncoder(&buf).Encode(response); err != nil {
+		return err
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+	_, err := buf.WriteTo(w)
+	return err
+}
+
+type GetTeamGetRequestObject struct {
+	Params GetTeamGetParams
+}
+
+type GetTeamGetResponseObject interface {
+	VisitGetTeamGetResponse(w http.ResponseWriter) error
+}
+
+type GetTeamGet200JSONResponse Team
+
+func (response GetTeamGet200JSONResponse) VisitGetTeamGetResponse(w http.ResponseWriter) error {
+
+	var buf bytes.Buffer
+	if err := json.NewEncoder(&buf).Encode(response); err != nil {
+		return err
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+	_, err := buf.WriteTo(w)
+	return err
+}
+
+type GetTeamGet404JSONResponse ErrorResponse
+
+func (response GetTeamGet404JSONResponse) VisitGetTeamGetResponse(w http.ResponseWriter) error {
+
+	var buf bytes.Buffer
+	if err := json.NewEncoder(&buf).Encode(response); err != nil {
+		return err
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+	_, err := buf.WriteTo(w)
+	return err
+}
+
+type PostTeamImportRequestObject struct {
+	Params      PostTeamImportParams
+	ContentType string
+	Body        io.Reader
+}
+
+type PostTeamImportResponseObject interface {
+	VisitPostTeamImportResponse(w http.ResponseWriter) error
+}
+
+type PostTeamImport200JSONResponse ImportSummary
+
+func (response PostTeamImport200JSONResponse) VisitPostTeamImportResponse(w http.ResponseWriter) error {
+
+	var buf bytes.Buffer
+	if err := json.NewEncoder(&buf).Encode(response); err != nil {
+		return err
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+	_, err := buf.WriteTo(w)
+	return err
+}
+
+type PostTeamImport400JSONResponse ErrorResponse
+
+func (response PostTeamImport400JSONResponse) VisitPostTeamImportResponse(w http.ResponseWriter) error {
+
+	var buf bytes.Buffer
+	if err := json.NewEncoder(&buf).Encode(response); err != nil {
+		return err
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+	_, err := buf.WriteTo(w)
+	return err
+}
+
+type PostTeamImport403JSONResponse ErrorResponse
+
+func (response PostTeamImport403JSONResponse) VisitPostTeamImportResponse(w http.ResponseWriter) error {
+
+	var buf bytes.Buffer
+	if err := json.NewEncoder(&buf).Encode(response); err != nil {
+		return err
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+	_, err := buf.WriteTo(w)
+	return err
+}
+
+type PostTeamRebalanceRequestObject struct {
+	Body *PostTeamRebalanceJSONRequestBody
+}
+
+type PostTeamRebalanceResponseObject interface {
+	VisitPostTeamRebalanceResponse(w http.ResponseWriter) error
+}
+
+type PostTeamRebalance200JSONResponse struct {
+	DryRun   bool            `json:"dry_run"`
+	Moves    []RebalanceMove `json:"moves"`
+	TeamName string          `json:"team_name"`
+}
+
+func (response PostTeamRebalance200JSONResponse) VisitPostTeamRebalanceResponse(w http.ResponseWriter) error {
+
+	var buf bytes.Buffer
+	if err := json.NewEncoder(&buf).Encode(response); err != nil {
+		return err
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+	_, err := buf.WriteTo(w)
+	return err
+}
+
+type PostTeamRebalance400JSONResponse ErrorResponse
+
+func (response PostTeamRebalance400JSONResponse) VisitPostTeamRebalanceResponse(w http.ResponseWriter) error {
+
+	var buf bytes.Buffer
+	if err := json.NewEncoder(&buf).Encode(response); err != nil {
+		return err
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+	_, err := buf.WriteTo(w)
+	return err
+}
+
+type PostTeamRebalance403JSONResponse ErrorResponse
+
+func (response PostTeamRebalance403JSONResponse) VisitPostTeamRebalanceResponse(w http.ResponseWriter) error {
+
+	var buf bytes.Buffer
+	if err := json.NewEncoder(&buf).Encode(response); err != nil {
+		return err
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+	_, err := buf.WriteTo(w)
+	return err
+}
+
+type PostTeamRebalance404JSONResponse ErrorResponse
+
+func (response PostTeamRebalance404JSONResponse) VisitPostTeamRebalanceResponse(w http.ResponseWriter) error {
+
+	var buf bytes.Buffer
+	if err := json.NewEncoder(&buf).Encode(response); err != nil {
+		return err
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+	_, err := buf.WriteTo(w)
+	return err
+}
+
+type GetUsersGetReviewRequestObject struct {
+	Params GetUsersGetReviewParams
+}
+
+type GetUsersGetReviewResponseObject interface {
+	VisitGetUsersGetReviewResponse(w http.ResponseWriter) error
+}
+
+type GetUsersGetReview200JSONResponse struct {
+	NextCursor   *string            `json:"next_cursor,omitempty"`
+	PullRequests []PullRequestShort `json:"pull_requests"`
+	UserId       string             `json:"user_id"`
+}
+
+func (response GetUsersGetReview200JSONResponse) VisitGetUsersGetReviewResponse(w http.ResponseWriter) error {
+
+	var buf bytes.Buffer
+	if err := json.NewEncoder(&buf).Encode(response); err != nil {
+		return err
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+	_, err := buf.WriteTo(w)
+	return err
+}
+
+type PostUsersSetIsActiveRequestObject struct {
+	Body *PostUsersSetIsActiveJSONRequestBody
+}
+
+type PostUsersSetIsActiveResponseObject interface {
+	VisitPostUsersSetIsActiveResponse(w http.ResponseWriter) error
+}
+
+type PostUsersSetIsActive200JSONResponse struct {
+	User User `json:"user"`
+}
+
+func (response PostUsersSetIsActive200JSONResponse) VisitPostUsersSetIsActiveResponse(w http.ResponseWriter) error {
+
+	var buf bytes.Buffer
+	if err := json.NewEncoder(&buf).Encode(response); err != nil {
+		return err
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+	_, err := buf.WriteTo(w)
+	return err
+}
+
+type PostUsersSetIsActive404JSONResponse ErrorResponse
+
+func (response PostUsersSetIsActive404JSONResponse) VisitPostUsersSetIsActiveResponse(w http.ResponseWriter) error {
+
+	var buf bytes.Buffer
+	if err := json.NewEncoder(&buf).Encode(response); err != nil {
+		return err
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+	_, err := buf.WriteTo(w)
+	return err
+}
+
+type PostWebhooksAddRequestObject struct {
+	Body *PostWebhooksAddJSONRequestBody
+}
+
+type PostWebhooksAddResponseObject interface {
+	VisitPostWebhooksAddResponse(w http.ResponseWriter) error
+}
+
+type PostWebhooksAdd201JSONResponse struct {
+	Webhook Webhook `json:"webhook"`
+}
+
+func (response PostWebhooksAdd201JSONResponse) VisitPostWebhooksAddResponse(w http.ResponseWriter) error {
+
+	var buf bytes.Buffer
+	if err := json.NewEncoder(&buf).Encode(response); err != nil {
+		return err
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(201)
+	_, err := buf.WriteTo(w)
+	return err
+}
+
+type PostWebhooksAdd400JSONResponse ErrorResponse
+
+func (response PostWebhooksAdd400JSONResponse) VisitPostWebhooksAddResponse(w http.ResponseWriter) error {
+
+	var buf bytes.Buffer
+	if err := json.NewEncoder(&buf).Encode(response); err != nil {
+		return err
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+	_, err := buf.WriteTo(w)
+	return err
+}
+
+type GetWebhooksDeadLettersRequestObject struct {
+	Params GetWebhooksDeadLettersParams
+}
+
+type GetWebhooksDeadLettersResponseObject interface {
+	VisitGetWebhooksDeadLettersResponse(w http.ResponseWriter) error
+}
+
+type GetWebhooksDeadLetters200JSONResponse struct {
+	Deliveries []DeadDelivery `json:"deliveries"`
+}
+
+func (response GetWebhooksDeadLetters200JSONResponse) VisitGetWebhooksDeadLettersResponse(w http.ResponseWriter) error {
+
+	var buf bytes.Buffer
+	if err := json.NewEncoder(&buf).Encode(response); err != nil {
+		return err
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+	_, err := buf.WriteTo(w)
+	return err
+}
+
+type GetWebhooksDeadLetters400JSONResponse ErrorResponse
+
+func (response GetWebhooksDeadLetters400JSONResponse) VisitGetWebhooksDeadLettersResponse(w http.ResponseWriter) error {
+
+	var buf bytes.Buffer
+	if err := json.NewEncoder(&buf).Encode(response); err != nil {
+		return err
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+	_, err := buf.WriteTo(w)
+	return err
+}
+
+type PostWebhooksDeleteRequestObject struct {
+	Body *PostWebhooksDeleteJSONRequestBody
+}
+
+type PostWebhooksDeleteResponseObject interface {
+	VisitPostWebhooksDeleteResponse(w http.ResponseWriter) error
+}
+
+type PostWebhooksDelete200JSONResponse struct {
+	IsActive  bool  `json:"is_active"`
+	WebhookId int64 `json:"webhook_id"`
+}
+
+func (response PostWebhooksDelete200JSONResponse) VisitPostWebhooksDeleteResponse(w http.ResponseWriter) error {
+
+	var buf bytes.Buffer
+	if err := json.NewEncoder(&buf).Encode(response); err != nil {
+		return err
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+	_, err := buf.WriteTo(w)
+	return err
+}
+
+type PostWebhooksDelete400JSONResponse ErrorResponse
+
+func (response PostWebhooksDelete400JSONResponse) VisitPostWebhooksDeleteResponse(w http.ResponseWriter) error {
+
+	var buf bytes.Buffer
+	if err := json.NewEncoder(&buf).Encode(response); err != nil {
+		return err
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+	_, err := buf.WriteTo(w)
+	return err
+}
+
+type PostWebhooksDelete404JSONResponse ErrorResponse
+
+func (response PostWebhooksDelete404JSONResponse) VisitPostWebhooksDeleteResponse(w http.ResponseWriter) error {
+
+	var buf bytes.Buffer
+	if err := json.NewEncoder(&buf).Encode(response); err != nil {
+		return err
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+	_, err := buf.WriteTo(w)
+	return err
+}
+
+type GetWebhooksListRequestObject struct {
+}
+
+type GetWebhooksListResponseObject interface {
+	VisitGetWebhooksListResponse(w http.ResponseWriter) error
+}
+
+type GetWebhooksList200JSONResponse struct {
+	Webhooks []Webhook `json:"webhooks"`
+}
+
+func (response GetWebhooksList200JSONResponse) VisitGetWebhooksListResponse(w http.ResponseWriter) error {
+
+	var buf bytes.Buffer
+	if err := json.NewEncoder(&buf).Encode(response); err != nil {
+		return err
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+	_, err := buf.WriteTo(w)
+	return err
+}
+
+type PostWebhooksRetryRequestObject struct {
+	Body *PostWebhooksRetryJSONRequestBody
+}
+
+type PostWebhooksRetryResponseObject interface {
+	VisitPostWebhooksRetryResponse(w http.ResponseWriter) error
+}
+
+type PostWebhooksRetry200JSONResponse struct {
+	DeliveryId int64                                      `json:"delivery_id"`
+	Status     PostWebhooksRetry200JSONResponseBodyStatus `json:"status"`
+}
+
+func (response PostWebhooksRetry200JSONResponse) VisitPostWebhooksRetryResponse(w http.ResponseWriter) error {
+
+	var buf bytes.Buffer
+	if err := json.NewEncoder(&buf).Encode(response); err != nil {
+		return err
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+	_, err := buf.WriteTo(w)
+	return err
+}
+
+type PostWebhooksRetry400JSONResponse ErrorResponse
+
+func (response PostWebhooksRetry400JSONResponse) VisitPostWebhooksRetryResponse(w http.ResponseWriter) error {
+
+	var buf bytes.Buffer
+	if err := json.NewEncoder(&buf).Encode(response); err != nil {
+		return err
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+	_, err := buf.WriteTo(w)
+	return err
+}
+
+type PostWebhooksRetry404JSONResponse ErrorResponse
+
+func (response PostWebhooksRetry404JSONResponse) VisitPostWebhooksRetryResponse(w http.ResponseWriter) error {
+
+	var buf bytes.Buffer
+	if err := json.NewEncoder(&buf).Encode(response); err != nil {
+		return err
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+	_, err := buf.WriteTo(w)
+	return err
+}
+
+// StrictServerInterface represents all server handlers.
+type StrictServerInterface interface {
+	// Резервная копия всех данных сервиса
+	// (GET /admin/backup)
+	GetAdminBackup(ctx context.Context, request GetAdminBackupRequestObject) (GetAdminBackupResponseObject, error)
+	// Восстановить данные из резервной копии (текущие данные заменяются)
+	// (POST /admin/restore)
+	PostAdminRestore(ctx context.Context, request PostAdminRestoreRequestObject) (PostAdminRestoreResponseObject, error)
+	// Журнал изменений
+	// (GET /audit)
+	GetAudit(ctx context.Context, request GetAuditRequestObject) (GetAuditResponseObject, error)
+	// Выпустить API токен (секрет возвращается только в этом ответе)
+	// (POST /auth/tokens/create)
+	PostAuthTokensCreate(ctx context.Context, request PostAuthTokensCreateRequestObject) (PostAuthTokensCreateResponseObject, error)
+	// Действующие API токены
+	// (GET /auth/tokens/list)
+	GetAuthTokensList(ctx context.Context, request GetAuthTokensListRequestObject) (GetAuthTokensListResponseObject, error)
+	// Отозвать API токен
+	// (POST /auth/tokens/revoke)
+	PostAuthTokensRevoke(ctx context.Context, request PostAuthTokensRevokeRequestObject) (PostAuthTokensRevokeResponseObject, error)
+	// Выгрузка истории назначений reviewer из аудита (CSV или NDJSON)
+	// (GET /export/assignments)
+	GetExportAssignments(ctx context.Context, request GetExportAssignmentsRequestObject) (GetExportAssignmentsResponseObject, error)
+	// Выгрузка PR (CSV или NDJSON)
+	// (GET /export/pullRequests)
+	GetExportPullRequests(ctx context.Context, request GetExportPullRequestsRequestObject) (GetExportPullRequestsResponseObject, error)
+	// Выгрузка назначений по пользователям (CSV или NDJSON)
+	// (GET /export/reviews)
+	GetExportReviews(ctx context.Context, request GetExportReviewsRequestObject) (GetExportReviewsResponseObject, error)
+	// Создать PR и автоматически назначить до 2 ревьюверов из команды автора
+	// (POST /pullRequest/create)
+	PostPullRequestCreate(ctx context.Context, request PostPullRequestCreateRequestObject) (PostPullRequestCreateResponseObject, error)
+	// Отказ reviewer от ревью с автоматическим подбором замены
+	// (POST /pullRequest/decline)
+	PostPullRequestDecline(ctx context.Context, request PostPullRequestDeclineRequestObject) (PostPullRequestDeclineResponseObject, error)
+	// История отказов от ревью PR
+	// (GET /pullRequest/declines)
+	GetPullRequestDeclines(ctx context.Context, request GetPullRequestDeclinesRequestObject) (GetPullRequestDeclinesResponseObject, error)
+	// PR с reviewer и хронологией изменений
+	// (GET /pullRequest/get)
+	GetPullRequestGet(ctx context.Context, request GetPullRequestGetRequestObject) (GetPullRequestGetResponseObject, error)
+	// Список PR по фильтрам с постраничной выдачей
+	// (GET /pullRequest/list)
+	GetPullRequestList(ctx context.Context, request GetPullRequestListRequestObject) (GetPullRequestListResponseObject, error)
+	// Пометить PR как MERGED (идемпотентная операция)
+	// (POST /pullRequest/merge)
+	PostPullRequestMerge(ctx context.Context, request PostPullRequestMergeRequestObject) (PostPullRequestMergeResponseObject, error)
+	// Ревью с истёкшим SLA
+	// (GET /pullRequest/overdue)
+	GetPullRequestOverdue(ctx context.Context, request GetPullRequestOverdueRequestObject) (GetPullRequestOverdueResponseObject, error)
+	// Переназначить конкретного ревьювера на другого из его команды
+	// (POST /pullRequest/reassign)
+	PostPullRequestReassign(ctx context.Context, request PostPullRequestReassignRequestObject) (PostPullRequestReassignResponseObject, error)
+	// Равномерность нагрузки на ревью внутри команд
+	// (GET /statistic/fairness)
+	GetStatisticFairness(ctx context.Context, request GetStatisticFairnessRequestObject) (GetStatisticFairnessResponseObject, error)
+	// Сводка по PR и время до merge
+	// (GET /statistic/pullRequests)
+	GetStatisticPullRequests(ctx context.Context, request GetStatisticPullRequestsRequestObject) (GetStatisticPullRequestsResponseObject, error)
+	// Число назначений на ревью по пользователям в окне [from, to)
+	// (GET /statistic/reviews)
+	GetStatisticReviews(ctx context.Context, request GetStatisticReviewsRequestObject) (GetStatisticReviewsResponseObject, error)
+	// Сводка по командам
+	// (GET /statistic/teams)
+	GetStatisticTeams(ctx context.Context, request GetStatisticTeamsRequestObject) (GetStatisticTeamsResponseObject, error)
+	// Создать команду с участниками (создаёт/обновляет пользователей)
+	// (POST /team/add)
+	PostTeamAdd(ctx context.Context, request PostTeamAddRequestObject) (PostTeamAddResponseObject, error)
+	// Деактивировать всех участников команды и снять их с ревью открытых PR
+	// (POST /team/deactivate)
+	PostTeamDeactivate(ctx context.Context, request PostTeamDeactivateRequestObject) (PostTeamDeactivateResponseObject, error)
+	// Получить команду с участниками
+	// (GET /team/get)
+	GetTeamGet(ctx context.Context, request GetTeamGetRequestObject) (GetTeamGetResponseObject, error)
+	// Импорт состава команд из YAML или CSV (dry_run - только итог без применения)
+	// (POST /team/import)
+	PostTeamImport(ctx context.Context, request PostTeamImportRequestObject) (PostTeamImportResponseObject, error)
+	// Выровнять нагрузку на ревью внутри команды (dry_run - только план)
+	// (POST /team/rebalance)
+	PostTeamRebalance(ctx context.Context, request PostTeamRebalanceRequestObject) (PostTeamRebalanceResponseObject, error)
+	// Получить PR'ы, где пользователь назначен ревьювером
+	// (GET /users/getReview)
+	GetUsersGetReview(ctx context.Context, request GetUsersGetReviewRequestObject) (GetUsersGetReviewResponseObject, error)
+	// Установить флаг активности пользователя
+	// (POST /users/setIsActive)
+	PostUsersSetIsActive(ctx context.Context, request PostUsersSetIsActiveRequestObject) (PostUsersSetIsActiveResponseObject, error)
+	// Зарегистрировать webhook
+	// (POST /webhooks/add)
+	PostWebhooksAdd(ctx context.Context, request PostWebhooksAddRequestObject) (PostWebhooksAddResponseObject, error)
+	// Доставки, исчерпавшие попытки
+	// (GET /webhooks/deadLetters)
+	GetWebhooksDeadLetters(ctx context.Context, request GetWebhooksDeadLettersRequestObject) (GetWebhooksDeadLettersResponseObject, error)
+	// Отключить webhook
+	// (POST /webhooks/delete)
+	PostWebhooksDelete(ctx context.Context, request PostWebhooksDeleteRequestObject) (PostWebhooksDeleteResponseObject, error)
+	// Активные webhook
+	// (GET /webhooks/list)
+	GetWebhooksList(ctx context.Context, request GetWebhooksListRequestObject) (GetWebhooksListResponseObject, error)
+	// Повторить недоставленное событие
+	// (POST /webhooks/retry)
+	PostWebhooksRetry(ctx context.Context, request PostWebhooksRetryRequestObject) (PostWebhooksRetryResponseObject, error)
+}
+
+type StrictHandlerFunc func(ctx context.Context, w http.ResponseWriter, r *http.Request, request any) (any, error)
+type StrictMiddlewareFunc func(f StrictHandlerFunc, operationID string) StrictHandlerFunc
+
+type StrictHTTPServerOptions struct {
+	RequestErrorHandlerFunc  func(w http.ResponseWriter, r *http.Request, err error)
+	ResponseErrorHandlerFunc func(w http.ResponseWriter, r *http.Request, err error)
+}
+
+func NewStrictHandler(ssi StrictServerInterface, middlewares []StrictMiddlewareFunc) ServerInterface {
+	return &strictHandler{ssi: ssi, middlewares: middlewares, options: StrictHTTPServerOptions{
+		RequestErrorHandlerFunc: func(w http.ResponseWriter, r *http.Request, err error) {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+		},
+		ResponseErrorHandlerFunc: func(w http.ResponseWriter, r *http.Request, err error) {
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+		},
+	}}
+}
+
+func NewStrictHandlerWithOptions(ssi StrictServerInterface, middlewares []StrictMiddlewareFunc, options StrictHTTPServerOptions) ServerInterface {
+	return &strictHandler{ssi: ssi, middlewares: middlewares, options: options}
+}
+
+type strictHandler struct {
+	ssi         StrictServerInterface
+	middlewares []StrictMiddlewareFunc
+	options     StrictHTTPServerOptions
+}
+
+// GetAdminBackup operation middleware
+func (sh *strictHandler) GetAdminBackup(w http.ResponseWriter, r *http.Request) {
+	var request GetAdminBackupRequestObject
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetAdminBackup(ctx, request.(GetAdminBackupRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetAdminBackup")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetAdminBackupResponseObject); ok {
+		if err := validResponse.VisitGetAdminBackupResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// PostAdminRestore operation middleware
+func (sh *strictHandler) PostAdminRestore(w http.ResponseWriter, r *http.Request) {
+	var request PostAdminRestoreRequestObject
+
+	var body PostAdminRestoreJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.PostAdminRestore(ctx, request.(PostAdminRestoreRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PostAdminRestore")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(PostAdminRestoreResponseObject); ok {
+		if err := validResponse.VisitPostAdminRestoreResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// GetAudit operation middleware
+func (sh *strictHandler) GetAudit(w http.ResponseWriter, r *http.Request, params GetAuditParams) {
+	var request GetAuditRequestObject
+
+	request.Params = params
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetAudit(ctx, request.(GetAuditRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetAudit")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetAuditResponseObject); ok {
+		if err := validResponse.VisitGetAuditResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// PostAuthTokensCreate operation middleware
+func (sh *strictHandler) PostAuthTokensCreate(w http.ResponseWriter, r *http.Request) {
+	var request PostAuthTokensCreateRequestObject
+
+	var body PostAuthTokensCreateJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.PostAuthTokensCreate(ctx, request.(PostAuthTokensCreateRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PostAuthTokensCreate")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(PostAuthTokensCreateResponseObject); ok {
+		if err := validResponse.VisitPostAuthTokensCreateResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// GetAuthTokensList operation middleware
+func (sh *strictHandler) GetAuthTokensList(w http.ResponseWriter, r *http.Request) {
+	var request GetAuthTokensListRequestObject
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetAuthTokensList(ctx, request.(GetAuthTokensListRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetAuthTokensList")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetAuthTokensListResponseObject); ok {
+		if err := validResponse.VisitGetAuthTokensListResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// PostAuthTokensRevoke operation middleware
+func (sh *strictHandler) PostAuthTokensRevoke(w http.ResponseWriter, r *http.Request) {
+	var request PostAuthTokensRevokeRequestObject
+
+	var body PostAuthTokensRevokeJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.PostAuthTokensRevoke(ctx, request.(PostAuthTokensRevokeRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PostAuthTokensRevoke")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(PostAuthTokensRevokeResponseObject); ok {
+		if err := validResponse.VisitPostAuthTokensRevokeResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// GetExportAssignments operation middleware
+func (sh *strictHandler) GetExportAssignments(w http.ResponseWriter, r *http.Request, params GetExportAssignmentsParams) {
+	var request GetExportAssignmentsRequestObject
+
+	request.Params = params
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetExportAssignments(ctx, request.(GetExportAssignmentsRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetExportAssignments")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetExportAssignmentsResponseObject); ok {
+		if err := validResponse.VisitGetExportAssignmentsResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// GetExportPullRequests operation middleware
+func (sh *strictHandler) GetExportPullRequests(w http.ResponseWriter, r *http.Request, params GetExportPullRequestsParams) {
+	var request GetExportPullRequestsRequestObject
+
+	request.Params = params
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetExportPullRequests(ctx, request.(GetExportPullRequestsRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetExportPullRequests")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetExportPullRequestsResponseObject); ok {
+		if err := validResponse.VisitGetExportPullRequestsResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// GetExportReviews operation middleware
+func (sh *strictHandler) GetExportReviews(w http.ResponseWriter, r *http.Request, params GetExportReviewsParams) {
+	var request GetExportReviewsRequestObject
+
+	request.Params = params
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetExportReviews(ctx, request.(GetExportReviewsRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetExportReviews")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetExportReviewsResponseObject); ok {
+		if err := validResponse.VisitGetExportReviewsResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// PostPullRequestCreate operation middleware
+func (sh *strictHandler) PostPullRequestCreate(w http.ResponseWriter, r *http.Request) {
+	var request PostPullRequestCreateRequestObject
+
+	var body PostPullRequestCreateJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.PostPullRequestCreate(ctx, request.(PostPullRequestCreateRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PostPullRequestCreate")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(PostPullRequestCreateResponseObject); ok {
+		if err := validResponse.VisitPostPullRequestCreateResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// PostPullRequestDecline operation middleware
+func (sh *strictHandler) PostPullRequestDecline(w http.ResponseWriter, r *http.Request, params PostPullRequestDeclineParams) {
+	var request PostPullRequestDeclineRequestObject
+
+	request.Params = params
+
+	var body PostPullRequestDeclineJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.PostPullRequestDecline(ctx, request.(PostPullRequestDeclineRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PostPullRequestDecline")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(PostPullRequestDeclineResponseObject); ok {
+		if err := validResponse.VisitPostPullRequestDeclineResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// GetPullRequestDeclines operation middleware
+func (sh *strictHandler) GetPullRequestDeclines(w http.ResponseWriter, r *http.Request, params GetPullRequestDeclinesParams) {
+	var request GetPullRequestDeclinesRequestObject
+
+	request.Params = params
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetPullRequestDeclines(ctx, request.(GetPullRequestDeclinesRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetPullRequestDeclines")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetPullRequestDeclinesResponseObject); ok {
+		if err := validResponse.VisitGetPullRequestDeclinesResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// GetPullRequestGet operation middleware
+func (sh *strictHandler) GetPullRequestGet(w http.ResponseWriter, r *http.Request, params GetPullRequestGetParams) {
+	var request GetPullRequestGetRequestObject
+
+	request.Params = params
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetPullRequestGet(ctx, request.(GetPullRequestGetRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetPullRequestGet")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetPullRequestGetResponseObject); ok {
+		if err := validResponse.VisitGetPullRequestGetResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// GetPullRequestList operation middleware
+func (sh *strictHandler) GetPullRequestList(w http.ResponseWriter, r *http.Request, params GetPullRequestListParams) {
+	var request GetPullRequestListRequestObject
+
+	request.Params = params
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetPullRequestList(ctx, request.(GetPullRequestListRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetPullRequestList")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetPullRequestListResponseObject); ok {
+		if err := validResponse.VisitGetPullRequestListResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// PostPullRequestMerge operation middleware
+func (sh *strictHandler) PostPullRequestMerge(w http.ResponseWriter, r *http.Request, params PostPullRequestMergeParams) {
+	var request PostPullRequestMergeRequestObject
+
+	request.Params = params
+
+	var body PostPullRequestMergeJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.PostPullRequestMerge(ctx, request.(PostPullRequestMergeRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PostPullRequestMerge")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(PostPullRequestMergeResponseObject); ok {
+		if err := validResponse.VisitPostPullRequestMergeResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// GetPullRequestOverdue operation middleware
+func (sh *strictHandler) GetPullRequestOverdue(w http.ResponseWriter, r *http.Request, params GetPullRequestOverdueParams) {
+	var request GetPullRequestOverdueRequestObject
+
+	request.Params = params
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetPullRequestOverdue(ctx, request.(GetPullRequestOverdueRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetPullRequestOverdue")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetPullRequestOverdueResponseObject); ok {
+		if err := validResponse.VisitGetPullRequestOverdueResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// PostPullRequestReassign operation middleware
+func (sh *strictHandler) PostPullRequestReassign(w http.ResponseWriter, r *http.Request, params PostPullRequestReassignParams) {
+	var request PostPullRequestReassignRequestObject
+
+	request.Params = params
+
+	var body PostPullRequestReassignJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.PostPullRequestReassign(ctx, request.(PostPullRequestReassignRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PostPullRequestReassign")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(PostPullRequestReassignResponseObject); ok {
+		if err := validResponse.VisitPostPullRequestReassignResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// GetStatisticFairness operation middleware
+func (sh *strictHandler) GetStatisticFairness(w http.ResponseWriter, r *http.Request, params GetStatisticFairnessParams) {
+	var request GetStatisticFairnessRequestObject
+
+	request.Params = params
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetStatisticFairness(ctx, request.(GetStatisticFairnessRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetStatisticFairness")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetStatisticFairnessResponseObject); ok {
+		if err := validResponse.VisitGetStatisticFairnessResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// GetStatisticPullRequests operation middleware
+func (sh *strictHandler) GetStatisticPullRequests(w http.ResponseWriter, r *http.Request, params GetStatisticPullRequestsParams) {
+	var request GetStatisticPullRequestsRequestObject
+
+	request.Params = params
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetStatisticPullRequests(ctx, request.(GetStatisticPullRequestsRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetStatisticPullRequests")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetStatisticPullRequestsResponseObject); ok {
+		if err := validResponse.VisitGetStatisticPullRequestsResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// GetStatisticReviews operation middleware
+func (sh *strictHandler) GetStatisticReviews(w http.ResponseWriter, r *http.Request, params GetStatisticReviewsParams) {
+	var request GetStatisticReviewsRequestObject
+
+	request.Params = params
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetStatisticReviews(ctx, request.(GetStatisticReviewsRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetStatisticReviews")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetStatisticReviewsResponseObject); ok {
+		if err := validResponse.VisitGetStatisticReviewsResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// GetStatisticTeams operation middleware
+func (sh *strictHandler) GetStatisticTeams(w http.ResponseWriter, r *http.Request, params GetStatisticTeamsParams) {
+	var request GetStatisticTeamsRequestObject
+
+	request.Params = params
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetStatisticTeams(ctx, request.(GetStatisticTeamsRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetStatisticTeams")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetStatisticTeamsResponseObject); ok {
+		if err := validResponse.VisitGetStatisticTeamsResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// PostTeamAdd operation middleware
+func (sh *strictHandler) PostTeamAdd(w http.ResponseWriter, r *http.Request) {
+	var request PostTeamAddRequestObject
+
+	var body PostTeamAddJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.PostTeamAdd(ctx, request.(PostTeamAddRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PostTeamAdd")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(PostTeamAddResponseObject); ok {
+		if err := validResponse.VisitPostTeamAddResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// PostTeamDeactivate operation middleware
+func (sh *strictHandler) PostTeamDeactivate(w http.ResponseWriter, r *http.Request) {
+	var request PostTeamDeactivateRequestObject
+
+	var body PostTeamDeactivateJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.PostTeamDeactivate(ctx, request.(PostTeamDeactivateRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PostTeamDeactivate")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(PostTeamDeactivateResponseObject); ok {
+		if err := validResponse.VisitPostTeamDeactivateResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// GetTeamGet operation middleware
+func (sh *strictHandler) GetTeamGet(w http.ResponseWriter, r *http.Request, params GetTeamGetParams) {
+	var request GetTeamGetRequestObject
+
+	request.Params = params
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetTeamGet(ctx, request.(GetTeamGetRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetTeamGet")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetTeamGetResponseObject); ok {
+		if err := validResponse.VisitGetTeamGetResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// PostTeamImport operation middleware
+func (sh *strictHandler) PostTeamImport(w http.ResponseWriter, r *http.Request, params PostTeamImportParams) {
+	var request PostTeamImportRequestObject
+
+	request.Params = params
+	request.ContentType = r.Header.Get("Content-Type")
+
+	request.Body = r.Body
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.PostTeamImport(ctx, request.(PostTeamImportRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PostTeamImport")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(PostTeamImportResponseObject); ok {
+		if err := validResponse.VisitPostTeamImportResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// PostTeamRebalance operation middleware
+func (sh *strictHandler) PostTeamRebalance(w http.ResponseWriter, r *http.Request) {
+	var request PostTeamRebalanceRequestObject
+
+	var body PostTeamRebalanceJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.PostTeamRebalance(ctx, request.(PostTeamRebalanceRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PostTeamRebalance")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(PostTeamRebalanceResponseObject); ok {
+		if err := validResponse.VisitPostTeamRebalanceResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// GetUsersGetReview operation middleware
+func (sh *strictHandler) GetUsersGetReview(w http.ResponseWriter, r *http.Request, params GetUsersGetReviewParams) {
+	var request GetUsersGetReviewRequestObject
+
+	request.Params = params
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetUsersGetReview(ctx, request.(GetUsersGetReviewRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetUsersGetReview")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetUsersGetReviewResponseObject); ok {
+		if err := validResponse.VisitGetUsersGetReviewResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// PostUsersSetIsActive operation middleware
+func (sh *strictHandler) PostUsersSetIsActive(w http.ResponseWriter, r *http.Request) {
+	var request PostUsersSetIsActiveRequestObject
+
+	var body PostUsersSetIsActiveJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.PostUsersSetIsActive(ctx, request.(PostUsersSetIsActiveRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PostUsersSetIsActive")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(PostUsersSetIsActiveResponseObject); ok {
+		if err := validResponse.VisitPostUsersSetIsActiveResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// PostWebhooksAdd operation middleware
+func (sh *strictHandler) PostWebhooksAdd(w http.ResponseWriter, r *http.Request) {
+	var request PostWebhooksAddRequestObject
+
+	var body PostWebhooksAddJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.PostWebhooksAdd(ctx, request.(PostWebhooksAddRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PostWebhooksAdd")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(PostWebhooksAddResponseObject); ok {
+		if err := validResponse.VisitPostWebhooksAddResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// GetWebhooksDeadLetters operation middleware
+func (sh *strictHandler) GetWebhooksDeadLetters(w http.ResponseWriter, r *http.Request, params GetWebhooksDeadLettersParams) {
+	var request GetWebhooksDeadLettersRequestObject
+
+	request.Params = params
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetWebhooksDeadLetters(ctx, request.(GetWebhooksDeadLettersRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetWebhooksDeadLetters")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetWebhooksDeadLettersResponseObject); ok {
+		if err := validResponse.VisitGetWebhooksDeadLettersResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// PostWebhooksDelete operation middleware
+func (sh *strictHandler) PostWebhooksDelete(w http.ResponseWriter, r *http.Request) {
+	var request PostWebhooksDeleteRequestObject
+
+	var body PostWebhooksDeleteJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.PostWebhooksDelete(ctx, request.(PostWebhooksDeleteRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PostWebhooksDelete")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(PostWebhooksDeleteResponseObject); ok {
+		if err := validResponse.VisitPostWebhooksDeleteResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// GetWebhooksList operation middleware
+func (sh *strictHandler) GetWebhooksList(w http.ResponseWriter, r *http.Request) {
+	var request GetWebhooksListRequestObject
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetWebhooksList(ctx, request.(GetWebhooksListRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetWebhooksList")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetWebhooksListResponseObject); ok {
+		if err := validResponse.VisitGetWebhooksListResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// PostWebhooksRetry operation middleware
+func (sh *strictHandler) PostWebhooksRetry(w http.ResponseWriter, r *http.Request) {
+	var request PostWebhooksRetryRequestObject
+
+	var body PostWebhooksRetryJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.PostWebhooksRetry(ctx, request.(PostWebhooksRetryRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PostWebhooksRetry")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(PostWebhooksRetryResponseObject); ok {
+		if err := validResponse.VisitPostWebhooksRetryResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
@@ -0,0 +1,4 @@
+// Package api Типы и серверные заглушки, сгенерированные из task/openapi.yml
+package api
+
+//go:generate go tool oapi-codegen -config oapi-codegen.yaml ../../../task/openapi.yml
@@ -0,0 +1,46 @@
+package graphqlserver
+
+import "sync"
+
+// batch Данные для всех объектов одного уровня ответа (например, всех участников команды),
+// загружаемые одним запросом при первом обращении к любому из них
+type batch[V any] struct {
+	once   sync.Once
+	load   func() (map[string]V, error)
+	values map[string]V
+	err    error
+}
+
+func newBatch[V any](load func() (map[string]V, error)) *batch[V] {
+	return &batch[V]{load: load}
+}
+
+// get Значение для объекта уровня (false - данных для объекта нет)
+func (b *batch[V]) get(key string) (V, bool, error) {
+	b.once.Do(func() {
+		b.values, b.err = b.load()
+	})
+	value, ok := b.values[key]
+	return value, ok, b.err
+}
+
+// batches Загрузки уровня для разных аргументов поля (например, PR пользователей по статусам)
+type batches[A comparable, V any] struct {
+	mu     sync.Mutex
+	byArgs map[A]*batch[V]
+}
+
+// get Загрузка для аргументов, общая для всех объектов уровня
+func (b *batches[A, V]) get(args A, load func() (map[string]V, error)) *batch[V] {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	if b.byArgs == nil {
+		b.byArgs = make(map[A]*batch[V])
+	}
+	loader, ok := b.byArgs[args]
+	if !ok {
+		loader = newBatch(load)
+		b.byArgs[args] = loader
+	}
+	return loader
+}
@@ -0,0 +1,102 @@
+package graphqlserver
+
+import (
+	"encoding/json"
+	"fmt"
+	"math"
+	"strings"
+
+	"github.com/sudo-odner/Backend-trainee-assignment-avito-2025/internal/transport"
+	"github.com/vektah/gqlparser/v2"
+	"github.com/vektah/gqlparser/v2/ast"
+)
+
+const (
+	// defaultListSize Оценка размера списков без аргумента limit (участники команды, reviewer)
+	defaultListSize = 10
+	// maxCost Предел оценки стоимости (чтобы вложенные списки не переполняли счётчик)
+	maxCost = math.MaxInt32
+)
+
+// queryCost Глубина и стоимость операции: поле стоит 1, список умножает стоимость вложенных полей
+// на limit (или defaultListSize). Поля интроспекции не учитываются
+func queryCost(set ast.SelectionSet, vars map[string]any) (depth, complexity int) {
+	for _, selection := range set {
+		var childDepth, childComplexity int
+		switch selection := selection.(type) {
+		case *ast.Field:
+			if strings.HasPrefix(selection.Name, "__") {
+				continue
+			}
+			childDepth, childComplexity = queryCost(selection.SelectionSet, vars)
+			childDepth++
+			childComplexity = min(1+listSize(selection, vars)*childComplexity, maxCost)
+		case *ast.InlineFragment:
+			childDepth, childComplexity = queryCost(selection.SelectionSet, vars)
+		case *ast.FragmentSpread:
+			if selection.Definition != nil {
+				childDepth, childComplexity = queryCost(selection.Definition.SelectionSet, vars)
+			}
+		}
+		depth = max(depth, childDepth)
+		complexity = min(complexity+childComplexity, maxCost)
+	}
+	return depth, complexity
+}
+
+// listSize Множитель стоимости вложенных полей: 1 для объектов, limit (не больше допустимого) или defaultListSize для списков
+func listSize(field *ast.Field, vars map[string]any) int {
+	if field.Definition == nil || field.Definition.Type.Elem == nil {
+		return 1
+	}
+	if argument := field.Arguments.ForName("limit"); argument != nil {
+		if limit, ok := intValue(argument.Value, vars); ok {
+			return min(max(limit, 1), transport.MaxPRListLimit)
+		}
+	}
+	if argument := field.Definition.Arguments.ForName("limit"); argument != nil && argument.DefaultValue != nil {
+		if limit, ok := intValue(argument.DefaultValue, vars); ok {
+			return min(max(limit, 1), transport.MaxPRListLimit)
+		}
+	}
+	return defaultListSize
+}
+
+// intValue Целое значение аргумента (литерал или переменная)
+func intValue(value *ast.Value, vars map[string]any) (int, bool) {
+	raw, err := value.Value(vars)
+	if err != nil {
+		return 0, false
+	}
+	switch raw := raw.(type) {
+	case int64:
+		return int(raw), true
+	case float64:
+		return int(raw), true
+	case json.Number:
+		parsed, err := raw.Int64()
+		return int(parsed), err == nil
+	}
+	return 0, false
+}
+
+// checkLimits Проверка глубины и стоимости операции до выполнения
+func (s *Server) checkLimits(query, operationName string, vars map[string]any) error {
+	// Запрос, который не удалось оценить, не выполняется: иначе расхождение парсеров обходило бы ограничения
+	doc, errs := gqlparser.LoadQuery(s.limitSchema, query)
+	if len(errs) > 0 {
+		return fmt.Errorf("invalid query: %s", errs[0].Message)
+	}
+	operation := doc.Operations.ForName(operationName)
+	if operation == nil {
+		return fmt.Errorf("operation %q not found", operationName)
+	}
+	depth, complexity := queryCost(operation.SelectionSet, vars)
+	if s.cfg.GraphQL.MaxDepth > 0 && depth > s.cfg.GraphQL.MaxDepth {
+		return fmt.Errorf("query depth %d exceeds max depth %d", depth, s.cfg.GraphQL.MaxDepth)
+	}
+	if s.cfg.GraphQL.MaxComplexity > 0 && complexity > s.cfg.GraphQL.MaxComplexity {
+		return fmt.Errorf("query complexity %d exceeds max complexity %d", complexity, s.cfg.GraphQL.MaxComplexity)
+	}
+	return nil
+}
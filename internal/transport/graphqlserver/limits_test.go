@@ -0,0 +1,140 @@
+package graphqlserver
+
+import (
+	"testing"
+
+	"github.com/sudo-odner/Backend-trainee-assignment-avito-2025/internal/storage/storagetest"
+	"github.com/vektah/gqlparser/v2"
+)
+
+func TestQueryCost(t *testing.T) {
+	server := newTestServer(t, storagetest.New(), 0, 0)
+
+	tests := []struct {
+		name           string
+		query          string
+		vars           map[string]any
+		wantDepth      int
+		wantComplexity int
+	}{
+		{
+			name:           "object field",
+			query:          `{ team(teamName: "a") { teamName } }`,
+			wantDepth:      2,
+			wantComplexity: 2,
+		},
+		{
+			name:           "list without limit",
+			query:          `{ team(teamName: "a") { members { userId username } } }`,
+			wantDepth:      3,
+			wantComplexity: 1 + 1 + defaultListSize*2,
+		},
+		{
+			name:           "limit argument",
+			query:          `{ pullRequests(limit: 5) { pullRequestId } }`,
+			wantDepth:      2,
+			wantComplexity: 6,
+		},
+		{
+			name:           "limit default from schema",
+			query:          `{ pullRequests { pullRequestId } }`,
+			wantDepth:      2,
+			wantComplexity: 101,
+		},
+		{
+			name:           "limit variable",
+			query:          `query($n: Int) { pullRequests(limit: $n) { pullRequestId } }`,
+			vars:           map[string]any{"n": float64(50)},
+			wantDepth:      2,
+			wantComplexity: 51,
+		},
+		{
+			name:           "limit above maximum",
+			query:          `{ pullRequests(limit: 100000) { pullRequestId } }`,
+			wantDepth:      2,
+			wantComplexity: 1001,
+		},
+		{
+			name: "nested lists multiply",
+			query: `{ team(teamName: "a") { members { pullRequests(limit: 3) {
+				pullRequestId author { username }
+			} } } }`,
+			wantDepth:      5,
+			wantComplexity: 1 + 1 + defaultListSize*(1+3*(1+2)),
+		},
+		{
+			name: "fragments",
+			query: `{ user(userId: "u1") { ...names ... on User { isActive } } }
+				fragment names on User { userId username }`,
+			wantDepth:      2,
+			wantComplexity: 4,
+		},
+		{
+			name:           "introspection is free",
+			query:          `{ __typename team(teamName: "a") { __typename teamName } }`,
+			wantDepth:      2,
+			wantComplexity: 2,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			doc, errs := gqlparser.LoadQuery(server.limitSchema, tt.query)
+			if len(errs) > 0 {
+				t.Fatalf("LoadQuery() errors = %v", errs)
+			}
+			depth, complexity := queryCost(doc.Operations[0].SelectionSet, tt.vars)
+			if depth != tt.wantDepth || complexity != tt.wantComplexity {
+				t.Errorf("queryCost() = %d, %d, want %d, %d", depth, complexity, tt.wantDepth, tt.wantComplexity)
+			}
+		})
+	}
+}
+
+func TestCheckLimits(t *testing.T) {
+	query := `{ team(teamName: "a") { members { userId } } }`
+
+	tests := []struct {
+		name          string
+		maxDepth      int
+		maxComplexity int
+		wantErr       bool
+	}{
+		{name: "no limits"},
+		{name: "at limits", maxDepth: 3, maxComplexity: 12},
+		{name: "too deep", maxDepth: 2, wantErr: true},
+		{name: "too complex", maxComplexity: 11, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			server := newTestServer(t, storagetest.New(), tt.maxDepth, tt.maxComplexity)
+			if err := server.checkLimits(query, "", nil); (err != nil) != tt.wantErr {
+				t.Errorf("checkLimits() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+// TestCheckLimitsRejectsUnparsed Запрос, который проверка не смогла разобрать, отклоняется, а не выполняется без ограничений
+func TestCheckLimitsRejectsUnparsed(t *testing.T) {
+	server := newTestServer(t, storagetest.New(), 8, 5000)
+
+	tests := []struct {
+		name          string
+		query         string
+		operationName string
+	}{
+		{name: "syntax error", query: `{ team(teamName: "a") { members { userId } }`},
+		{name: "unknown field", query: `{ team(teamName: "a") { unknown } }`},
+		{name: "unknown operation", query: `query A { team(teamName: "a") { teamName } }`, operationName: "B"},
+		{name: "ambiguous operation", query: `query A { team(teamName: "a") { teamName } } query B { team(teamName: "a") { teamName } }`},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := server.checkLimits(tt.query, tt.operationName, nil); err == nil {
+				t.Error("checkLimits() error = nil, want error")
+			}
+		})
+	}
+}
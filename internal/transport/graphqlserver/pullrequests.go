@@ -0,0 +1,71 @@
+package graphqlserver
+
+import (
+	"github.com/graph-gophers/graphql-go"
+	"github.com/sudo-odner/Backend-trainee-assignment-avito-2025/internal/domain"
+)
+
+type pullRequestResolver struct {
+	pr        *domain.PullRequest
+	authors   *userLevel
+	reviewers *userLevel
+}
+
+// newPullRequestResolvers PR с общими уровнями авторов и reviewer всех PR
+func newPullRequestResolvers(storage Storage, prs []*domain.PullRequest) []*pullRequestResolver {
+	authorIDs, reviewerIDs := make([]string, 0), make([]string, 0)
+	authors, reviewers := make(map[string]*domain.User), make(map[string]*domain.User)
+	for _, pr := range prs {
+		if _, ok := authors[pr.Author.ID]; !ok {
+			authors[pr.Author.ID] = &pr.Author
+			authorIDs = append(authorIDs, pr.Author.ID)
+		}
+		for i := range pr.Reviewers {
+			reviewer := &pr.Reviewers[i]
+			if _, ok := reviewers[reviewer.ID]; !ok {
+				reviewers[reviewer.ID] = reviewer
+				reviewerIDs = append(reviewerIDs, reviewer.ID)
+			}
+		}
+	}
+	authorLevel := newUserLevel(storage, authorIDs, authors, nil)
+	reviewerLevel := newUserLevel(storage, reviewerIDs, reviewers, nil)
+
+	res := make([]*pullRequestResolver, 0, len(prs))
+	for _, pr := range prs {
+		res = append(res, &pullRequestResolver{pr: pr, authors: authorLevel, reviewers: reviewerLevel})
+	}
+	return res
+}
+
+func (r *pullRequestResolver) PullRequestID() string {
+	return r.pr.ID
+}
+
+func (r *pullRequestResolver) PullRequestName() string {
+	return r.pr.Name
+}
+
+func (r *pullRequestResolver) Status() string {
+	return r.pr.Status
+}
+
+func (r *pullRequestResolver) Author() *userResolver {
+	return &userResolver{id: r.pr.Author.ID, level: r.authors}
+}
+
+func (r *pullRequestResolver) Reviewers() []*userResolver {
+	res := make([]*userResolver, 0, len(r.pr.Reviewers))
+	for _, reviewer := range r.pr.Reviewers {
+		res = append(res, &userResolver{id: reviewer.ID, level: r.reviewers})
+	}
+	return res
+}
+
+func (r *pullRequestResolver) CreatedAt() *graphql.Time {
+	return optionalTime(r.pr.CreatedAt)
+}
+
+func (r *pullRequestResolver) MergedAt() *graphql.Time {
+	return optionalTime(r.pr.MergedAt)
+}
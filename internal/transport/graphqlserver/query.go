@@ -0,0 +1,156 @@
+package graphqlserver
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/graph-gophers/graphql-go"
+	"github.com/sudo-odner/Backend-trainee-assignment-avito-2025/internal/domain"
+	"github.com/sudo-odner/Backend-trainee-assignment-avito-2025/internal/storage"
+	"github.com/sudo-odner/Backend-trainee-assignment-avito-2025/internal/transport"
+)
+
+// resolver Поля Query
+type resolver struct {
+	storage Storage
+}
+
+// Team Команда с участниками (nil - команды нет)
+func (r *resolver) Team(args struct{ TeamName string }) (*teamResolver, error) {
+	team, err := r.storage.GetTeam(args.TeamName)
+	if errors.Is(err, storage.ErrTeamNotFound) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to get team: %w", err)
+	}
+	return newTeamResolvers(r.storage, []*domain.Team{team})[0], nil
+}
+
+// User Пользователь (nil - пользователя нет)
+func (r *resolver) User(args struct{ UserID string }) (*userResolver, error) {
+	user, err := r.storage.GetUserByID(args.UserID)
+	if errors.Is(err, storage.ErrUserNotFound) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed get user by id: %w", err)
+	}
+	level := newUserLevel(r.storage, []string{user.ID}, map[string]*domain.User{user.ID: user}, nil)
+	return level.resolvers()[0], nil
+}
+
+// PullRequest PR (nil - PR нет)
+func (r *resolver) PullRequest(args struct{ PullRequestID string }) (*pullRequestResolver, error) {
+	pr, err := r.storage.GetPRByID(args.PullRequestID)
+	if errors.Is(err, storage.ErrPRNotFound) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to get PR: %w", err)
+	}
+	return newPullRequestResolvers(r.storage, []*domain.PullRequest{pr})[0], nil
+}
+
+// PullRequests Список PR по фильтрам, новые первыми
+func (r *resolver) PullRequests(args struct {
+	AuthorID   *string
+	ReviewerID *string
+	TeamName   *string
+	Status     *string
+	Limit      int32
+}) ([]*pullRequestResolver, error) {
+	limit, err := prLimit(args.Limit)
+	if err != nil {
+		return nil, err
+	}
+	prs, _, err := r.storage.ListPRs(domain.PRFilter{
+		AuthorID:   deref(args.AuthorID),
+		ReviewerID: deref(args.ReviewerID),
+		TeamName:   deref(args.TeamName),
+		Status:     deref(args.Status),
+		Desc:       true,
+		Limit:      limit,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list PRs: %w", err)
+	}
+	return newPullRequestResolvers(r.storage, prs), nil
+}
+
+// ReviewStats Число назначений на ревью по пользователям
+func (r *resolver) ReviewStats(args struct {
+	From     *graphql.Time
+	To       *graphql.Time
+	TeamName *string
+	Status   *string
+}) ([]*reviewStatResolver, error) {
+	stat, err := r.storage.GetReviewStat(domain.StatFilter{
+		From:     localTime(args.From),
+		To:       localTime(args.To),
+		TeamName: deref(args.TeamName),
+		Status:   deref(args.Status),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed get review stat: %w", err)
+	}
+
+	ids := make([]string, 0, len(stat))
+	for _, reviewer := range stat {
+		ids = append(ids, reviewer.UserID)
+	}
+	users := newUserLevel(r.storage, ids, nil, nil)
+	res := make([]*reviewStatResolver, 0, len(stat))
+	for _, reviewer := range stat {
+		res = append(res, &reviewStatResolver{stat: reviewer, users: users})
+	}
+	return res, nil
+}
+
+// reviewStatResolver Число назначений пользователя
+type reviewStatResolver struct {
+	stat  domain.UserReviewStat
+	users *userLevel
+}
+
+func (r *reviewStatResolver) User() *userResolver {
+	return &userResolver{id: r.stat.UserID, level: r.users}
+}
+
+func (r *reviewStatResolver) ReviewCount() int32 {
+	return int32(r.stat.ReviewCount)
+}
+
+// prLimit Число PR в списке (1..MaxPRListLimit)
+func prLimit(limit int32) (int, error) {
+	if limit <= 0 || limit > transport.MaxPRListLimit {
+		return 0, errBadArgument("limit")
+	}
+	return int(limit), nil
+}
+
+// localTime Время из аргумента в локальной зоне сервиса (время в БД хранится без зоны), нулевое, если не задано
+func localTime(t *graphql.Time) time.Time {
+	if t == nil {
+		return time.Time{}
+	}
+	return t.Local()
+}
+
+// optionalTime Время или nil, если не задано
+func optionalTime(t time.Time) *graphql.Time {
+	if t.IsZero() {
+		return nil
+	}
+	return &graphql.Time{Time: t}
+}
+
+// deref Значение необязательного аргумента (нулевое, если не передан)
+func deref[T any](value *T) T {
+	if value == nil {
+		var zero T
+		return zero
+	}
+	return *value
+}
@@ -0,0 +1,167 @@
+// Package graphqlserver GraphQL API (/graphql) для вложенных запросов (команда → участники → их PR → авторы PR) за один запрос.
+// Связанные данные загружаются одним запросом на уровень ответа, глубина и стоимость запроса ограничены конфигом
+package graphqlserver
+
+import (
+	"context"
+	_ "embed"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log/slog"
+	"net/http"
+	"runtime/debug"
+
+	"github.com/go-chi/chi/v5/middleware"
+	"github.com/go-chi/render"
+	"github.com/graph-gophers/graphql-go"
+	gqlerrors "github.com/graph-gophers/graphql-go/errors"
+	"github.com/sudo-odner/Backend-trainee-assignment-avito-2025/internal/config"
+	"github.com/sudo-odner/Backend-trainee-assignment-avito-2025/internal/domain"
+	"github.com/sudo-odner/Backend-trainee-assignment-avito-2025/internal/transport"
+	"github.com/sudo-odner/Backend-trainee-assignment-avito-2025/pkg/logger/sl"
+	"github.com/vektah/gqlparser/v2"
+	"github.com/vektah/gqlparser/v2/ast"
+)
+
+//go:embed schema.graphql
+var schemaSDL string
+
+// maxRequestSize Ограничение размера тела запроса
+const maxRequestSize = 1 << 20
+
+// Storage Данные для resolver: запросы верхнего уровня и загрузка связанных данных сразу для уровня ответа
+type Storage interface {
+	GetTeam(teamName string) (*domain.Team, error)
+	GetUserByID(userID string) (*domain.User, error)
+	GetPRByID(pullRequestID string) (*domain.PullRequest, error)
+	ListPRs(filter domain.PRFilter) ([]*domain.PullRequest, *domain.PRCursor, error)
+	GetReviewStat(filter domain.StatFilter) ([]domain.UserReviewStat, error)
+	GetTeamsByNames(names []string) (map[string]*domain.Team, error)
+	GetUsersByIDs(userIDs []string) (map[string]*domain.User, error)
+	GetUserTeamsByIDs(userIDs []string) (map[string]string, error)
+	ListUsersPRs(filter domain.UsersPRFilter) (map[string][]*domain.PullRequest, error)
+}
+
+type Server struct {
+	log    *slog.Logger
+	cfg    *config.Config
+	schema *graphql.Schema
+	// limitSchema Та же схема для оценки глубины и стоимости запроса
+	limitSchema *ast.Schema
+}
+
+func New(log *slog.Logger, storage Storage, cfg *config.Config) (*Server, error) {
+	s := &Server{log: log, cfg: cfg}
+	var err error
+	s.schema, err = graphql.ParseSchema(schemaSDL, &resolver{storage: storage},
+		graphql.Logger(panicHandler{log: log}),
+		graphql.PanicHandler(panicHandler{log: log}),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("parse schema: %w", err)
+	}
+	if s.limitSchema, err = gqlparser.LoadSchema(&ast.Source{Name: "schema.graphql", Input: schemaSDL}); err != nil {
+		return nil, fmt.Errorf("load schema: %w", err)
+	}
+	return s, nil
+}
+
+type request struct {
+	Query         string         `json:"query"`
+	OperationName string         `json:"operationName"`
+	Variables     map[string]any `json:"variables"`
+}
+
+func (s *Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	var req request
+	err := json.NewDecoder(http.MaxBytesReader(w, r.Body, maxRequestSize)).Decode(&req)
+	if err == nil && req.Query == "" {
+		err = errors.New("empty query")
+	}
+	if err != nil {
+		s.log.Error("failed to decode request", sl.Err(err), "request_id", middleware.GetReqID(r.Context()))
+		render.Status(r, http.StatusBadRequest)
+		render.JSON(w, r, errorResponse(transport.BAD_REQUEST, "failed to decode request"))
+		return
+	}
+
+	// Глубина и стоимость проверяются до выполнения, чтобы тяжёлый запрос не дошёл до БД
+	if err := s.checkLimits(req.Query, req.OperationName, req.Variables); err != nil {
+		s.log.Error("query rejected", sl.Err(err), "request_id", middleware.GetReqID(r.Context()))
+		render.Status(r, http.StatusBadRequest)
+		render.JSON(w, r, errorResponse(transport.BAD_REQUEST, err.Error()))
+		return
+	}
+
+	response := s.schema.Exec(r.Context(), req.Query, req.OperationName, req.Variables)
+	s.presentErrors(r, response.Errors)
+	render.JSON(w, r, response)
+}
+
+// errorResponse Ответ без данных с одной ошибкой
+func errorResponse(code, message string) *graphql.Response {
+	return &graphql.Response{Errors: []*gqlerrors.QueryError{{
+		Message:    message,
+		Extensions: map[string]any{"code": code},
+	}}}
+}
+
+// presentErrors Коды ошибок HTTP API в extensions.code: ошибки запроса - BAD_REQUEST, ошибки storage
+// по общей таблице, остальные - SERVER_ERROR без внутренних деталей
+func (s *Server) presentErrors(r *http.Request, errs []*gqlerrors.QueryError) {
+	for _, queryErr := range errs {
+		if queryErr.ResolverError == nil {
+			if queryErr.Extensions == nil {
+				queryErr.Extensions = map[string]any{"code": transport.BAD_REQUEST}
+			}
+			continue
+		}
+
+		var argErr *argumentError
+		if errors.As(queryErr.ResolverError, &argErr) {
+			queryErr.Message = argErr.Error()
+			queryErr.Extensions = map[string]any{"code": transport.BAD_REQUEST}
+			continue
+		}
+		s.log.Error("failed to resolve field", sl.Err(queryErr.ResolverError),
+			"request_id", middleware.GetReqID(r.Context()))
+		if known, ok := transport.StorageError(queryErr.ResolverError); ok {
+			queryErr.Message = known.Message
+			queryErr.Extensions = map[string]any{"code": known.Code}
+			continue
+		}
+		queryErr.Message = "internal server error"
+		queryErr.Extensions = map[string]any{"code": transport.SERVER_ERROR}
+	}
+}
+
+// panicHandler Паника в resolver: запись в лог сервиса и ошибка поля без внутренних деталей
+type panicHandler struct {
+	log *slog.Logger
+}
+
+func (h panicHandler) LogPanic(ctx context.Context, value any) {
+	h.log.Error("resolver panic", "panic", value, "stack", string(debug.Stack()),
+		"request_id", middleware.GetReqID(ctx))
+}
+
+func (h panicHandler) MakePanicError(_ context.Context, _ any) *gqlerrors.QueryError {
+	return &gqlerrors.QueryError{
+		Message:    "internal server error",
+		Extensions: map[string]any{"code": transport.SERVER_ERROR},
+	}
+}
+
+// argumentError Некорректный аргумент поля
+type argumentError struct {
+	name string
+}
+
+func (e *argumentError) Error() string {
+	return "invalid " + e.name
+}
+
+func errBadArgument(name string) error {
+	return &argumentError{name: name}
+}
@@ -0,0 +1,221 @@
+package graphqlserver
+
+import (
+	"encoding/json"
+	"io"
+	"log/slog"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/sudo-odner/Backend-trainee-assignment-avito-2025/internal/config"
+	"github.com/sudo-odner/Backend-trainee-assignment-avito-2025/internal/storage/storagetest"
+)
+
+func newTestServer(t *testing.T, storage Storage, maxDepth, maxComplexity int) *Server {
+	t.Helper()
+	cfg := &config.Config{}
+	cfg.GraphQL.MaxDepth = maxDepth
+	cfg.GraphQL.MaxComplexity = maxComplexity
+	server, err := New(slog.New(slog.NewTextHandler(io.Discard, nil)), storage, cfg)
+	if err != nil {
+		t.Fatalf("New() error = %v", err)
+	}
+	return server
+}
+
+type testResponse struct {
+	Data   json.RawMessage `json:"data"`
+	Errors []struct {
+		Message    string         `json:"message"`
+		Extensions map[string]any `json:"extensions"`
+	} `json:"errors"`
+}
+
+func execute(t *testing.T, server *Server, query string) testResponse {
+	t.Helper()
+	body, err := json.Marshal(request{Query: query})
+	if err != nil {
+		t.Fatal(err)
+	}
+	rec := httptest.NewRecorder()
+	server.ServeHTTP(rec, httptest.NewRequest(http.MethodPost, "/graphql", strings.NewReader(string(body))))
+
+	var res testResponse
+	if err := json.NewDecoder(rec.Body).Decode(&res); err != nil {
+		t.Fatalf("decode response: %v", err)
+	}
+	return res
+}
+
+// TestNestedTeamQuery Команда → участники → их PR → авторы PR: связанные данные загружаются
+// одним запросом на уровень, а не на каждый объект
+func TestNestedTeamQuery(t *testing.T) {
+	storage := storagetest.New()
+	server := newTestServer(t, storage, 0, 0)
+
+	res := execute(t, server, `{
+		team(teamName: "backend") {
+			teamName
+			members {
+				userId
+				team { teamName }
+				pullRequests {
+					pullRequestId
+					author { username teamName team { teamName } }
+				}
+			}
+		}
+	}`)
+	if len(res.Errors) > 0 {
+		t.Fatalf("errors = %+v", res.Errors)
+	}
+
+	carol := `{"username": "Carol", "teamName": "frontend", "team": {"teamName": "frontend"}}`
+	alice := `{"username": "Alice", "teamName": "backend", "team": {"teamName": "backend"}}`
+	want := `{"team": {"teamName": "backend", "members": [
+		{"userId": "u1", "team": {"teamName": "backend"}, "pullRequests": [
+			{"pullRequestId": "pr-1", "author": ` + carol + `}
+		]},
+		{"userId": "u2", "team": {"teamName": "backend"}, "pullRequests": [
+			{"pullRequestId": "pr-2", "author": ` + alice + `},
+			{"pullRequestId": "pr-1", "author": ` + carol + `}
+		]}
+	]}}`
+	assertJSON(t, res.Data, want)
+
+	for method, want := range map[string]int{
+		"GetTeam": 1,
+		// Команды участников известны из родителя, авторов - загружаются для уровня авторов
+		"GetUserTeamsByIDs": 1,
+		"GetTeamsByNames":   2,
+		"ListUsersPRs":      1,
+		// Данные авторов приходят вместе с PR
+		"GetUsersByIDs": 0,
+	} {
+		if got := storage.Count(method); got != want {
+			t.Errorf("%s calls = %d, want %d", method, got, want)
+		}
+	}
+}
+
+func TestUserTeam(t *testing.T) {
+	server := newTestServer(t, storagetest.New(), 0, 0)
+
+	res := execute(t, server, `{
+		user(userId: "u3") { username team { teamName members { userId } } }
+		missing: user(userId: "u9") { username }
+	}`)
+	if len(res.Errors) > 0 {
+		t.Fatalf("errors = %+v", res.Errors)
+	}
+	assertJSON(t, res.Data, `{
+		"user": {"username": "Carol", "team": {"teamName": "frontend", "members": [{"userId": "u3"}]}},
+		"missing": null
+	}`)
+}
+
+func TestPullRequestReviewers(t *testing.T) {
+	storage := storagetest.New()
+	server := newTestServer(t, storage, 0, 0)
+
+	res := execute(t, server, `{
+		pullRequests { pullRequestId reviewers { username pullRequests(role: AUTHOR) { pullRequestId } } }
+	}`)
+	if len(res.Errors) > 0 {
+		t.Fatalf("errors = %+v", res.Errors)
+	}
+	assertJSON(t, res.Data, `{"pullRequests": [
+		{"pullRequestId": "pr-2", "reviewers": [{"username": "Bob", "pullRequests": []}]},
+		{"pullRequestId": "pr-1", "reviewers": [
+			{"username": "Alice", "pullRequests": [{"pullRequestId": "pr-2"}]},
+			{"username": "Bob", "pullRequests": []}
+		]}
+	]}`)
+	if got := storage.Count("ListUsersPRs"); got != 1 {
+		t.Errorf("ListUsersPRs calls = %d, want 1", got)
+	}
+}
+
+func TestArgumentError(t *testing.T) {
+	server := newTestServer(t, storagetest.New(), 0, 0)
+
+	res := execute(t, server, `{ pullRequests(limit: 0) { pullRequestId } }`)
+	if len(res.Errors) != 1 || res.Errors[0].Message != "invalid limit" || res.Errors[0].Extensions["code"] != "BAD_REQUEST" {
+		t.Errorf("errors = %+v, want invalid limit with BAD_REQUEST", res.Errors)
+	}
+}
+
+func TestBadRequestStatus(t *testing.T) {
+	server := newTestServer(t, storagetest.New(), 2, 0)
+
+	for name, body := range map[string]string{
+		"invalid json": `{"query":`,
+		"empty query":  `{"query": ""}`,
+		"too deep":     `{"query": "{ team(teamName: \"backend\") { members { userId } } }"}`,
+	} {
+		t.Run(name, func(t *testing.T) {
+			rec := httptest.NewRecorder()
+			server.ServeHTTP(rec, httptest.NewRequest(http.MethodPost, "/graphql", strings.NewReader(body)))
+			// Заголовки на момент отправки статуса
+			res := rec.Result()
+			if res.StatusCode != http.StatusBadRequest || !strings.HasPrefix(res.Header.Get("Content-Type"), "application/json") {
+				t.Errorf("status = %d, Content-Type = %q, want 400 application/json", res.StatusCode, res.Header.Get("Content-Type"))
+			}
+		})
+	}
+}
+
+func TestQueryRejectedByLimits(t *testing.T) {
+	tests := []struct {
+		name          string
+		maxDepth      int
+		maxComplexity int
+		query         string
+		wantErr       string
+	}{
+		{
+			name:     "depth",
+			maxDepth: 3,
+			query:    `{ team(teamName: "backend") { members { pullRequests { pullRequestId } } } }`,
+			wantErr:  "query depth 4 exceeds max depth 3",
+		},
+		{
+			name:          "complexity",
+			maxComplexity: 1000,
+			query:         `{ pullRequests(limit: 100) { reviewers { userId } } }`,
+			wantErr:       "query complexity 1101 exceeds max complexity 1000",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			storage := storagetest.New()
+			server := newTestServer(t, storage, tt.maxDepth, tt.maxComplexity)
+
+			res := execute(t, server, tt.query)
+			if len(res.Errors) != 1 || res.Errors[0].Message != tt.wantErr || res.Errors[0].Extensions["code"] != "BAD_REQUEST" {
+				t.Errorf("errors = %+v, want %q", res.Errors, tt.wantErr)
+			}
+			if calls := storage.Calls(); calls != 0 {
+				t.Errorf("storage calls = %d, want none", calls)
+			}
+		})
+	}
+}
+
+func assertJSON(t *testing.T, got json.RawMessage, want string) {
+	t.Helper()
+	var gotValue, wantValue any
+	if err := json.Unmarshal(got, &gotValue); err != nil {
+		t.Fatalf("unmarshal data: %v", err)
+	}
+	if err := json.Unmarshal([]byte(want), &wantValue); err != nil {
+		t.Fatalf("unmarshal want: %v", err)
+	}
+	if !reflect.DeepEqual(gotValue, wantValue) {
+		t.Errorf("data = %s, want %s", got, want)
+	}
+}
@@ -0,0 +1,46 @@
+package graphqlserver
+
+import (
+	"github.com/sudo-odner/Backend-trainee-assignment-avito-2025/internal/domain"
+)
+
+type teamResolver struct {
+	team    *domain.Team
+	members *userLevel
+}
+
+// newTeamResolvers Команды с общим уровнем участников всех команд
+func newTeamResolvers(storage Storage, teams []*domain.Team) []*teamResolver {
+	ids := make([]string, 0)
+	users := make(map[string]*domain.User)
+	teamNames := make(map[string]string)
+	for _, team := range teams {
+		for i := range team.Users {
+			user := &team.Users[i]
+			if _, ok := users[user.ID]; !ok {
+				ids = append(ids, user.ID)
+			}
+			users[user.ID] = user
+			teamNames[user.ID] = team.Name
+		}
+	}
+	members := newUserLevel(storage, ids, users, teamNames)
+
+	res := make([]*teamResolver, 0, len(teams))
+	for _, team := range teams {
+		res = append(res, &teamResolver{team: team, members: members})
+	}
+	return res
+}
+
+func (r *teamResolver) TeamName() string {
+	return r.team.Name
+}
+
+func (r *teamResolver) Members() []*userResolver {
+	res := make([]*userResolver, 0, len(r.team.Users))
+	for _, user := range r.team.Users {
+		res = append(res, &userResolver{id: user.ID, level: r.members})
+	}
+	return res
+}
@@ -0,0 +1,216 @@
+package graphqlserver
+
+import (
+	"fmt"
+
+	"github.com/sudo-odner/Backend-trainee-assignment-avito-2025/internal/domain"
+	"github.com/sudo-odner/Backend-trainee-assignment-avito-2025/internal/storage"
+)
+
+// userLevel Пользователи одного уровня ответа (участники команд, авторы или reviewer PR списка, ...):
+// данные, команды и PR загружаются одним запросом сразу для всех пользователей уровня
+type userLevel struct {
+	storage      Storage
+	ids          []string
+	users        *batch[*domain.User]
+	teamNames    *batch[string]
+	teams        *batch[*teamResolver]
+	pullRequests batches[userPRArgs, []*pullRequestResolver]
+}
+
+// userPRArgs Аргументы поля User.pullRequests
+type userPRArgs struct {
+	role   string
+	status string
+	limit  int
+}
+
+// newUserLevel Уровень пользователей ids (без повторов). Известные из родителя данные и команды
+// передаются в users и teamNames, nil - загрузить при первом обращении
+func newUserLevel(storage Storage, ids []string, users map[string]*domain.User, teamNames map[string]string) *userLevel {
+	l := &userLevel{storage: storage, ids: ids}
+	l.users = newBatch(func() (map[string]*domain.User, error) {
+		if users != nil {
+			return users, nil
+		}
+		return storage.GetUsersByIDs(ids)
+	})
+	l.teamNames = newBatch(func() (map[string]string, error) {
+		if teamNames != nil {
+			return teamNames, nil
+		}
+		return storage.GetUserTeamsByIDs(ids)
+	})
+	l.teams = newBatch(l.loadTeams)
+	return l
+}
+
+// resolvers Пользователи уровня в порядке ids
+func (l *userLevel) resolvers() []*userResolver {
+	res := make([]*userResolver, 0, len(l.ids))
+	for _, id := range l.ids {
+		res = append(res, &userResolver{id: id, level: l})
+	}
+	return res
+}
+
+// loadTeams Команды всех пользователей уровня
+func (l *userLevel) loadTeams() (map[string]*teamResolver, error) {
+	names := make([]string, 0)
+	seen := make(map[string]bool)
+	for _, id := range l.ids {
+		name, ok, err := l.teamNames.get(id)
+		if err != nil {
+			return nil, err
+		}
+		if ok && !seen[name] {
+			seen[name] = true
+			names = append(names, name)
+		}
+	}
+	teams, err := l.storage.GetTeamsByNames(names)
+	if err != nil {
+		return nil, err
+	}
+
+	loaded := make([]*domain.Team, 0, len(teams))
+	for _, name := range names {
+		if team, ok := teams[name]; ok {
+			loaded = append(loaded, team)
+		}
+	}
+	res := make(map[string]*teamResolver, len(loaded))
+	for _, team := range newTeamResolvers(l.storage, loaded) {
+		res[team.team.Name] = team
+	}
+	return res, nil
+}
+
+// loadPullRequests PR всех пользователей уровня с общим уровнем PR
+func (l *userLevel) loadPullRequests(args userPRArgs) (map[string][]*pullRequestResolver, error) {
+	prsByUser, err := l.storage.ListUsersPRs(domain.UsersPRFilter{
+		UserIDs: l.ids,
+		Role:    args.role,
+		Status:  args.status,
+		Limit:   args.limit,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	prs := make([]*domain.PullRequest, 0)
+	seen := make(map[string]bool)
+	for _, id := range l.ids {
+		for _, pr := range prsByUser[id] {
+			if !seen[pr.ID] {
+				seen[pr.ID] = true
+				prs = append(prs, pr)
+			}
+		}
+	}
+	byID := make(map[string]*pullRequestResolver, len(prs))
+	for _, pr := range newPullRequestResolvers(l.storage, prs) {
+		byID[pr.pr.ID] = pr
+	}
+	res := make(map[string][]*pullRequestResolver, len(prsByUser))
+	for userID, userPRs := range prsByUser {
+		for _, pr := range userPRs {
+			res[userID] = append(res[userID], byID[pr.ID])
+		}
+	}
+	return res, nil
+}
+
+type userResolver struct {
+	id    string
+	level *userLevel
+}
+
+// user Данные пользователя из уровня
+func (r *userResolver) user() (*domain.User, error) {
+	user, ok, err := r.level.users.get(r.id)
+	if err != nil {
+		return nil, fmt.Errorf("failed get users: %w", err)
+	}
+	if !ok {
+		return nil, fmt.Errorf("failed get user %s: %w", r.id, storage.ErrUserNotFound)
+	}
+	return user, nil
+}
+
+func (r *userResolver) UserID() string {
+	return r.id
+}
+
+func (r *userResolver) Username() (string, error) {
+	user, err := r.user()
+	if err != nil {
+		return "", err
+	}
+	return user.Name, nil
+}
+
+func (r *userResolver) IsActive() (bool, error) {
+	user, err := r.user()
+	if err != nil {
+		return false, err
+	}
+	return user.IsActive, nil
+}
+
+// TeamName Команда пользователя (nil - не состоит в команде)
+func (r *userResolver) TeamName() (*string, error) {
+	name, ok, err := r.level.teamNames.get(r.id)
+	if err != nil {
+		return nil, fmt.Errorf("failed get user teams: %w", err)
+	}
+	if !ok {
+		return nil, nil
+	}
+	return &name, nil
+}
+
+// Team Команда пользователя (nil - не состоит в команде)
+func (r *userResolver) Team() (*teamResolver, error) {
+	name, ok, err := r.level.teamNames.get(r.id)
+	if err != nil {
+		return nil, fmt.Errorf("failed get user teams: %w", err)
+	}
+	if !ok {
+		return nil, nil
+	}
+	// Команды уровня загружены по названию
+	team, _, err := r.level.teams.get(name)
+	if err != nil {
+		return nil, fmt.Errorf("failed get teams: %w", err)
+	}
+	return team, nil
+}
+
+// PullRequests Последние PR, где пользователь reviewer или автор
+func (r *userResolver) PullRequests(args struct {
+	Role   string
+	Status *string
+	Limit  int32
+}) ([]*pullRequestResolver, error) {
+	limit, err := prLimit(args.Limit)
+	if err != nil {
+		return nil, err
+	}
+	prArgs := userPRArgs{role: domain.PRRoleReviewer, status: deref(args.Status), limit: limit}
+	if args.Role == "AUTHOR" {
+		prArgs.role = domain.PRRoleAuthor
+	}
+
+	loader := r.level.pullRequests.get(prArgs, func() (map[string][]*pullRequestResolver, error) {
+		return r.level.loadPullRequests(prArgs)
+	})
+	prs, _, err := loader.get(r.id)
+	if err != nil {
+		return nil, fmt.Errorf("failed get user PRs: %w", err)
+	}
+	if prs == nil {
+		prs = make([]*pullRequestResolver, 0)
+	}
+	return prs, nil
+}
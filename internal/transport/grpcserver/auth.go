@@ -0,0 +1,87 @@
+package grpcserver
+
+import (
+	"context"
+	"errors"
+	"strings"
+
+	"github.com/sudo-odner/Backend-trainee-assignment-avito-2025/internal/auth"
+	"github.com/sudo-odner/Backend-trainee-assignment-avito-2025/internal/storage"
+	"github.com/sudo-odner/Backend-trainee-assignment-avito-2025/internal/transport"
+	"github.com/sudo-odner/Backend-trainee-assignment-avito-2025/pkg/logger/sl"
+	"github.com/sudo-odner/Backend-trainee-assignment-avito-2025/pkg/prpb"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+)
+
+// methodAccess Правила доступа к методам, как у соответствующих HTTP маршрутов. Методы без правила доступны только admin
+var methodAccess = map[string]auth.Access{
+	prpb.PRService_DeactivateTeam_FullMethodName:      auth.AccessTeamLead,
+	prpb.PRService_SetUserActive_FullMethodName:       auth.AccessTeamLead,
+	prpb.PRService_GetTeam_FullMethodName:             auth.AccessUser,
+	prpb.PRService_GetUserReviews_FullMethodName:      auth.AccessUser,
+	prpb.PRService_CreatePullRequest_FullMethodName:   auth.AccessUser,
+	prpb.PRService_ReassignReviewer_FullMethodName:    auth.AccessUser,
+	prpb.PRService_DeclineReview_FullMethodName:       auth.AccessUser,
+	prpb.PRService_GetPullRequest_FullMethodName:      auth.AccessUser,
+	prpb.PRService_ListPullRequests_FullMethodName:    auth.AccessUser,
+	prpb.PRService_GetReviewStats_FullMethodName:      auth.AccessUser,
+	prpb.PRService_GetTeamStats_FullMethodName:        auth.AccessUser,
+	prpb.PRService_GetPullRequestStats_FullMethodName: auth.AccessUser,
+	// Поток отдаёт записи аудита (инициатор, причина, состояние до и после), как GET /audit - не шире команды
+	prpb.PRService_WatchPullRequestEvents_FullMethodName: auth.AccessTeamLead,
+}
+
+func (s *Server) authenticateUnary(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+	ctx, err := s.authenticate(ctx, info.FullMethod)
+	if err != nil {
+		return nil, err
+	}
+	return handler(ctx, req)
+}
+
+func (s *Server) authenticateStream(srv any, stream grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+	ctx, err := s.authenticate(stream.Context(), info.FullMethod)
+	if err != nil {
+		return err
+	}
+	return handler(srv, &principalStream{ServerStream: stream, ctx: ctx})
+}
+
+// authenticate Проверка bearer токена из metadata и роли по правилам methodAccess
+func (s *Server) authenticate(ctx context.Context, method string) (context.Context, error) {
+	rule, ok := methodAccess[method]
+	if !ok {
+		rule = auth.AccessAdmin
+	}
+	if !s.cfg.Auth.Enabled || rule == auth.AccessPublic {
+		return ctx, nil
+	}
+
+	var token string
+	if values := metadata.ValueFromIncomingContext(ctx, "authorization"); len(values) > 0 {
+		token, _ = strings.CutPrefix(values[0], "Bearer ")
+	}
+	principal, err := s.auth.Authenticate(strings.TrimSpace(token))
+	if err != nil {
+		if !errors.Is(err, storage.ErrTokenNotFound) {
+			s.log.Error("failed to check api token", sl.Err(err))
+			return nil, errorStatus(transport.SERVER_ERROR, "failed to check api token")
+		}
+		return nil, errorStatus(transport.UNAUTHORIZED, "missing or invalid api token")
+	}
+	if !rule.Allows(principal.Role) {
+		return nil, errorStatus(transport.FORBIDDEN, "insufficient role")
+	}
+	return auth.WithPrincipal(ctx, principal), nil
+}
+
+// principalStream Поток с контекстом, в котором сохранён владелец токена
+type principalStream struct {
+	grpc.ServerStream
+	ctx context.Context
+}
+
+func (s *principalStream) Context() context.Context {
+	return s.ctx
+}
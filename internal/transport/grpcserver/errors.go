@@ -0,0 +1,69 @@
+package grpcserver
+
+import (
+	"errors"
+
+	"github.com/sudo-odner/Backend-trainee-assignment-avito-2025/internal/auth"
+	"github.com/sudo-odner/Backend-trainee-assignment-avito-2025/internal/transport"
+	"github.com/sudo-odner/Backend-trainee-assignment-avito-2025/pkg/logger/sl"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// errorDomain Домен причин ошибок в google.rpc.ErrorInfo
+const errorDomain = "prservice.v1"
+
+// grpcCodes Код статуса gRPC для кода ошибки HTTP API
+var grpcCodes = map[string]codes.Code{
+	transport.BAD_REQUEST:          codes.InvalidArgument,
+	transport.UNAUTHORIZED:         codes.Unauthenticated,
+	transport.FORBIDDEN:            codes.PermissionDenied,
+	transport.NOT_FOUND:            codes.NotFound,
+	transport.TEAM_EXISTS:          codes.AlreadyExists,
+	transport.PR_EXISTS:            codes.AlreadyExists,
+	transport.PR_MERGED:            codes.FailedPrecondition,
+	transport.NOT_ASSIGNED:         codes.FailedPrecondition,
+	transport.NO_CANDIDATE:         codes.FailedPrecondition,
+	transport.NOT_EMPTY:            codes.FailedPrecondition,
+	transport.PRECONDITION_FAILED:  codes.Aborted,
+	transport.IDEMPOTENCY_CONFLICT: codes.Aborted,
+	transport.RATE_LIMITED:         codes.ResourceExhausted,
+	transport.SERVER_ERROR:         codes.Internal,
+}
+
+// errorStatus Статус gRPC с кодом ошибки HTTP API в ErrorInfo.Reason
+func errorStatus(code, message string) error {
+	grpcCode, ok := grpcCodes[code]
+	if !ok {
+		grpcCode = codes.Unknown
+	}
+	st := status.New(grpcCode, message)
+	withInfo, err := st.WithDetails(&errdetails.ErrorInfo{Reason: code, Domain: errorDomain})
+	if err != nil {
+		return st.Err()
+	}
+	return withInfo.Err()
+}
+
+// errBadParam Некорректный или отсутствующий параметр запроса
+func errBadParam(name string) error {
+	return errorStatus(transport.BAD_REQUEST, "invalid "+name)
+}
+
+// handleError Статус по ошибке обработчика: готовый статус как есть, отказ по области токена - FORBIDDEN,
+// ошибки storage по общей таблице, остальные - SERVER_ERROR без внутренних деталей
+func (s *Server) handleError(message string, err error) error {
+	if _, ok := status.FromError(err); ok {
+		return err
+	}
+	s.log.Error(message, sl.Err(err))
+	var scopeErr *auth.ScopeError
+	if errors.As(err, &scopeErr) {
+		return errorStatus(transport.FORBIDDEN, scopeErr.Message)
+	}
+	if known, ok := transport.StorageError(err); ok {
+		return errorStatus(known.Code, known.Message)
+	}
+	return errorStatus(transport.SERVER_ERROR, message)
+}
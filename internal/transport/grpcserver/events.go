@@ -0,0 +1,191 @@
+package grpcserver
+
+import (
+	"log/slog"
+	"slices"
+	"time"
+
+	"github.com/sudo-odner/Backend-trainee-assignment-avito-2025/internal/domain"
+	"github.com/sudo-odner/Backend-trainee-assignment-avito-2025/pkg/prpb"
+	"google.golang.org/protobuf/types/known/structpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+const (
+	// eventBatchSize Число событий, читаемых из аудита за один запрос
+	eventBatchSize = 100
+	// eventSettleWindow Сколько после отправки события ждать зафиксированных позже событий с меньшим id
+	// (дольше транзакций изменения PR)
+	eventSettleWindow = time.Minute
+	// defaultEventPollInterval Интервал опроса аудита, если в конфиге задан неположительный
+	defaultEventPollInterval = time.Second
+)
+
+// prEventTypes Типы событий аудита, относящиеся к PR
+var prEventTypes = []string{
+	domain.AuditPRCreated,
+	domain.AuditReviewerAssigned,
+	domain.AuditReviewerReassigned,
+	domain.AuditReviewerDeclined,
+	domain.AuditReviewerRemoved,
+	domain.AuditPRMerged,
+}
+
+// pollInterval Интервал опроса аудита потоком событий (неположительный заменяется значением по умолчанию)
+func pollInterval(log *slog.Logger, interval time.Duration) time.Duration {
+	if interval <= 0 {
+		log.Warn("invalid gRPC event poll interval, using default",
+			slog.Duration("interval", interval), slog.Duration("default", defaultEventPollInterval))
+		return defaultEventPollInterval
+	}
+	return interval
+}
+
+// WatchPullRequestEvents Поток событий PR из журнала аудита: опрос новых записей с интервалом grpc_server.event_poll_interval
+func (s *Server) WatchPullRequestEvents(req *prpb.WatchPullRequestEventsRequest, stream prpb.PRService_WatchPullRequestEventsServer) error {
+	types := prEventTypes
+	if len(req.GetTypes()) > 0 {
+		for _, eventType := range req.GetTypes() {
+			if !slices.Contains(prEventTypes, eventType) {
+				return errBadParam("types")
+			}
+		}
+		types = req.GetTypes()
+	}
+	if req.GetAfterId() < 0 {
+		return errBadParam("after_id")
+	}
+	// Руководитель команды видит только события своей команды
+	if err := s.auth.TeamScope(stream.Context(), req.GetTeamName()); err != nil {
+		return s.handleError("team is out of token scope", err)
+	}
+
+	// Без after_id поток начинается с событий, появившихся после подписки
+	afterID := req.GetAfterId()
+	if afterID == 0 {
+		var err error
+		if afterID, err = s.storage.GetLastAuditEventID(); err != nil {
+			return s.handleError("failed to get last audit event", err)
+		}
+	}
+
+	ticker := time.NewTicker(s.eventPollInterval)
+	defer ticker.Stop()
+	cursor := newEventCursor(afterID)
+	for {
+		if err := s.sendNewEvents(req, types, cursor, stream); err != nil {
+			return err
+		}
+
+		select {
+		case <-stream.Context().Done():
+			return nil
+		case <-ticker.C:
+		}
+	}
+}
+
+// sendNewEvents Отправка ещё не отправленных событий после нижней границы курсора
+func (s *Server) sendNewEvents(
+	req *prpb.WatchPullRequestEventsRequest, types []string, cursor *eventCursor,
+	stream prpb.PRService_WatchPullRequestEventsServer,
+) error {
+	now := time.Now()
+	afterID := cursor.floor
+	for {
+		events, err := s.storage.GetAuditEvents(domain.AuditFilter{
+			PullRequestID: req.GetPullRequestId(),
+			TeamName:      req.GetTeamName(),
+			AfterID:       afterID,
+			Limit:         eventBatchSize,
+			Types:         types,
+		})
+		if err != nil {
+			return s.handleError("failed to get audit events", err)
+		}
+		for _, event := range events {
+			afterID = event.ID
+			if cursor.sent(event.ID) {
+				continue
+			}
+			pbEvent, err := pbPullRequestEvent(event)
+			if err != nil {
+				return s.handleError("failed to convert audit event", err)
+			}
+			if err := stream.Send(pbEvent); err != nil {
+				return err
+			}
+			cursor.add(event.ID, now)
+		}
+		// Неполная пачка - событий больше нет
+		if len(events) < eventBatchSize {
+			break
+		}
+	}
+	cursor.settle(now.Add(-eventSettleWindow))
+	return nil
+}
+
+// eventCursor Позиция потока событий. Id событий выдаются при вставке, а транзакции фиксируются в другом порядке:
+// событие с меньшим id может появиться после уже отправленного. Поэтому опрос перечитывает окно после floor,
+// а отправленные в окне события пропускаются по id. Событие уходит за floor через eventSettleWindow после отправки
+type eventCursor struct {
+	// floor Все события с id не больше floor отправлены или уже не появятся
+	floor int64
+	// sentAt Отправленные события после floor и время их отправки
+	sentAt map[int64]time.Time
+}
+
+func newEventCursor(afterID int64) *eventCursor {
+	return &eventCursor{floor: afterID, sentAt: make(map[int64]time.Time)}
+}
+
+func (c *eventCursor) sent(id int64) bool {
+	_, ok := c.sentAt[id]
+	return ok
+}
+
+func (c *eventCursor) add(id int64, now time.Time) {
+	c.sentAt[id] = now
+}
+
+// settle Сдвиг floor за события, отправленные раньше before: транзакция с меньшим id,
+// не зафиксированная за это время, считается отменённой
+func (c *eventCursor) settle(before time.Time) {
+	for id, sentAt := range c.sentAt {
+		if sentAt.Before(before) && id > c.floor {
+			c.floor = id
+		}
+	}
+	for id := range c.sentAt {
+		if id <= c.floor {
+			delete(c.sentAt, id)
+		}
+	}
+}
+
+// pbPullRequestEvent Событие аудита в формате protobuf
+func pbPullRequestEvent(event domain.AuditEvent) (*prpb.PullRequestEvent, error) {
+	res := &prpb.PullRequestEvent{
+		Id:            event.ID,
+		Type:          event.Type,
+		PullRequestId: event.PullRequestID,
+		UserIds:       event.UserIDs,
+		TeamName:      event.TeamName,
+		Actor:         event.Actor,
+		Reason:        event.Reason,
+		OccurredAt:    timestamppb.New(event.CreatedAt),
+	}
+	var err error
+	if event.Before != nil {
+		if res.Before, err = structpb.NewStruct(event.Before); err != nil {
+			return nil, err
+		}
+	}
+	if event.After != nil {
+		if res.After, err = structpb.NewStruct(event.After); err != nil {
+			return nil, err
+		}
+	}
+	return res, nil
+}
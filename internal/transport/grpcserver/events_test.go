@@ -0,0 +1,66 @@
+package grpcserver
+
+import (
+	"io"
+	"log/slog"
+	"testing"
+	"time"
+)
+
+func TestPollIntervalDefault(t *testing.T) {
+	log := slog.New(slog.NewTextHandler(io.Discard, nil))
+	for _, interval := range []time.Duration{0, -time.Second} {
+		if got := pollInterval(log, interval); got != defaultEventPollInterval {
+			t.Errorf("pollInterval(%v) = %v, want %v", interval, got, defaultEventPollInterval)
+		}
+	}
+	if got := pollInterval(log, 5*time.Second); got != 5*time.Second {
+		t.Errorf("pollInterval(5s) = %v, want 5s", got)
+	}
+}
+
+// TestEventCursorLateCommit Событие с меньшим id, зафиксированное позже отправленного, не теряется и не дублируется
+func TestEventCursorLateCommit(t *testing.T) {
+	start := time.Date(2026, 10, 19, 12, 0, 0, 0, time.UTC)
+	cursor := newEventCursor(10)
+
+	// Опрос 1: видно только событие 12, транзакция события 11 ещё не зафиксирована
+	cursor.add(12, start)
+	cursor.settle(start.Add(-eventSettleWindow))
+	if cursor.floor != 10 {
+		t.Fatalf("floor = %d, want 10 while 12 is inside settle window", cursor.floor)
+	}
+
+	// Опрос 2: перечитывание после floor находит 11 и снова 12
+	now := start.Add(time.Second)
+	if cursor.sent(11) {
+		t.Fatal("11 is reported as sent")
+	}
+	cursor.add(11, now)
+	if !cursor.sent(12) {
+		t.Fatal("12 would be sent twice")
+	}
+	cursor.settle(now.Add(-eventSettleWindow))
+
+	// После окна оба события уходят за floor
+	later := now.Add(eventSettleWindow + time.Second)
+	cursor.settle(later.Add(-eventSettleWindow))
+	if cursor.floor != 12 || len(cursor.sentAt) != 0 {
+		t.Errorf("floor = %d, sent = %v, want floor 12 and no tracked events", cursor.floor, cursor.sentAt)
+	}
+}
+
+func TestEventCursorKeepsUnsettled(t *testing.T) {
+	start := time.Date(2026, 10, 19, 12, 0, 0, 0, time.UTC)
+	cursor := newEventCursor(0)
+	cursor.add(5, start)
+	cursor.add(7, start.Add(eventSettleWindow))
+
+	cursor.settle(start.Add(eventSettleWindow + time.Second).Add(-eventSettleWindow))
+	if cursor.floor != 5 {
+		t.Errorf("floor = %d, want 5", cursor.floor)
+	}
+	if !cursor.sent(7) || cursor.sent(5) {
+		t.Errorf("sent = %v, want only 7 tracked", cursor.sentAt)
+	}
+}
@@ -0,0 +1,255 @@
+package grpcserver
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/sudo-odner/Backend-trainee-assignment-avito-2025/internal/domain"
+	"github.com/sudo-odner/Backend-trainee-assignment-avito-2025/internal/storage"
+	"github.com/sudo-odner/Backend-trainee-assignment-avito-2025/internal/transport"
+	"github.com/sudo-odner/Backend-trainee-assignment-avito-2025/pkg/prpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// CreatePullRequest Создание PR с автоматическим назначением до 2 reviewer из команды автора
+func (s *Server) CreatePullRequest(ctx context.Context, req *prpb.CreatePullRequestRequest) (*prpb.CreatePullRequestResponse, error) {
+	switch {
+	case req.GetPullRequestId() == "":
+		return nil, errBadParam("pull_request_id")
+	case req.GetPullRequestName() == "":
+		return nil, errBadParam("pull_request_name")
+	case req.GetAuthorId() == "":
+		return nil, errBadParam("author_id")
+	}
+	if err := s.auth.AuthorScope(ctx, req.GetAuthorId()); err != nil {
+		return nil, s.handleError("failed to check author scope", err)
+	}
+
+	pr, err := s.storage.CreatePRWithReviewers(req.GetPullRequestId(), req.GetPullRequestName(), req.GetAuthorId(), changeMeta(ctx))
+	if err != nil {
+		return nil, s.handleError("failed to create PR", err)
+	}
+	return &prpb.CreatePullRequestResponse{Pr: pbPullRequest(*pr)}, nil
+}
+
+// MergePullRequest Перевод PR в MERGED (идемпотентная операция)
+func (s *Server) MergePullRequest(ctx context.Context, req *prpb.MergePullRequestRequest) (*prpb.MergePullRequestResponse, error) {
+	if req.GetPullRequestId() == "" {
+		return nil, errBadParam("pull_request_id")
+	}
+	meta, err := prChangeMeta(ctx, req.GetIfVersion())
+	if err != nil {
+		return nil, err
+	}
+
+	err = s.storage.MergePR(req.GetPullRequestId(), meta)
+	if err != nil && !errors.Is(err, storage.ErrPRAlreadyMerged) {
+		return nil, s.handleError("failed to soft-merge PR", err)
+	}
+	pr, err := s.storage.GetPRByID(req.GetPullRequestId())
+	if err != nil {
+		return nil, s.handleError("failed to get PR", err)
+	}
+	return &prpb.MergePullRequestResponse{Pr: pbPullRequest(*pr)}, nil
+}
+
+// ReassignReviewer Переназначение reviewer на другого из его команды
+func (s *Server) ReassignReviewer(ctx context.Context, req *prpb.ReassignReviewerRequest) (*prpb.ReassignReviewerResponse, error) {
+	switch {
+	case req.GetPullRequestId() == "":
+		return nil, errBadParam("pull_request_id")
+	case req.GetOldReviewerId() == "":
+		return nil, errBadParam("old_reviewer_id")
+	}
+	if err := s.auth.ReviewScope(ctx, req.GetPullRequestId(), req.GetOldReviewerId()); err != nil {
+		return nil, s.handleError("failed to check review scope", err)
+	}
+	meta, err := prChangeMeta(ctx, req.GetIfVersion())
+	if err != nil {
+		return nil, err
+	}
+
+	pr, newReviewer, err := s.storage.ReassignReviewer(req.GetPullRequestId(), req.GetOldReviewerId(), meta)
+	if err != nil {
+		return nil, s.handleError("failed to reassign PR", err)
+	}
+	return &prpb.ReassignReviewerResponse{Pr: pbPullRequest(*pr), ReplacedBy: newReviewer}, nil
+}
+
+// DeclineReview Отказ reviewer от ревью с подбором замены (исключая всех, кто уже отказывался)
+func (s *Server) DeclineReview(ctx context.Context, req *prpb.DeclineReviewRequest) (*prpb.DeclineReviewResponse, error) {
+	switch {
+	case req.GetPullRequestId() == "":
+		return nil, errBadParam("pull_request_id")
+	case req.GetReviewerId() == "":
+		return nil, errBadParam("reviewer_id")
+	case req.GetReason() == "":
+		return nil, errBadParam("reason")
+	}
+	if err := s.auth.ReviewScope(ctx, req.GetPullRequestId(), req.GetReviewerId()); err != nil {
+		return nil, s.handleError("failed to check review scope", err)
+	}
+	meta, err := prChangeMeta(ctx, req.GetIfVersion())
+	if err != nil {
+		return nil, err
+	}
+
+	pr, newReviewer, err := s.storage.DeclineReview(req.GetPullRequestId(), req.GetReviewerId(), req.GetReason(), meta)
+	if err != nil {
+		return nil, s.handleError("failed to decline review", err)
+	}
+	return &prpb.DeclineReviewResponse{Pr: pbPullRequest(*pr), ReplacedBy: newReviewer}, nil
+}
+
+// GetPullRequest PR с назначенными reviewer
+func (s *Server) GetPullRequest(ctx context.Context, req *prpb.GetPullRequestRequest) (*prpb.GetPullRequestResponse, error) {
+	if req.GetPullRequestId() == "" {
+		return nil, errBadParam("pull_request_id")
+	}
+	pr, err := s.storage.GetPRByID(req.GetPullRequestId())
+	if err != nil {
+		return nil, s.handleError("failed to get PR", err)
+	}
+	return &prpb.GetPullRequestResponse{Pr: pbPullRequest(*pr)}, nil
+}
+
+// ListPullRequests Список PR с фильтрами и постраничной выдачей
+func (s *Server) ListPullRequests(ctx context.Context, req *prpb.ListPullRequestsRequest) (*prpb.ListPullRequestsResponse, error) {
+	filter, err := newPRFilter(req.GetStatus(), req.GetDesc(), req.GetCursor(), req.GetLimit())
+	if err != nil {
+		return nil, err
+	}
+	filter.AuthorID = req.GetAuthorId()
+	filter.ReviewerID = req.GetReviewerId()
+	filter.TeamName = req.GetTeamName()
+	filter.NameQuery = req.GetNameQuery()
+	switch req.GetSortBy() {
+	case "", domain.PRSortCreatedAt, domain.PRSortMergedAt:
+		filter.SortBy = req.GetSortBy()
+	default:
+		return nil, errBadParam("sort_by")
+	}
+	filter.CreatedFrom = localTime(req.GetCreatedFrom())
+	filter.CreatedTo = localTime(req.GetCreatedTo())
+	filter.MergedFrom = localTime(req.GetMergedFrom())
+	filter.MergedTo = localTime(req.GetMergedTo())
+
+	prs, next, err := s.storage.ListPRs(filter)
+	if err != nil {
+		return nil, s.handleError("failed to list PRs", err)
+	}
+	pullRequests := make([]*prpb.PullRequest, 0, len(prs))
+	for _, pr := range prs {
+		pullRequests = append(pullRequests, pbPullRequest(*pr))
+	}
+	return &prpb.ListPullRequestsResponse{
+		PullRequests: pullRequests,
+		NextCursor:   deref(transport.EncodePRCursor(next)),
+	}, nil
+}
+
+// newPRFilter Фильтр списка PR по статусу, направлению сортировки, курсору и limit (0 - по умолчанию)
+func newPRFilter(status prpb.PullRequestStatus, desc bool, cursor string, limit int32) (domain.PRFilter, error) {
+	filter := domain.PRFilter{
+		Desc:  desc,
+		Limit: transport.DefaultPRListLimit,
+	}
+	var err error
+	if filter.Status, err = statusFromPB(status); err != nil {
+		return filter, err
+	}
+	if cursor != "" {
+		after, err := transport.DecodePRCursor(cursor)
+		if err != nil {
+			return filter, errBadParam("cursor")
+		}
+		filter.After = after
+	}
+	if limit != 0 {
+		if limit < 0 || limit > transport.MaxPRListLimit {
+			return filter, errBadParam("limit")
+		}
+		filter.Limit = int(limit)
+	}
+	return filter, nil
+}
+
+// prChangeMeta Инициатор изменения PR и ожидаемая версия (0 - без проверки)
+func prChangeMeta(ctx context.Context, ifVersion int64) (domain.ChangeMeta, error) {
+	if ifVersion < 0 {
+		return domain.ChangeMeta{}, errBadParam("if_version")
+	}
+	meta := changeMeta(ctx)
+	meta.IfVersion = ifVersion
+	return meta, nil
+}
+
+// pbPullRequest PR в формате protobuf
+func pbPullRequest(pr domain.PullRequest) *prpb.PullRequest {
+	reviewers := make([]string, 0, len(pr.Reviewers))
+	for _, reviewer := range pr.Reviewers {
+		reviewers = append(reviewers, reviewer.ID)
+	}
+	return &prpb.PullRequest{
+		PullRequestId:     pr.ID,
+		PullRequestName:   pr.Name,
+		AuthorId:          pr.Author.ID,
+		Status:            statusToPB(pr.Status),
+		AssignedReviewers: reviewers,
+		CreatedAt:         optionalTimestamp(pr.CreatedAt),
+		MergedAt:          optionalTimestamp(pr.MergedAt),
+		Version:           pr.Version,
+	}
+}
+
+// statusToPB Статус PR в формате protobuf
+func statusToPB(status string) prpb.PullRequestStatus {
+	switch status {
+	case "OPEN":
+		return prpb.PullRequestStatus_PULL_REQUEST_STATUS_OPEN
+	case "MERGED":
+		return prpb.PullRequestStatus_PULL_REQUEST_STATUS_MERGED
+	default:
+		return prpb.PullRequestStatus_PULL_REQUEST_STATUS_UNSPECIFIED
+	}
+}
+
+// statusFromPB Статус PR из фильтра (UNSPECIFIED - любой)
+func statusFromPB(status prpb.PullRequestStatus) (string, error) {
+	switch status {
+	case prpb.PullRequestStatus_PULL_REQUEST_STATUS_UNSPECIFIED:
+		return "", nil
+	case prpb.PullRequestStatus_PULL_REQUEST_STATUS_OPEN:
+		return "OPEN", nil
+	case prpb.PullRequestStatus_PULL_REQUEST_STATUS_MERGED:
+		return "MERGED", nil
+	default:
+		return "", errBadParam("status")
+	}
+}
+
+// optionalTimestamp Время или nil, если не задано
+func optionalTimestamp(t time.Time) *timestamppb.Timestamp {
+	if t.IsZero() {
+		return nil
+	}
+	return timestamppb.New(t)
+}
+
+// localTime Время из запроса в локальной зоне сервиса (время в БД хранится без зоны), нулевое, если не задано
+func localTime(ts *timestamppb.Timestamp) time.Time {
+	if ts == nil {
+		return time.Time{}
+	}
+	return ts.AsTime().Local()
+}
+
+// deref Значение необязательного поля (нулевое, если не задано)
+func deref[T any](value *T) T {
+	if value == nil {
+		var zero T
+		return zero
+	}
+	return *value
+}
@@ -0,0 +1,79 @@
+// Package grpcserver gRPC API сервиса (pkg/prpb) поверх того же storage и с теми же ошибками, что и HTTP API
+package grpcserver
+
+import (
+	"context"
+	"log/slog"
+	"runtime/debug"
+	"time"
+
+	"github.com/sudo-odner/Backend-trainee-assignment-avito-2025/internal/auth"
+	"github.com/sudo-odner/Backend-trainee-assignment-avito-2025/internal/config"
+	"github.com/sudo-odner/Backend-trainee-assignment-avito-2025/internal/domain"
+	"github.com/sudo-odner/Backend-trainee-assignment-avito-2025/internal/storage/postgresql"
+	"github.com/sudo-odner/Backend-trainee-assignment-avito-2025/internal/transport"
+	"github.com/sudo-odner/Backend-trainee-assignment-avito-2025/pkg/prpb"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+)
+
+// metadataActor Metadata с инициатором изменения (попадает в аудит, учитывается для admin токена)
+const metadataActor = "x-actor"
+
+type Server struct {
+	prpb.UnimplementedPRServiceServer
+
+	log     *slog.Logger
+	storage *postgresql.Storage
+	cfg     *config.Config
+	auth    *auth.Authenticator
+	// Как часто поток событий PR проверяет новые события
+	eventPollInterval time.Duration
+}
+
+func New(log *slog.Logger, storage *postgresql.Storage, cfg *config.Config) *grpc.Server {
+	s := &Server{
+		log:     log,
+		storage: storage,
+		cfg:     cfg,
+		auth:    auth.New(storage, cfg.Auth.AdminToken),
+
+		eventPollInterval: pollInterval(log, cfg.GRPCServer.EventPollInterval),
+	}
+	srv := grpc.NewServer(
+		grpc.ChainUnaryInterceptor(s.recoverUnary, s.authenticateUnary),
+		grpc.ChainStreamInterceptor(s.recoverStream, s.authenticateStream),
+	)
+	prpb.RegisterPRServiceServer(srv, s)
+	return srv
+}
+
+// recoverUnary Ответ SERVER_ERROR при panic в обработчике
+func (s *Server) recoverUnary(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (resp any, err error) {
+	defer func() {
+		if rec := recover(); rec != nil {
+			s.log.Error("handler panic", "panic", rec, "method", info.FullMethod, "stack", string(debug.Stack()))
+			err = errorStatus(transport.SERVER_ERROR, "internal server error")
+		}
+	}()
+	return handler(ctx, req)
+}
+
+func (s *Server) recoverStream(srv any, stream grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) (err error) {
+	defer func() {
+		if rec := recover(); rec != nil {
+			s.log.Error("handler panic", "panic", rec, "method", info.FullMethod, "stack", string(debug.Stack()))
+			err = errorStatus(transport.SERVER_ERROR, "internal server error")
+		}
+	}()
+	return handler(srv, stream)
+}
+
+// changeMeta Инициатор изменения из запроса (токен не-admin всегда действует от имени своего пользователя)
+func changeMeta(ctx context.Context) domain.ChangeMeta {
+	var actor string
+	if values := metadata.ValueFromIncomingContext(ctx, metadataActor); len(values) > 0 {
+		actor = values[0]
+	}
+	return auth.ChangeMeta(ctx, actor)
+}
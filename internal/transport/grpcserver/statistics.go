@@ -0,0 +1,90 @@
+package grpcserver
+
+import (
+	"context"
+
+	"github.com/sudo-odner/Backend-trainee-assignment-avito-2025/internal/domain"
+	"github.com/sudo-odner/Backend-trainee-assignment-avito-2025/pkg/prpb"
+	"google.golang.org/protobuf/types/known/durationpb"
+)
+
+// GetReviewStats Число назначений на ревью по пользователям
+func (s *Server) GetReviewStats(ctx context.Context, req *prpb.GetReviewStatsRequest) (*prpb.GetReviewStatsResponse, error) {
+	filter, err := statFilter(req.GetFilter())
+	if err != nil {
+		return nil, err
+	}
+	stat, err := s.storage.GetReviewStat(filter)
+	if err != nil {
+		return nil, s.handleError("failed get review stat", err)
+	}
+
+	reviewersStat := make([]*prpb.ReviewStat, 0, len(stat))
+	for _, reviewer := range stat {
+		reviewersStat = append(reviewersStat, &prpb.ReviewStat{
+			UserId:      reviewer.UserID,
+			ReviewCount: int32(reviewer.ReviewCount),
+		})
+	}
+	return &prpb.GetReviewStatsResponse{ReviewStat: reviewersStat}, nil
+}
+
+// GetTeamStats Статистика по командам
+func (s *Server) GetTeamStats(ctx context.Context, req *prpb.GetTeamStatsRequest) (*prpb.GetTeamStatsResponse, error) {
+	filter, err := statFilter(req.GetFilter())
+	if err != nil {
+		return nil, err
+	}
+	stat, err := s.storage.GetTeamStat(filter)
+	if err != nil {
+		return nil, s.handleError("failed get team stat", err)
+	}
+
+	teamsStat := make([]*prpb.TeamStat, 0, len(stat))
+	for _, team := range stat {
+		teamsStat = append(teamsStat, &prpb.TeamStat{
+			TeamName:           team.TeamName,
+			Members:            int32(team.Members),
+			ActiveMembers:      int32(team.ActiveMembers),
+			PullRequests:       int32(team.PullRequests),
+			OpenPullRequests:   int32(team.OpenPullRequests),
+			MergedPullRequests: int32(team.MergedPullRequests),
+			ReviewAssignments:  int32(team.ReviewAssignments),
+			AvgReviewersPerPr:  team.AvgReviewersPerPR,
+		})
+	}
+	return &prpb.GetTeamStatsResponse{TeamStat: teamsStat}, nil
+}
+
+// GetPullRequestStats Статистика по PR и время до merge
+func (s *Server) GetPullRequestStats(ctx context.Context, req *prpb.GetPullRequestStatsRequest) (*prpb.GetPullRequestStatsResponse, error) {
+	filter, err := statFilter(req.GetFilter())
+	if err != nil {
+		return nil, err
+	}
+	stat, err := s.storage.GetPRStat(filter)
+	if err != nil {
+		return nil, s.handleError("failed get PR stat", err)
+	}
+	return &prpb.GetPullRequestStatsResponse{
+		PullRequests:       int32(stat.PullRequests),
+		OpenPullRequests:   int32(stat.OpenPullRequests),
+		MergedPullRequests: int32(stat.MergedPullRequests),
+		AvgReviewersPerPr:  stat.AvgReviewersPerPR,
+		TimeToMergeP50:     durationpb.New(stat.TimeToMergeP50),
+		TimeToMergeP90:     durationpb.New(stat.TimeToMergeP90),
+		TimeToMergeP99:     durationpb.New(stat.TimeToMergeP99),
+	}, nil
+}
+
+// statFilter Фильтры статистики из запроса (nil - без фильтров)
+func statFilter(req *prpb.StatFilter) (domain.StatFilter, error) {
+	filter := domain.StatFilter{
+		From:     localTime(req.GetFrom()),
+		To:       localTime(req.GetTo()),
+		TeamName: req.GetTeamName(),
+	}
+	var err error
+	filter.Status, err = statusFromPB(req.GetStatus())
+	return filter, err
+}
@@ -0,0 +1,70 @@
+package grpcserver
+
+import (
+	"context"
+
+	"github.com/sudo-odner/Backend-trainee-assignment-avito-2025/internal/domain"
+	"github.com/sudo-odner/Backend-trainee-assignment-avito-2025/pkg/prpb"
+)
+
+// AddTeam Создание команды с участниками (создаёт/обновляет пользователей)
+func (s *Server) AddTeam(ctx context.Context, req *prpb.AddTeamRequest) (*prpb.AddTeamResponse, error) {
+	if req.GetTeam().GetTeamName() == "" {
+		return nil, errBadParam("team_name")
+	}
+
+	users := make([]domain.User, 0, len(req.GetTeam().GetMembers()))
+	for _, member := range req.GetTeam().GetMembers() {
+		if member.GetUserId() == "" || member.GetUsername() == "" {
+			return nil, errBadParam("members")
+		}
+		users = append(users, domain.User{ID: member.GetUserId(), Name: member.GetUsername(), IsActive: member.GetIsActive()})
+	}
+
+	team := domain.Team{Name: req.GetTeam().GetTeamName(), Users: users}
+	if err := s.storage.CreateTeamWithUser(team.Name, users, changeMeta(ctx)); err != nil {
+		return nil, s.handleError("failed to create team", err)
+	}
+	return &prpb.AddTeamResponse{Team: pbTeam(team)}, nil
+}
+
+// GetTeam Команда с участниками
+func (s *Server) GetTeam(ctx context.Context, req *prpb.GetTeamRequest) (*prpb.GetTeamResponse, error) {
+	if req.GetTeamName() == "" {
+		return nil, errBadParam("team_name")
+	}
+	team, err := s.storage.GetTeam(req.GetTeamName())
+	if err != nil {
+		return nil, s.handleError("failed to get team", err)
+	}
+	return &prpb.GetTeamResponse{Team: pbTeam(*team)}, nil
+}
+
+// DeactivateTeam Деактивация всех участников команды
+func (s *Server) DeactivateTeam(ctx context.Context, req *prpb.DeactivateTeamRequest) (*prpb.DeactivateTeamResponse, error) {
+	if req.GetTeamName() == "" {
+		return nil, errBadParam("team_name")
+	}
+	if err := s.auth.TeamScope(ctx, req.GetTeamName()); err != nil {
+		return nil, s.handleError("team is out of token scope", err)
+	}
+
+	count, err := s.storage.DeactivateTeamUsers(req.GetTeamName(), changeMeta(ctx))
+	if err != nil {
+		return nil, s.handleError("failed to deactivate team users", err)
+	}
+	return &prpb.DeactivateTeamResponse{TeamName: req.GetTeamName(), DeactivateCount: int32(count)}, nil
+}
+
+// pbTeam Команда в формате protobuf
+func pbTeam(team domain.Team) *prpb.Team {
+	members := make([]*prpb.TeamMember, 0, len(team.Users))
+	for _, user := range team.Users {
+		members = append(members, &prpb.TeamMember{
+			UserId:   user.ID,
+			Username: user.Name,
+			IsActive: user.IsActive,
+		})
+	}
+	return &prpb.Team{TeamName: team.Name, Members: members}
+}
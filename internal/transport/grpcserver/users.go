@@ -0,0 +1,76 @@
+package grpcserver
+
+import (
+	"context"
+
+	"github.com/sudo-odner/Backend-trainee-assignment-avito-2025/internal/transport"
+	"github.com/sudo-odner/Backend-trainee-assignment-avito-2025/pkg/prpb"
+)
+
+// SetUserActive Установка флага активности пользователя
+func (s *Server) SetUserActive(ctx context.Context, req *prpb.SetUserActiveRequest) (*prpb.SetUserActiveResponse, error) {
+	if req.GetUserId() == "" {
+		return nil, errBadParam("user_id")
+	}
+	if err := s.auth.UserScope(ctx, req.GetUserId()); err != nil {
+		return nil, s.handleError("failed to check user scope", err)
+	}
+
+	user, err := s.storage.GetUserByID(req.GetUserId())
+	if err != nil {
+		return nil, s.handleError("failed get user by id", err)
+	}
+	if err := s.storage.SetUserIsActive(req.GetUserId(), req.GetIsActive(), changeMeta(ctx)); err != nil {
+		return nil, s.handleError("failed set user is_active", err)
+	}
+	teamName, err := s.storage.GetUserTeamByID(user.ID)
+	if err != nil {
+		return nil, s.handleError("failed get user team", err)
+	}
+
+	return &prpb.SetUserActiveResponse{
+		User: &prpb.User{
+			UserId:   user.ID,
+			Username: user.Name,
+			TeamName: teamName,
+			IsActive: req.GetIsActive(),
+		},
+	}, nil
+}
+
+// GetUserReviews Очередь пользователя: PR, где он reviewer (role=reviewer, по умолчанию) или автор (role=author)
+func (s *Server) GetUserReviews(ctx context.Context, req *prpb.GetUserReviewsRequest) (*prpb.GetUserReviewsResponse, error) {
+	if req.GetUserId() == "" {
+		return nil, errBadParam("user_id")
+	}
+	filter, err := newPRFilter(req.GetStatus(), req.GetDesc(), req.GetCursor(), req.GetLimit())
+	if err != nil {
+		return nil, err
+	}
+	switch req.GetRole() {
+	case "", "reviewer":
+		filter.ReviewerID = req.GetUserId()
+	case "author":
+		filter.AuthorID = req.GetUserId()
+	default:
+		return nil, errBadParam("role")
+	}
+
+	if _, err := s.storage.GetUserByID(req.GetUserId()); err != nil {
+		return nil, s.handleError("failed get user by id", err)
+	}
+
+	prs, next, err := s.storage.ListPRs(filter)
+	if err != nil {
+		return nil, s.handleError("failed get user PRs", err)
+	}
+	pullRequests := make([]*prpb.PullRequest, 0, len(prs))
+	for _, pr := range prs {
+		pullRequests = append(pullRequests, pbPullRequest(*pr))
+	}
+	return &prpb.GetUserReviewsResponse{
+		UserId:       req.GetUserId(),
+		PullRequests: pullRequests,
+		NextCursor:   deref(transport.EncodePRCursor(next)),
+	}, nil
+}
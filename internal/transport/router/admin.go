@@ -0,0 +1,65 @@
+package router
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"log/slog"
+	"net/http"
+	"time"
+
+	"github.com/sudo-odner/Backend-trainee-assignment-avito-2025/internal/backup"
+	"github.com/sudo-odner/Backend-trainee-assignment-avito-2025/internal/domain"
+	"github.com/sudo-odner/Backend-trainee-assignment-avito-2025/internal/transport"
+	"github.com/sudo-odner/Backend-trainee-assignment-avito-2025/internal/transport/api"
+	"github.com/sudo-odner/Backend-trainee-assignment-avito-2025/pkg/logger/sl"
+)
+
+// maxArchiveSize Ограничение размера архива для восстановления
+const maxArchiveSize = 256 << 20
+
+// GetAdminBackup Резервная копия всех данных сервиса
+func (router *Router) GetAdminBackup(ctx context.Context, request api.GetAdminBackupRequestObject) (api.GetAdminBackupResponseObject, error) {
+	snapshot, err := router.storage.DumpSnapshot()
+	if err != nil {
+		return nil, fmt.Errorf("failed to dump snapshot: %w", err)
+	}
+	return backupResponse{log: router.log, snapshot: snapshot, createdAt: time.Now()}, nil
+}
+
+// backupResponse Архив, который пишется сразу в ответ
+type backupResponse struct {
+	log       *slog.Logger
+	snapshot  *domain.Snapshot
+	createdAt time.Time
+}
+
+func (b backupResponse) VisitGetAdminBackupResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("Content-Disposition",
+		"attachment; filename=\"backup-"+b.createdAt.Format("20060102-150405")+".json\"")
+	w.WriteHeader(http.StatusOK)
+	// Статус уже отправлен, ошибку можно только залогировать
+	if err := backup.Write(w, b.snapshot, b.createdAt); err != nil {
+		b.log.Error("failed to write backup", sl.Err(err))
+	}
+	return nil
+}
+
+// PostAdminRestore Восстановление данных из резервной копии
+func (router *Router) PostAdminRestore(ctx context.Context, request api.PostAdminRestoreRequestObject) (api.PostAdminRestoreResponseObject, error) {
+	snapshot, err := backup.Read(bytes.NewReader(*request.Body))
+	if err != nil {
+		return nil, &responseError{status: http.StatusBadRequest, code: transport.BAD_REQUEST, message: err.Error()}
+	}
+
+	if err := router.storage.RestoreSnapshot(snapshot, changeMetaFrom(ctx)); err != nil {
+		return nil, fmt.Errorf("failed to restore backup: %w", err)
+	}
+	return api.PostAdminRestore200JSONResponse{
+		Users:        len(snapshot.Users),
+		Teams:        len(snapshot.Teams),
+		PullRequests: len(snapshot.PullRequests),
+		Reviewers:    len(snapshot.Reviewers),
+	}, nil
+}
@@ -0,0 +1,65 @@
+package router
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/sudo-odner/Backend-trainee-assignment-avito-2025/internal/domain"
+	"github.com/sudo-odner/Backend-trainee-assignment-avito-2025/internal/transport/api"
+)
+
+const (
+	defaultAuditLimit = 100
+	maxAuditLimit     = 1000
+)
+
+// GetAudit Журнал изменений с фильтрами по PR, пользователю, команде, окну [from, to) и курсором after_id
+func (router *Router) GetAudit(ctx context.Context, request api.GetAuditRequestObject) (api.GetAuditResponseObject, error) {
+	params := request.Params
+	filter := domain.AuditFilter{
+		PullRequestID: deref(params.PullRequestId),
+		UserID:        deref(params.UserId),
+		TeamName:      deref(params.TeamName),
+		From:          localTime(params.From),
+		To:            localTime(params.To),
+		AfterID:       deref(params.AfterId),
+		Limit:         defaultAuditLimit,
+	}
+	if params.Limit != nil {
+		if *params.Limit <= 0 || *params.Limit > maxAuditLimit {
+			return nil, errBadParam("limit")
+		}
+		filter.Limit = *params.Limit
+	}
+
+	events, err := router.storage.GetAuditEvents(filter)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get audit events: %w", err)
+	}
+	apiEvents := make([]api.AuditEvent, 0, len(events))
+	for _, event := range events {
+		apiEvents = append(apiEvents, apiAuditEvent(event))
+	}
+	// Курсор на следующую страницу, если страница заполнена целиком
+	var nextAfterID *int64
+	if len(events) == filter.Limit {
+		nextAfterID = &events[len(events)-1].ID
+	}
+	return api.GetAudit200JSONResponse{Events: apiEvents, NextAfterId: nextAfterID}, nil
+}
+
+// apiAuditEvent Событие аудита в формате спецификации
+func apiAuditEvent(event domain.AuditEvent) api.AuditEvent {
+	return api.AuditEvent{
+		EventId:       event.ID,
+		Type:          event.Type,
+		PullRequestId: optionalString(event.PullRequestID),
+		UserIds:       event.UserIDs,
+		TeamName:      optionalString(event.TeamName),
+		Actor:         event.Actor,
+		Reason:        optionalString(event.Reason),
+		Before:        optionalChange(event.Before),
+		After:         optionalChange(event.After),
+		CreatedAt:     event.CreatedAt,
+	}
+}
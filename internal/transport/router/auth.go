@@ -0,0 +1,94 @@
+package router
+
+import (
+	"errors"
+	"net/http"
+	"strings"
+
+	"github.com/sudo-odner/Backend-trainee-assignment-avito-2025/internal/auth"
+	"github.com/sudo-odner/Backend-trainee-assignment-avito-2025/internal/domain"
+	"github.com/sudo-odner/Backend-trainee-assignment-avito-2025/internal/storage"
+	"github.com/sudo-odner/Backend-trainee-assignment-avito-2025/internal/transport"
+	"github.com/sudo-odner/Backend-trainee-assignment-avito-2025/pkg/logger/sl"
+)
+
+// routeAccess Правила доступа по "METHOD /path" ("/*" - все маршруты группы). Маршруты без правила доступны только admin
+var routeAccess = map[string]auth.Access{
+	"POST /team/deactivate":      auth.AccessTeamLead,
+	"POST /team/rebalance":       auth.AccessTeamLead,
+	"POST /team/import":          auth.AccessTeamLead,
+	"POST /users/setIsActive":    auth.AccessTeamLead,
+	"GET /team/get":              auth.AccessUser,
+	"GET /users/getReview":       auth.AccessUser,
+	"GET /pullRequest/get":       auth.AccessUser,
+	"GET /pullRequest/list":      auth.AccessUser,
+	"GET /pullRequest/declines":  auth.AccessUser,
+	"GET /pullRequest/overdue":   auth.AccessUser,
+	"POST /pullRequest/create":   auth.AccessUser,
+	"POST /pullRequest/reassign": auth.AccessUser,
+	"POST /pullRequest/decline":  auth.AccessUser,
+	"GET /statistic/*":           auth.AccessUser,
+	"GET /export/*":              auth.AccessUser,
+	"POST /graphql":              auth.AccessUser,
+	"POST /integrations/*":       auth.AccessPublic,
+	"GET /metrics":               auth.AccessPublic,
+	"GET /openapi.yaml":          auth.AccessPublic,
+	"GET /docs":                  auth.AccessPublic,
+	"GET /docs/*":                auth.AccessPublic,
+}
+
+// routeAccessFor Правило для маршрута запроса
+func routeAccessFor(method, path string) auth.Access {
+	if rule, ok := routeAccess[method+" "+path]; ok {
+		return rule
+	}
+	for i := strings.LastIndex(path, "/"); i > 0; i = strings.LastIndex(path, "/") {
+		path = path[:i]
+		if rule, ok := routeAccess[method+" "+path+"/*"]; ok {
+			return rule
+		}
+	}
+	return auth.AccessAdmin
+}
+
+// authenticate Проверка bearer токена и роли по правилам routeAccess
+func (router *Router) authenticate(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		rule := routeAccessFor(r.Method, r.URL.Path)
+		if !router.cfg.Auth.Enabled || rule == auth.AccessPublic {
+			next.ServeHTTP(w, r)
+			return
+		}
+
+		principal, err := router.principal(r)
+		if err != nil {
+			if !errors.Is(err, storage.ErrTokenNotFound) {
+				router.log.Error("failed to check api token", sl.Err(err))
+				router.writeError(w, r, http.StatusInternalServerError, transport.SERVER_ERROR, "failed to check api token")
+				return
+			}
+			w.Header().Set("WWW-Authenticate", `Bearer realm="service-pr"`)
+			router.writeError(w, r, http.StatusUnauthorized, transport.UNAUTHORIZED, "missing or invalid api token")
+			return
+		}
+		if !rule.Allows(principal.Role) {
+			router.respondError(w, r, errOutOfScope("insufficient role"))
+			return
+		}
+		next.ServeHTTP(w, r.WithContext(auth.WithPrincipal(r.Context(), principal)))
+	})
+}
+
+// principal Владелец токена из заголовка Authorization
+func (router *Router) principal(r *http.Request) (*domain.Principal, error) {
+	token, ok := strings.CutPrefix(r.Header.Get("Authorization"), "Bearer ")
+	if !ok {
+		return nil, storage.ErrTokenNotFound
+	}
+	return router.auth.Authenticate(strings.TrimSpace(token))
+}
+
+// errOutOfScope Отказ 403 при действии вне области токена
+func errOutOfScope(message string) error {
+	return &auth.ScopeError{Message: message}
+}
@@ -0,0 +1,100 @@
+package router
+
+import (
+	"io"
+	"log/slog"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/sudo-odner/Backend-trainee-assignment-avito-2025/internal/auth"
+	"github.com/sudo-odner/Backend-trainee-assignment-avito-2025/internal/config"
+	"github.com/sudo-odner/Backend-trainee-assignment-avito-2025/internal/domain"
+	"github.com/sudo-odner/Backend-trainee-assignment-avito-2025/internal/storage/storagetest"
+)
+
+func TestRouteAccessFor(t *testing.T) {
+	tests := []struct {
+		method string
+		path   string
+		want   auth.Access
+	}{
+		{method: http.MethodPost, path: "/team/deactivate", want: auth.AccessTeamLead},
+		{method: http.MethodGet, path: "/team/get", want: auth.AccessUser},
+		{method: http.MethodPost, path: "/pullRequest/create", want: auth.AccessUser},
+		// Правило группы "/*" действует на вложенные маршруты
+		{method: http.MethodGet, path: "/statistic/reviews", want: auth.AccessUser},
+		{method: http.MethodGet, path: "/export/assignments.ndjson", want: auth.AccessUser},
+		{method: http.MethodPost, path: "/integrations/github/webhook", want: auth.AccessPublic},
+		{method: http.MethodGet, path: "/docs/swagger-ui/index.html", want: auth.AccessPublic},
+		{method: http.MethodGet, path: "/docs", want: auth.AccessPublic},
+		// Маршруты без правила и другие методы известных маршрутов доступны только admin
+		{method: http.MethodPost, path: "/team/add", want: auth.AccessAdmin},
+		{method: http.MethodPost, path: "/auth/tokens/create", want: auth.AccessAdmin},
+		{method: http.MethodGet, path: "/audit", want: auth.AccessAdmin},
+		{method: http.MethodGet, path: "/admin/backup", want: auth.AccessAdmin},
+		{method: http.MethodPost, path: "/statistic/reviews", want: auth.AccessAdmin},
+		{method: http.MethodGet, path: "/pullRequest/create", want: auth.AccessAdmin},
+		{method: http.MethodGet, path: "/statistic", want: auth.AccessAdmin},
+		{method: http.MethodGet, path: "/", want: auth.AccessAdmin},
+	}
+	for _, tt := range tests {
+		if got := routeAccessFor(tt.method, tt.path); got != tt.want {
+			t.Errorf("routeAccessFor(%s %s) = %d, want %d", tt.method, tt.path, got, tt.want)
+		}
+	}
+}
+
+func TestAuthenticateRole(t *testing.T) {
+	tokens := storagetest.New()
+	for _, token := range []domain.APIToken{
+		{Name: "user-token", Role: domain.RoleUser, UserID: "u1"},
+		{Name: "team-lead-token", Role: domain.RoleTeamLead, UserID: "u2", TeamName: "backend"},
+	} {
+		if _, err := tokens.CreateAPIToken(token.Name, auth.HashToken(token.Name), token.Role, token.UserID, token.TeamName); err != nil {
+			t.Fatal(err)
+		}
+	}
+	cfg := &config.Config{}
+	cfg.Auth.Enabled = true
+	router := &Router{
+		log:  slog.New(slog.NewTextHandler(io.Discard, nil)),
+		cfg:  cfg,
+		auth: auth.New(tokens, "admin-token"),
+	}
+	handler := router.authenticate(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if auth.PrincipalFrom(r.Context()) == nil {
+			t.Errorf("%s %s: principal is not set", r.Method, r.URL.Path)
+		}
+	}))
+
+	tests := []struct {
+		name       string
+		method     string
+		target     string
+		token      string
+		wantStatus int
+	}{
+		{name: "admin on admin route", method: http.MethodPost, target: "/team/add", token: "admin-token", wantStatus: http.StatusOK},
+		{name: "team lead on admin route", method: http.MethodPost, target: "/team/add", token: "team-lead-token", wantStatus: http.StatusForbidden},
+		{name: "user on admin route", method: http.MethodGet, target: "/audit", token: "user-token", wantStatus: http.StatusForbidden},
+		{name: "team lead on team lead route", method: http.MethodPost, target: "/team/deactivate", token: "team-lead-token", wantStatus: http.StatusOK},
+		{name: "user on team lead route", method: http.MethodPost, target: "/team/deactivate", token: "user-token", wantStatus: http.StatusForbidden},
+		{name: "user on user route", method: http.MethodGet, target: "/statistic/reviews", token: "user-token", wantStatus: http.StatusOK},
+		{name: "without token", method: http.MethodGet, target: "/team/get", wantStatus: http.StatusUnauthorized},
+		{name: "unknown token", method: http.MethodGet, target: "/team/get", token: "other-token", wantStatus: http.StatusUnauthorized},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := httptest.NewRequest(tt.method, tt.target, nil)
+			if tt.token != "" {
+				req.Header.Set("Authorization", "Bearer "+tt.token)
+			}
+			rec := httptest.NewRecorder()
+			handler.ServeHTTP(rec, req)
+			if rec.Code != tt.wantStatus {
+				t.Errorf("status = %d, want %d", rec.Code, tt.wantStatus)
+			}
+		})
+	}
+}
@@ -0,0 +1,126 @@
+package router
+
+import (
+	"errors"
+	"log/slog"
+	"net/http"
+	"runtime/debug"
+
+	"github.com/go-chi/chi/v5/middleware"
+	"github.com/go-chi/render"
+	"github.com/sudo-odner/Backend-trainee-assignment-avito-2025/internal/auth"
+	"github.com/sudo-odner/Backend-trainee-assignment-avito-2025/internal/transport"
+	"github.com/sudo-odner/Backend-trainee-assignment-avito-2025/internal/transport/api"
+	"github.com/sudo-odner/Backend-trainee-assignment-avito-2025/pkg/logger/sl"
+)
+
+// responseError Ошибка с готовыми статусом и кодом ответа (права, заголовки и параметры запроса)
+type responseError struct {
+	status  int
+	code    string
+	message string
+}
+
+func (e *responseError) Error() string {
+	return e.message
+}
+
+// errBadParam Некорректный или отсутствующий параметр запроса
+func errBadParam(name string) error {
+	return &responseError{status: http.StatusBadRequest, code: transport.BAD_REQUEST, message: "invalid " + name}
+}
+
+// writeError Ответ с ошибкой в формате спецификации: {"error": {"code", "message", "request_id"}}
+func (router *Router) writeError(w http.ResponseWriter, r *http.Request, status int, code, message string) {
+	// Статус через render: WriteHeader до render.JSON отправил бы ответ без Content-Type
+	render.Status(r, status)
+	render.JSON(w, r, transport.ErrResponse{
+		Error: transport.ErrBody{
+			Code:      code,
+			Message:   message,
+			RequestID: middleware.GetReqID(r.Context()),
+		},
+	})
+}
+
+// storageError Ответ по ошибке storage: известные ошибки по storageErrors, остальные - 500 без внутренних деталей
+func (router *Router) storageError(w http.ResponseWriter, r *http.Request, message string, err error) {
+	router.log.Error(message, sl.Err(err), "request_id", middleware.GetReqID(r.Context()))
+	if known, ok := transport.StorageError(err); ok {
+		router.writeError(w, r, known.Status, known.Code, known.Message)
+		return
+	}
+	router.writeError(w, r, http.StatusInternalServerError, transport.SERVER_ERROR, message)
+}
+
+// respondError Ответ по ошибке обработчика: responseError как есть, отказ по области токена - 403, остальное через storageError
+func (router *Router) respondError(w http.ResponseWriter, r *http.Request, err error) {
+	var (
+		respErr  *responseError
+		scopeErr *auth.ScopeError
+	)
+	switch {
+	case errors.As(err, &respErr):
+		router.log.Error("request rejected", slog.String("path", r.URL.Path), sl.Err(err),
+			"request_id", middleware.GetReqID(r.Context()))
+		router.writeError(w, r, respErr.status, respErr.code, respErr.message)
+		return
+	case errors.As(err, &scopeErr):
+		router.log.Error("request rejected", slog.String("path", r.URL.Path), sl.Err(err),
+			"request_id", middleware.GetReqID(r.Context()))
+		router.writeError(w, r, http.StatusForbidden, transport.FORBIDDEN, scopeErr.Message)
+		return
+	}
+	router.storageError(w, r, "failed to handle request", err)
+}
+
+// requestError Ответ на запрос, который не удалось разобрать по спецификации (тело или параметры)
+func (router *Router) requestError(w http.ResponseWriter, r *http.Request, err error) {
+	router.log.Error("failed to decode request", slog.String("path", r.URL.Path), sl.Err(err),
+		"request_id", middleware.GetReqID(r.Context()))
+	message := "failed to decode request"
+	var (
+		formatErr   *api.InvalidParamFormatError
+		requiredErr *api.RequiredParamError
+		headerErr   *api.RequiredHeaderError
+	)
+	switch {
+	case errors.As(err, &formatErr):
+		message = "invalid " + formatErr.ParamName
+	case errors.As(err, &requiredErr):
+		message = "invalid " + requiredErr.ParamName
+	case errors.As(err, &headerErr):
+		message = "invalid " + headerErr.ParamName
+	}
+	router.writeError(w, r, http.StatusBadRequest, transport.BAD_REQUEST, message)
+}
+
+// recoverer Ответ 500 в формате спецификации при panic в обработчике
+func (router *Router) recoverer(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		defer func() {
+			rec := recover()
+			if rec == nil {
+				return
+			}
+			// Прерывание ответа обрабатывает сам net/http
+			if rec == http.ErrAbortHandler {
+				panic(rec)
+			}
+			router.log.Error("handler panic", "panic", rec, "request_id", middleware.GetReqID(r.Context()),
+				"stack", string(debug.Stack()))
+			router.writeError(w, r, http.StatusInternalServerError, transport.SERVER_ERROR, "internal server error")
+		}()
+		next.ServeHTTP(w, r)
+	})
+}
+
+// notFound Ответ на неизвестный маршрут
+func (router *Router) notFound(w http.ResponseWriter, r *http.Request) {
+	router.writeError(w, r, http.StatusNotFound, transport.NOT_FOUND, "route not found")
+}
+
+// methodNotAllowed Ответ на неподдерживаемый метод маршрута
+func (router *Router) methodNotAllowed(w http.ResponseWriter, r *http.Request) {
+	router.writeError(w, r, http.StatusMethodNotAllowed, transport.BAD_REQUEST, "method not allowed")
+}
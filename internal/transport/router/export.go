@@ -0,0 +1,295 @@
+package router
+
+import (
+	"context"
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"io"
+	"log/slog"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/sudo-odner/Backend-trainee-assignment-avito-2025/internal/domain"
+	"github.com/sudo-odner/Backend-trainee-assignment-avito-2025/internal/transport/api"
+	"github.com/sudo-odner/Backend-trainee-assignment-avito-2025/pkg/logger/sl"
+)
+
+// Форматы выгрузки
+const (
+	formatCSV    = "csv"
+	formatNDJSON = "ndjson"
+)
+
+// exportWriter Построчная запись выгрузки в ответ
+type exportWriter interface {
+	// Write Запись одной строки: колонки для CSV, объект для NDJSON
+	Write(columns []string, record any) error
+	Flush() error
+}
+
+type csvExportWriter struct {
+	w *csv.Writer
+}
+
+func (e *csvExportWriter) Write(columns []string, _ any) error {
+	return e.w.Write(columns)
+}
+
+func (e *csvExportWriter) Flush() error {
+	e.w.Flush()
+	return e.w.Error()
+}
+
+type ndjsonExportWriter struct {
+	enc *json.Encoder
+}
+
+func (e *ndjsonExportWriter) Write(_ []string, record any) error {
+	return e.enc.Encode(record)
+}
+
+func (e *ndjsonExportWriter) Flush() error {
+	return nil
+}
+
+// exportFormat Формат выгрузки: query параметр format, затем заголовок Accept (по умолчанию CSV)
+func exportFormat(ctx context.Context, format string) (string, error) {
+	switch format {
+	case formatCSV, formatNDJSON:
+		return format, nil
+	case "":
+	default:
+		return "", errBadParam("format")
+	}
+	accept := acceptFrom(ctx)
+	if strings.Contains(accept, "application/x-ndjson") || strings.Contains(accept, "application/ndjson") {
+		return formatNDJSON, nil
+	}
+	return formatCSV, nil
+}
+
+// exportResponse Выгрузка, строки которой пишутся сразу в ответ по мере чтения из storage
+type exportResponse struct {
+	log    *slog.Logger
+	name   string
+	format string
+	// header Заголовок CSV
+	header []string
+	write  func(exp exportWriter) error
+}
+
+func (e exportResponse) VisitGetExportReviewsResponse(w http.ResponseWriter) error {
+	return e.visit(w)
+}
+
+func (e exportResponse) VisitGetExportPullRequestsResponse(w http.ResponseWriter) error {
+	return e.visit(w)
+}
+
+func (e exportResponse) VisitGetExportAssignmentsResponse(w http.ResponseWriter) error {
+	return e.visit(w)
+}
+
+// visit Запись заголовков и строк выгрузки (статус уже отправлен, ошибку можно только залогировать)
+func (e exportResponse) visit(w http.ResponseWriter) error {
+	out := io.Writer(w)
+	if flusher, ok := w.(http.Flusher); ok {
+		out = flushWriter{w: w, flusher: flusher}
+	}
+
+	var exp exportWriter
+	if e.format == formatNDJSON {
+		w.Header().Set("Content-Type", "application/x-ndjson")
+		w.Header().Set("Content-Disposition", "attachment; filename=\""+e.name+".ndjson\"")
+		w.WriteHeader(http.StatusOK)
+		exp = &ndjsonExportWriter{enc: json.NewEncoder(out)}
+	} else {
+		w.Header().Set("Content-Type", "text/csv; charset=utf-8")
+		w.Header().Set("Content-Disposition", "attachment; filename=\""+e.name+".csv\"")
+		w.WriteHeader(http.StatusOK)
+		exp = &csvExportWriter{w: csv.NewWriter(out)}
+		// Заголовок CSV пишется сразу, даже если строк не будет
+		if err := exp.Write(e.header, nil); err != nil {
+			e.log.Error("failed to write export header", slog.String("export", e.name), sl.Err(err))
+		}
+	}
+
+	err := e.write(exp)
+	if err == nil {
+		err = exp.Flush()
+	}
+	if err != nil {
+		e.log.Error("export interrupted", slog.String("export", e.name), sl.Err(err))
+	}
+	return nil
+}
+
+// flushWriter Отправка клиенту каждого записанного блока
+type flushWriter struct {
+	w       io.Writer
+	flusher http.Flusher
+}
+
+func (f flushWriter) Write(p []byte) (int, error) {
+	n, err := f.w.Write(p)
+	f.flusher.Flush()
+	return n, err
+}
+
+// GetExportReviews Выгрузка назначений по пользователям
+func (router *Router) GetExportReviews(ctx context.Context, request api.GetExportReviewsRequestObject) (api.GetExportReviewsResponseObject, error) {
+	type record struct {
+		UserID      string `json:"user_id"`
+		ReviewCount int    `json:"review_count"`
+	}
+
+	params := request.Params
+	filter, err := newStatFilter(params.From, params.To, params.TeamName, string(deref(params.Status)))
+	if err != nil {
+		return nil, err
+	}
+	format, err := exportFormat(ctx, string(deref(params.Format)))
+	if err != nil {
+		return nil, err
+	}
+
+	return exportResponse{
+		log:    router.log,
+		name:   "reviews",
+		format: format,
+		header: []string{"user_id", "review_count"},
+		write: func(exp exportWriter) error {
+			return router.storage.EachReviewStat(filter, func(stat domain.UserReviewStat) error {
+				return exp.Write(
+					[]string{stat.UserID, strconv.Itoa(stat.ReviewCount)},
+					record{UserID: stat.UserID, ReviewCount: stat.ReviewCount})
+			})
+		},
+	}, nil
+}
+
+// GetExportPullRequests Выгрузка PR (без author_id - всех PR)
+func (router *Router) GetExportPullRequests(ctx context.Context, request api.GetExportPullRequestsRequestObject) (api.GetExportPullRequestsResponseObject, error) {
+	type record struct {
+		PullRequestID     string   `json:"pull_request_id"`
+		PullRequestName   string   `json:"pull_request_name"`
+		AuthorID          string   `json:"author_id"`
+		Status            string   `json:"status"`
+		AssignedReviewers []string `json:"assigned_reviewers"`
+		CreatedAt         *string  `json:"created_at"`
+		MergedAt          *string  `json:"merged_at"`
+	}
+
+	format, err := exportFormat(ctx, string(deref(request.Params.Format)))
+	if err != nil {
+		return nil, err
+	}
+	authorID := deref(request.Params.AuthorId)
+	if authorID != "" {
+		if _, err := router.storage.GetUserByID(authorID); err != nil {
+			return nil, fmt.Errorf("failed get user by id: %w", err)
+		}
+	}
+
+	return exportResponse{
+		log:    router.log,
+		name:   "pull_requests",
+		format: format,
+		header: []string{
+			"pull_request_id", "pull_request_name", "author_id", "status", "assigned_reviewers", "created_at", "merged_at",
+		},
+		write: func(exp exportWriter) error {
+			return router.storage.EachPullRequest(authorID, func(pr *domain.PullRequest) error {
+				reviewers := make([]string, 0, len(pr.Reviewers))
+				for _, reviewer := range pr.Reviewers {
+					reviewers = append(reviewers, reviewer.ID)
+				}
+				createdAt := formatOptionalTime(pr.CreatedAt)
+				mergedAt := formatOptionalTime(pr.MergedAt)
+				return exp.Write(
+					[]string{
+						pr.ID, pr.Name, pr.Author.ID, pr.Status, strings.Join(reviewers, ";"),
+						stringOrEmpty(createdAt), stringOrEmpty(mergedAt),
+					},
+					record{
+						PullRequestID:     pr.ID,
+						PullRequestName:   pr.Name,
+						AuthorID:          pr.Author.ID,
+						Status:            pr.Status,
+						AssignedReviewers: reviewers,
+						CreatedAt:         createdAt,
+						MergedAt:          mergedAt,
+					})
+			})
+		},
+	}, nil
+}
+
+// GetExportAssignments Выгрузка истории назначений - событий аудита по reviewer
+func (router *Router) GetExportAssignments(ctx context.Context, request api.GetExportAssignmentsRequestObject) (api.GetExportAssignmentsResponseObject, error) {
+	params := request.Params
+	format, err := exportFormat(ctx, string(deref(params.Format)))
+	if err != nil {
+		return nil, err
+	}
+	filter := domain.AuditFilter{
+		PullRequestID: deref(params.PullRequestId),
+		UserID:        deref(params.UserId),
+		TeamName:      deref(params.TeamName),
+		From:          localTime(params.From),
+		To:            localTime(params.To),
+		Types: []string{
+			domain.AuditReviewerAssigned,
+			domain.AuditReviewerReassigned,
+			domain.AuditReviewerDeclined,
+			domain.AuditReviewerRemoved,
+		},
+	}
+
+	return exportResponse{
+		log:    router.log,
+		name:   "assignments",
+		format: format,
+		header: []string{
+			"event_id", "created_at", "type", "pull_request_id", "team_name", "user_ids", "actor", "reason", "before", "after",
+		},
+		write: func(exp exportWriter) error {
+			return router.storage.EachAuditEvent(filter, func(event domain.AuditEvent) error {
+				before, err := json.Marshal(event.Before)
+				if err != nil {
+					return err
+				}
+				after, err := json.Marshal(event.After)
+				if err != nil {
+					return err
+				}
+				return exp.Write(
+					[]string{
+						strconv.FormatInt(event.ID, 10), event.CreatedAt.Format(time.RFC3339), event.Type,
+						event.PullRequestID, event.TeamName, strings.Join(event.UserIDs, ";"),
+						event.Actor, event.Reason, string(before), string(after),
+					},
+					apiAuditEvent(event))
+			})
+		},
+	}, nil
+}
+
+// formatOptionalTime Время в RFC3339 или nil, если не задано
+func formatOptionalTime(t time.Time) *string {
+	if t.IsZero() {
+		return nil
+	}
+	formatted := t.Format(time.RFC3339)
+	return &formatted
+}
+
+func stringOrEmpty(value *string) string {
+	if value == nil {
+		return ""
+	}
+	return *value
+}
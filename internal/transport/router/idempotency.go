@@ -0,0 +1,143 @@
+package router
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"io"
+	"log/slog"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/go-chi/chi/v5/middleware"
+	"github.com/sudo-odner/Backend-trainee-assignment-avito-2025/internal/auth"
+	"github.com/sudo-odner/Backend-trainee-assignment-avito-2025/internal/domain"
+	"github.com/sudo-odner/Backend-trainee-assignment-avito-2025/internal/transport"
+	"github.com/sudo-odner/Backend-trainee-assignment-avito-2025/pkg/logger/sl"
+)
+
+const (
+	// HeaderIdempotencyKey Ключ идемпотентности POST запроса
+	HeaderIdempotencyKey = "Idempotency-Key"
+	// HeaderIdempotentReplayed Признак повторно отданного сохранённого ответа
+	HeaderIdempotentReplayed = "Idempotent-Replayed"
+
+	maxIdempotencyKeyLength = 255
+	maxIdempotentBodySize   = 10 << 20
+)
+
+// noIdempotentReplay Маршруты, ответы которых не сохраняются: ответ на создание токена содержит секрет,
+// а токены хранятся только в виде хеша
+var noIdempotentReplay = []string{"/auth/tokens/"}
+
+// idempotency Повтор POST запроса тем же клиентом с тем же Idempotency-Key (маршрут и тело совпадают)
+// получает сохранённый ответ
+func (router *Router) idempotency(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		key := r.Header.Get(HeaderIdempotencyKey)
+		if r.Method != http.MethodPost || key == "" || !idempotentReplayAllowed(r.URL.Path) {
+			next.ServeHTTP(w, r)
+			return
+		}
+		if len(key) > maxIdempotencyKeyLength {
+			router.badHeader(w, r, HeaderIdempotencyKey)
+			return
+		}
+
+		// Тело нужно и для хеша, и обработчику
+		body, err := io.ReadAll(http.MaxBytesReader(w, r.Body, maxIdempotentBodySize))
+		if err != nil {
+			router.log.Error("failed to read request body", sl.Err(err))
+			router.writeError(w, r, http.StatusBadRequest, transport.BAD_REQUEST, "failed to read request body")
+			return
+		}
+		r.Body = io.NopCloser(bytes.NewReader(body))
+		sum := sha256.Sum256(body)
+		bodyHash := hex.EncodeToString(sum[:])
+		route := r.URL.Path
+		// Ключ действует в пределах клиента: чужой повтор не должен получить ответ в обход проверок области токена
+		principal := idempotencyPrincipal(auth.PrincipalFrom(r.Context()))
+
+		stored, err := router.storage.ReserveIdempotencyKey(key, principal, route, bodyHash, time.Now(), router.cfg.Idempotency.TTL)
+		if err != nil {
+			router.storageError(w, r, "failed to reserve idempotency key", err)
+			return
+		}
+		if stored != nil {
+			router.replayIdempotent(w, r, stored, bodyHash)
+			return
+		}
+
+		// Первый запрос: сохраняем ответ для повторов
+		var buf bytes.Buffer
+		ww := middleware.NewWrapResponseWriter(w, r.ProtoMajor)
+		ww.Tee(&buf)
+		defer func() {
+			// Ошибки сервера (и panic до ответа) не сохраняем - повтор должен выполниться заново
+			if ww.Status() == 0 || ww.Status() >= http.StatusInternalServerError {
+				if err := router.storage.ReleaseIdempotencyKey(key, principal, route); err != nil {
+					router.log.Error("failed to release idempotency key", sl.Err(err))
+				}
+				return
+			}
+			if err := router.storage.CompleteIdempotencyKey(
+				key, principal, route, ww.Status(), ww.Header().Get("Content-Type"), buf.Bytes()); err != nil {
+				router.log.Error("failed to store idempotent response", sl.Err(err))
+			}
+		}()
+		next.ServeHTTP(ww, r)
+	})
+}
+
+// idempotentReplayAllowed Можно ли сохранять ответ маршрута для повторов
+func idempotentReplayAllowed(path string) bool {
+	for _, prefix := range noIdempotentReplay {
+		if strings.HasPrefix(path, prefix) {
+			return false
+		}
+	}
+	return true
+}
+
+// idempotencyPrincipal Владелец ключа идемпотентности: токен из БД по id, токен из конфига по имени
+// (без аутентификации - общий для всех клиентов)
+func idempotencyPrincipal(principal *domain.Principal) string {
+	switch {
+	case principal == nil:
+		return ""
+	case principal.TokenID != 0:
+		return "token:" + strconv.FormatInt(principal.TokenID, 10)
+	default:
+		return "config:" + principal.Name
+	}
+}
+
+// replayIdempotent Ответ на повтор: сохранённый ответ или конфликт
+func (router *Router) replayIdempotent(w http.ResponseWriter, r *http.Request, stored *domain.IdempotentResponse, bodyHash string) {
+	if stored.BodyHash != bodyHash {
+		router.log.Warn("idempotency key reused with different body", slog.String("path", r.URL.Path))
+		router.writeError(w, r, http.StatusConflict, transport.IDEMPOTENCY_CONFLICT, "idempotency key already used with a different request body")
+		return
+	}
+	if stored.InProgress {
+		router.writeError(w, r, http.StatusConflict, transport.IDEMPOTENCY_CONFLICT, "request with this idempotency key is still in progress")
+		return
+	}
+
+	if stored.ContentType != "" {
+		w.Header().Set("Content-Type", stored.ContentType)
+	}
+	w.Header().Set(HeaderIdempotentReplayed, "true")
+	w.WriteHeader(stored.StatusCode)
+	if _, err := w.Write(stored.Body); err != nil {
+		router.log.Error("failed to replay idempotent response", sl.Err(err))
+	}
+}
+
+// badHeader Ответ на некорректный заголовок
+func (router *Router) badHeader(w http.ResponseWriter, r *http.Request, name string) {
+	router.log.Error("invalid header", slog.String("header", name))
+	router.writeError(w, r, http.StatusBadRequest, transport.BAD_REQUEST, "invalid "+name)
+}
@@ -0,0 +1,46 @@
+package router
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/sudo-odner/Backend-trainee-assignment-avito-2025/internal/domain"
+)
+
+func TestIdempotencySkipsTokenRoutes(t *testing.T) {
+	// Без storage: любое обращение к ключам идемпотентности упадёт
+	router := &Router{}
+	called := false
+	handler := router.idempotency(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		called = true
+		w.WriteHeader(http.StatusCreated)
+	}))
+
+	req := httptest.NewRequest(http.MethodPost, "/auth/tokens/create", strings.NewReader(`{"name":"ci"}`))
+	req.Header.Set(HeaderIdempotencyKey, "retry-1")
+	rec := httptest.NewRecorder()
+	handler.ServeHTTP(rec, req)
+
+	if !called || rec.Code != http.StatusCreated {
+		t.Errorf("token route: handler called = %v, status = %d", called, rec.Code)
+	}
+}
+
+func TestIdempotencyPrincipal(t *testing.T) {
+	principals := []*domain.Principal{
+		nil,
+		{Name: "admin", Role: domain.RoleAdmin},
+		{TokenID: 1, Name: "ci", Role: domain.RoleUser, UserID: "u1"},
+		{TokenID: 2, Name: "ci", Role: domain.RoleUser, UserID: "u2"},
+	}
+	seen := make(map[string]bool)
+	for _, principal := range principals {
+		scope := idempotencyPrincipal(principal)
+		if seen[scope] {
+			t.Errorf("idempotencyPrincipal(%+v) = %q is shared with another client", principal, scope)
+		}
+		seen[scope] = true
+	}
+}
@@ -0,0 +1,209 @@
+package router
+
+import (
+	"crypto/subtle"
+	"errors"
+	"io"
+	"net/http"
+
+	"github.com/go-chi/render"
+	"github.com/sudo-odner/Backend-trainee-assignment-avito-2025/internal/domain"
+	"github.com/sudo-odner/Backend-trainee-assignment-avito-2025/internal/integration"
+	"github.com/sudo-odner/Backend-trainee-assignment-avito-2025/internal/storage"
+	"github.com/sudo-odner/Backend-trainee-assignment-avito-2025/internal/transport"
+	"github.com/sudo-odner/Backend-trainee-assignment-avito-2025/internal/webhook"
+	"github.com/sudo-odner/Backend-trainee-assignment-avito-2025/pkg/logger/sl"
+)
+
+const (
+	providerGitHub = "github"
+	providerGitLab = "gitlab"
+	// Максимальный размер тела входящего webhook
+	maxIntegrationBody = 5 << 20
+)
+
+var errLoginNotMapped = errors.New("login is not mapped to user")
+
+type integrationResponse struct {
+	DeliveryID    string `json:"delivery_id"`
+	Action        string `json:"action"`
+	PullRequestID string `json:"pull_request_id,omitempty"`
+	Result        string `json:"result"`
+}
+
+func (router *Router) GitHubPOSTWebhook(w http.ResponseWriter, r *http.Request) {
+	body, err := io.ReadAll(io.LimitReader(r.Body, maxIntegrationBody))
+	if err != nil {
+		router.log.Error("failed to read request", sl.Err(err))
+		router.writeError(w, r, http.StatusBadRequest, transport.BAD_REQUEST, "failed to read request")
+		return
+	}
+
+	// Проверка подписи GitHub (без настроенного секрета webhook не принимаются)
+	secret := router.cfg.Integrations.GitHub.Secret
+	if secret == "" || !webhook.Verify(secret, body, r.Header.Get(integration.GitHubHeaderSignature)) {
+		router.log.Error("invalid github webhook signature")
+		router.writeError(w, r, http.StatusUnauthorized, transport.UNAUTHORIZED, "invalid signature")
+		return
+	}
+
+	deliveryID := r.Header.Get(integration.GitHubHeaderDelivery)
+	eventType := r.Header.Get(integration.GitHubHeaderEvent)
+	if deliveryID == "" {
+		router.log.Error("github webhook without delivery id")
+		router.writeError(w, r, http.StatusBadRequest, transport.BAD_REQUEST, "missing delivery id")
+		return
+	}
+
+	// Нас интересуют только события pull_request (ping приходит при создании webhook)
+	if eventType != "pull_request" {
+		render.JSON(w, r, integrationResponse{
+			DeliveryID: deliveryID,
+			Action:     eventType,
+			Result:     "ignored",
+		})
+		return
+	}
+
+	event, err := integration.ParseGitHubPullRequest(body)
+	if err != nil {
+		router.log.Error("failed to parse github event", sl.Err(err))
+		router.writeError(w, r, http.StatusBadRequest, transport.BAD_REQUEST, "failed to parse event")
+		return
+	}
+
+	router.applyPREvent(w, r, providerGitHub, deliveryID, event, router.cfg.Integrations.GitHub.Users[event.AuthorLogin])
+}
+
+func (router *Router) GitLabPOSTWebhook(w http.ResponseWriter, r *http.Request) {
+	// Проверка секретного токена GitLab
+	token := r.Header.Get(integration.GitLabHeaderToken)
+	expected := router.cfg.Integrations.GitLab.Token
+	if expected == "" || subtle.ConstantTimeCompare([]byte(token), []byte(expected)) != 1 {
+		router.log.Error("invalid gitlab webhook token")
+		router.writeError(w, r, http.StatusUnauthorized, transport.UNAUTHORIZED, "invalid token")
+		return
+	}
+
+	body, err := io.ReadAll(io.LimitReader(r.Body, maxIntegrationBody))
+	if err != nil {
+		router.log.Error("failed to read request", sl.Err(err))
+		router.writeError(w, r, http.StatusBadRequest, transport.BAD_REQUEST, "failed to read request")
+		return
+	}
+
+	deliveryID := r.Header.Get(integration.GitLabHeaderEventUUID)
+	eventType := r.Header.Get(integration.GitLabHeaderEvent)
+	if deliveryID == "" {
+		router.log.Error("gitlab webhook without event uuid")
+		router.writeError(w, r, http.StatusBadRequest, transport.BAD_REQUEST, "missing event uuid")
+		return
+	}
+
+	// Нас интересуют только события merge request
+	if eventType != integration.GitLabMergeRequest {
+		render.JSON(w, r, integrationResponse{
+			DeliveryID: deliveryID,
+			Action:     eventType,
+			Result:     "ignored",
+		})
+		return
+	}
+
+	event, err := integration.ParseGitLabMergeRequest(body)
+	if err != nil {
+		router.log.Error("failed to parse gitlab event", sl.Err(err))
+		router.writeError(w, r, http.StatusBadRequest, transport.BAD_REQUEST, "failed to parse event")
+		return
+	}
+
+	authorID := router.cfg.Integrations.GitLab.Users[event.AuthorLogin]
+	if event.AuthorLogin == "" {
+		authorID = router.cfg.Integrations.GitLab.UserIDs[event.AuthorID]
+	}
+	router.applyPREvent(w, r, providerGitLab, deliveryID, event, authorID)
+}
+
+// applyPREvent Идемпотентное применение события PR из внешней системы (authorID - автор в сервисе, пусто - не сопоставлен)
+func (router *Router) applyPREvent(
+	w http.ResponseWriter, r *http.Request,
+	provider, deliveryID string, event *integration.PREvent, authorID string,
+) {
+	// Повторная доставка того же события не обрабатывается
+	isNew, err := router.storage.RegisterIntegrationDelivery(provider, deliveryID)
+	if err != nil {
+		router.log.Error("failed to register delivery", sl.Err(err))
+		router.writeError(w, r, http.StatusInternalServerError, transport.SERVER_ERROR, "failed to register delivery")
+		return
+	}
+	if !isNew {
+		render.JSON(w, r, integrationResponse{
+			DeliveryID:    deliveryID,
+			Action:        string(event.Action),
+			PullRequestID: event.PullRequestID,
+			Result:        "duplicate",
+		})
+		return
+	}
+
+	result, err := router.handlePREvent(event, authorID, domain.ChangeMeta{Actor: "integration:" + provider})
+	if err != nil {
+		// Даём внешней системе возможность повторить доставку
+		if err := router.storage.ForgetIntegrationDelivery(provider, deliveryID); err != nil {
+			router.log.Error("failed to forget delivery", sl.Err(err))
+		}
+		if errors.Is(err, errLoginNotMapped) {
+			router.log.Error("author login is not mapped", sl.Err(err))
+			router.writeError(w, r, http.StatusUnprocessableEntity, transport.BAD_REQUEST, "author login is not mapped to user")
+			return
+		}
+		if errors.Is(err, storage.ErrUserNotFound) || errors.Is(err, storage.ErrTeamNotFound) || errors.Is(err, storage.ErrPRNotFound) {
+			router.log.Error("PR, user or team not found", sl.Err(err))
+			router.writeError(w, r, http.StatusNotFound, transport.NOT_FOUND, "resource not found")
+			return
+		}
+		router.log.Error("failed to apply PR event", sl.Err(err))
+		router.writeError(w, r, http.StatusInternalServerError, transport.SERVER_ERROR, "failed to apply PR event")
+		return
+	}
+
+	render.JSON(w, r, integrationResponse{
+		DeliveryID:    deliveryID,
+		Action:        string(event.Action),
+		PullRequestID: event.PullRequestID,
+		Result:        result,
+	})
+}
+
+// handlePREvent Применение события PR теми же методами storage, что и HTTP ручки
+func (router *Router) handlePREvent(event *integration.PREvent, authorID string, meta domain.ChangeMeta) (string, error) {
+	switch event.Action {
+	case integration.ActionOpen:
+		if authorID == "" {
+			return "", errLoginNotMapped
+		}
+		_, err := router.storage.CreatePRWithReviewers(event.PullRequestID, event.PullRequestName, authorID, meta)
+		if errors.Is(err, storage.ErrPRAlreadyExists) {
+			return "exists", nil
+		}
+		if err != nil {
+			return "", err
+		}
+		return "created", nil
+	case integration.ActionMerge:
+		err := router.storage.MergePR(event.PullRequestID, meta)
+		if errors.Is(err, storage.ErrPRAlreadyMerged) {
+			return "already_merged", nil
+		}
+		if err != nil {
+			return "", err
+		}
+		return "merged", nil
+	case integration.ActionUpdate:
+		// Название и другие поля PR из внешней системы не синхронизируются
+		return "unsupported", nil
+	default:
+		// Закрытие без merge и прочие действия не меняют состояние PR в сервисе
+		return "ignored", nil
+	}
+}
@@ -0,0 +1,49 @@
+package router
+
+import (
+	"bytes"
+	"io"
+	"log/slog"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/sudo-odner/Backend-trainee-assignment-avito-2025/internal/config"
+	"github.com/sudo-odner/Backend-trainee-assignment-avito-2025/internal/integration"
+	"github.com/sudo-odner/Backend-trainee-assignment-avito-2025/internal/webhook"
+)
+
+func TestGitHubPOSTWebhookSignature(t *testing.T) {
+	body := []byte(`{"action":"opened","number":42,"repository":{"full_name":"a/b"}}`)
+
+	tests := []struct {
+		name      string
+		secret    string
+		signature string
+	}{
+		// Без секрета подпись с пустым ключом может посчитать кто угодно
+		{name: "secret not configured", secret: "", signature: webhook.Sign("", body)},
+		{name: "wrong signature", secret: "secret", signature: webhook.Sign("other", body)},
+		{name: "missing signature", secret: "secret"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cfg := &config.Config{}
+			cfg.Integrations.GitHub.Secret = tt.secret
+			router := &Router{log: slog.New(slog.NewTextHandler(io.Discard, nil)), cfg: cfg}
+
+			req := httptest.NewRequest(http.MethodPost, "/integrations/github/webhook", bytes.NewReader(body))
+			req.Header.Set(integration.GitHubHeaderEvent, "pull_request")
+			req.Header.Set(integration.GitHubHeaderDelivery, "72d3162e-cc78-11e3-81ab-4c9367dc0958")
+			if tt.signature != "" {
+				req.Header.Set(integration.GitHubHeaderSignature, tt.signature)
+			}
+			rec := httptest.NewRecorder()
+			router.GitHubPOSTWebhook(rec, req)
+
+			if rec.Code != http.StatusUnauthorized {
+				t.Errorf("status = %d, want %d", rec.Code, http.StatusUnauthorized)
+			}
+		})
+	}
+}
@@ -0,0 +1,28 @@
+package router
+
+import (
+	"fmt"
+	"net/http"
+	"sort"
+)
+
+// MetricsGET Метрики сервиса в текстовом формате Prometheus
+func (router *Router) MetricsGET(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("Content-Type", "text/plain; version=0.0.4; charset=utf-8")
+	w.WriteHeader(http.StatusOK)
+
+	_, _ = fmt.Fprintln(w, "# HELP service_pr_rate_limited_total Requests rejected by the rate limiter.")
+	_, _ = fmt.Fprintln(w, "# TYPE service_pr_rate_limited_total counter")
+	if router.limiter == nil {
+		return
+	}
+	throttled := router.limiter.Throttled()
+	routes := make([]string, 0, len(throttled))
+	for route := range throttled {
+		routes = append(routes, route)
+	}
+	sort.Strings(routes)
+	for _, route := range routes {
+		_, _ = fmt.Fprintf(w, "service_pr_rate_limited_total{route=%q} %d\n", route, throttled[route])
+	}
+}
@@ -0,0 +1,98 @@
+package router
+
+import (
+	"context"
+	"errors"
+	"net/http"
+
+	"github.com/getkin/kin-openapi/openapi3"
+	"github.com/getkin/kin-openapi/openapi3filter"
+	"github.com/getkin/kin-openapi/routers"
+	"github.com/getkin/kin-openapi/routers/legacy"
+	"github.com/go-chi/chi/v5/middleware"
+	"github.com/sudo-odner/Backend-trainee-assignment-avito-2025/internal/transport"
+	"github.com/sudo-odner/Backend-trainee-assignment-avito-2025/pkg/logger/sl"
+	"github.com/sudo-odner/Backend-trainee-assignment-avito-2025/task"
+)
+
+const (
+	// SpecPath Путь, по которому отдаётся спецификация API (URLFormat маршрутизирует его как /openapi)
+	SpecPath = "/openapi.yaml"
+	// DocsPath Путь страницы Swagger UI
+	DocsPath = "/docs"
+)
+
+// newSpecRouter Поиск операций встроенной спецификации по запросу
+func newSpecRouter() (routers.Router, error) {
+	doc, err := openapi3.NewLoader().LoadFromData(task.OpenAPI)
+	if err != nil {
+		return nil, err
+	}
+	if err := doc.Validate(context.Background()); err != nil {
+		return nil, err
+	}
+	return legacy.NewRouter(doc)
+}
+
+// OpenAPIGET Встроенная спецификация API
+func (router *Router) OpenAPIGET(w http.ResponseWriter, r *http.Request) {
+	if format, _ := r.Context().Value(middleware.URLFormatCtxKey).(string); format != "yaml" && format != "yml" {
+		router.notFound(w, r)
+		return
+	}
+	w.Header().Set("Content-Type", "application/yaml")
+	w.WriteHeader(http.StatusOK)
+	if _, err := w.Write(task.OpenAPI); err != nil {
+		router.log.Error("failed to write spec", sl.Err(err))
+	}
+}
+
+// validateRequest Проверка параметров и тела запроса по спецификации (маршруты вне спецификации пропускаются)
+func (router *Router) validateRequest(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		route, pathParams, err := router.specRouter.FindRoute(r)
+		if err != nil {
+			next.ServeHTTP(w, r)
+			return
+		}
+
+		err = openapi3filter.ValidateRequest(r.Context(), &openapi3filter.RequestValidationInput{
+			Request:    r,
+			PathParams: pathParams,
+			Route:      route,
+			Options: &openapi3filter.Options{
+				// Токены проверяет authenticate
+				AuthenticationFunc: openapi3filter.NoopAuthenticationFunc,
+			},
+		})
+		if err != nil {
+			router.log.Error("request does not match spec", sl.Err(err))
+			router.writeError(w, r, http.StatusBadRequest, transport.BAD_REQUEST, specErrorMessage(err))
+			return
+		}
+		next.ServeHTTP(w, r)
+	})
+}
+
+// specErrorMessage Краткое описание нарушения спецификации без дампа схемы
+func specErrorMessage(err error) string {
+	var reqErr *openapi3filter.RequestError
+	if !errors.As(err, &reqErr) {
+		return "request does not match spec"
+	}
+	reason := reqErr.Reason
+	var schemaErr *openapi3.SchemaError
+	if errors.As(reqErr.Err, &schemaErr) {
+		reason = schemaErr.Reason
+		if path := schemaErr.JSONPointer(); len(path) > 0 {
+			reason = "field " + path[len(path)-1] + ": " + reason
+		}
+	}
+	if reason == "" && reqErr.Err != nil {
+		reason = reqErr.Err.Error()
+	}
+	if reqErr.Parameter != nil {
+		return "invalid parameter " + reqErr.Parameter.Name + ": " + reason
+	}
+	return "invalid request body: " + reason
+}
@@ -0,0 +1,127 @@
+package router
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"log/slog"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/getkin/kin-openapi/openapi3filter"
+	"github.com/sudo-odner/Backend-trainee-assignment-avito-2025/internal/config"
+	"github.com/sudo-odner/Backend-trainee-assignment-avito-2025/internal/storage/storagetest"
+)
+
+// testStorage Хранилище в памяти; не реализованные им методы Storage паникуют, recoverer отвечает 500
+type testStorage struct {
+	*storagetest.Storage
+	unimplementedStorage
+}
+
+type unimplementedStorage struct {
+	Storage
+}
+
+func newTestStorage() *testStorage {
+	return &testStorage{Storage: storagetest.New()}
+}
+
+// TestResponsesMatchSpec Статус, заголовки и тело ответов роутера соответствуют task/openapi.yml
+func TestResponsesMatchSpec(t *testing.T) {
+	openapi3filter.RegisterBodyDecoder("application/x-ndjson", openapi3filter.FileBodyDecoder)
+	specRouter, err := newSpecRouter()
+	if err != nil {
+		t.Fatalf("newSpecRouter() error = %v", err)
+	}
+
+	// Случаи выполняются по порядку на общих данных (merge с If-Match ждёт версию после merge)
+	tests := []struct {
+		name       string
+		method     string
+		target     string
+		body       string
+		header     map[string]string
+		wantStatus int
+	}{
+		{name: "team add", method: http.MethodPost, target: "/team/add",
+			body:       `{"team_name": "mobile", "members": [{"user_id": "u4", "username": "Dave", "is_active": true}]}`,
+			wantStatus: http.StatusCreated},
+		{name: "team exists", method: http.MethodPost, target: "/team/add",
+			body: `{"team_name": "backend", "members": []}`, wantStatus: http.StatusBadRequest},
+		{name: "team get", method: http.MethodGet, target: "/team/get?team_name=backend", wantStatus: http.StatusOK},
+		{name: "team not found", method: http.MethodGet, target: "/team/get?team_name=missing", wantStatus: http.StatusNotFound},
+		{name: "set is active", method: http.MethodPost, target: "/users/setIsActive",
+			body: `{"user_id": "u2", "is_active": false}`, wantStatus: http.StatusOK},
+		{name: "user reviews", method: http.MethodGet, target: "/users/getReview?user_id=u2", wantStatus: http.StatusOK},
+		{name: "PR create", method: http.MethodPost, target: "/pullRequest/create",
+			body: `{"pull_request_id": "pr-3", "pull_request_name": "Docs", "author_id": "u2"}`, wantStatus: http.StatusCreated},
+		{name: "PR exists", method: http.MethodPost, target: "/pullRequest/create",
+			body: `{"pull_request_id": "pr-1", "pull_request_name": "Feature", "author_id": "u1"}`, wantStatus: http.StatusConflict},
+		{name: "PR merge", method: http.MethodPost, target: "/pullRequest/merge",
+			body: `{"pull_request_id": "pr-1"}`, wantStatus: http.StatusOK},
+		{name: "PR merge stale version", method: http.MethodPost, target: "/pullRequest/merge",
+			body: `{"pull_request_id": "pr-1"}`, header: map[string]string{"If-Match": `"1"`}, wantStatus: http.StatusPreconditionFailed},
+		{name: "PR get", method: http.MethodGet, target: "/pullRequest/get?pull_request_id=pr-1", wantStatus: http.StatusOK},
+		{name: "PR list", method: http.MethodGet, target: "/pullRequest/list?status=OPEN", wantStatus: http.StatusOK},
+		{name: "PR list bad limit", method: http.MethodGet, target: "/pullRequest/list?limit=0", wantStatus: http.StatusBadRequest},
+		{name: "review stat", method: http.MethodGet, target: "/statistic/reviews", wantStatus: http.StatusOK},
+		{name: "export csv", method: http.MethodGet, target: "/export/reviews", wantStatus: http.StatusOK},
+		{name: "export ndjson", method: http.MethodGet, target: "/export/reviews",
+			header: map[string]string{"Accept": "application/x-ndjson"}, wantStatus: http.StatusOK},
+		{name: "audit", method: http.MethodGet, target: "/audit?pull_request_id=pr-1", wantStatus: http.StatusOK},
+		{name: "webhooks", method: http.MethodGet, target: "/webhooks/list", wantStatus: http.StatusOK},
+		{name: "webhook bad url", method: http.MethodPost, target: "/webhooks/add",
+			body: `{"url": "hook", "secret": "s"}`, wantStatus: http.StatusBadRequest},
+		{name: "token create", method: http.MethodPost, target: "/auth/tokens/create",
+			body: `{"name": "ci", "role": "user", "user_id": "u1"}`, wantStatus: http.StatusCreated},
+		{name: "tokens", method: http.MethodGet, target: "/auth/tokens/list", wantStatus: http.StatusOK},
+	}
+
+	cfg := &config.Config{}
+	cfg.OpenAPI.ValidateRequests = true
+	handler := New(slog.New(slog.NewTextHandler(io.Discard, nil)), newTestStorage(), cfg)
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := httptest.NewRequest(tt.method, tt.target, strings.NewReader(tt.body))
+			if tt.body != "" {
+				req.Header.Set("Content-Type", "application/json")
+			}
+			for name, value := range tt.header {
+				req.Header.Set(name, value)
+			}
+			rec := httptest.NewRecorder()
+			handler.ServeHTTP(rec, req)
+			// Заголовки на момент отправки статуса, а не после записи тела
+			res := rec.Result()
+			body, _ := io.ReadAll(res.Body)
+
+			if res.StatusCode != tt.wantStatus {
+				t.Fatalf("status = %d, want %d: %s", res.StatusCode, tt.wantStatus, body)
+			}
+			route, pathParams, err := specRouter.FindRoute(req)
+			if err != nil {
+				t.Fatalf("FindRoute() error = %v", err)
+			}
+			err = openapi3filter.ValidateResponse(context.Background(), &openapi3filter.ResponseValidationInput{
+				RequestValidationInput: &openapi3filter.RequestValidationInput{
+					Request:    req,
+					PathParams: pathParams,
+					Route:      route,
+				},
+				Status: res.StatusCode,
+				Header: res.Header,
+				Body:   io.NopCloser(bytes.NewReader(body)),
+				Options: &openapi3filter.Options{
+					IncludeResponseStatus: true,
+				},
+			})
+			if err != nil {
+				t.Errorf("response does not match spec: %v\nContent-Type: %q\n%s", err, res.Header.Get("Content-Type"), body)
+			}
+		})
+	}
+}
@@ -1,282 +1,385 @@
 package router
 
 import (
+	"context"
 	"errors"
-	"net/http"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
 
-	"github.com/go-chi/render"
-	"github.com/go-playground/validator/v10"
+	"github.com/sudo-odner/Backend-trainee-assignment-avito-2025/internal/domain"
 	"github.com/sudo-odner/Backend-trainee-assignment-avito-2025/internal/storage"
 	"github.com/sudo-odner/Backend-trainee-assignment-avito-2025/internal/transport"
-	"github.com/sudo-odner/Backend-trainee-assignment-avito-2025/pkg/logger/sl"
+	"github.com/sudo-odner/Backend-trainee-assignment-avito-2025/internal/transport/api"
 )
 
-func (router *Router) PRPOSTCreate(w http.ResponseWriter, r *http.Request) {
-	type request struct {
-		PullRequestID   string `json:"pull_request_id" validate:"required"`
-		PullRequestName string `json:"pull_request_name" validate:"required"`
-		AuthorID        string `json:"author_id" validate:"required"`
-	}
-	type response struct {
-		PullRequestID     string   `json:"pull_request_id"`
-		PullRequestName   string   `json:"pull_request_name"`
-		AuthorID          string   `json:"author_name"`
-		Status            string   `json:"status"`
-		AssignedReviewers []string `json:"assigned_reviewers"`
+// PostPullRequestCreate Создание PR с автоматическим назначением до 2 reviewer из команды автора
+func (router *Router) PostPullRequestCreate(ctx context.Context, request api.PostPullRequestCreateRequestObject) (api.PostPullRequestCreateResponseObject, error) {
+	req := request.Body
+	switch {
+	case req.PullRequestId == "":
+		return nil, errBadParam("pull_request_id")
+	case req.PullRequestName == "":
+		return nil, errBadParam("pull_request_name")
+	case req.AuthorId == "":
+		return nil, errBadParam("author_id")
+	}
+	if err := router.auth.AuthorScope(ctx, req.AuthorId); err != nil {
+		return nil, err
 	}
 
-	// Декодирование и валидация запроса
-	var req request
-	if err := render.DecodeJSON(r.Body, &req); err != nil {
-		router.log.Error("failed to decode request", sl.Err(err))
-		w.WriteHeader(http.StatusBadRequest)
-		render.JSON(w, r, transport.ErrResponse{
-			Code:    transport.BAD_REQUEST,
-			Message: "failed to decode request",
-		})
-		return
-	}
-	if err := validator.New().Struct(req); err != nil {
-		router.log.Error("failed to validate request", sl.Err(err))
-		w.WriteHeader(http.StatusBadRequest)
-		render.JSON(w, r, transport.ErrResponse{
-			Code:    transport.BAD_REQUEST,
-			Message: "failed to validate request",
-		})
-		return
+	pr, err := router.storage.CreatePRWithReviewers(req.PullRequestId, req.PullRequestName, req.AuthorId, changeMetaFrom(ctx))
+	if err != nil {
+		return nil, fmt.Errorf("failed to create PR: %w", err)
 	}
+	response := api.PostPullRequestCreate201JSONResponse{Headers: api.PostPullRequestCreate201ResponseHeaders{ETag: etag(pr.Version)}}
+	response.Body.Pr = apiPullRequest(*pr)
+	return response, nil
+}
 
-	pr, err := router.storage.CreatePRWithReviewers(req.PullRequestID, req.PullRequestName, req.AuthorID)
+// PostPullRequestMerge Перевод PR в MERGED (идемпотентная операция)
+func (router *Router) PostPullRequestMerge(ctx context.Context, request api.PostPullRequestMergeRequestObject) (api.PostPullRequestMergeResponseObject, error) {
+	req := request.Body
+	if req.PullRequestId == "" {
+		return nil, errBadParam("pull_request_id")
+	}
+	meta := changeMetaFrom(ctx)
+	version, err := parseIfMatch(deref(request.Params.IfMatch))
 	if err != nil {
-		if errors.Is(err, storage.ErrUserNotFound) || errors.Is(err, storage.ErrTeamNotFound) {
-			router.log.Error("Not found user or team", sl.Err(err))
-			w.WriteHeader(http.StatusNotFound)
-			render.JSON(w, r, transport.ErrResponse{
-				Code:    transport.BAD_REQUEST,
-				Message: "resource not found",
-			})
-			return
-		}
-		if errors.Is(err, storage.ErrPRAlreadyExists) {
-			router.log.Error("PR already exists", sl.Err(err))
-			w.WriteHeader(http.StatusConflict)
-			render.JSON(w, r, transport.ErrResponse{
-				Code:    transport.PR_EXISTS,
-				Message: "PR id already exists",
-			})
-			return
-		}
-		router.log.Error("failed to create PR", sl.Err(err))
-		w.WriteHeader(http.StatusInternalServerError)
-		render.JSON(w, r, transport.ErrResponse{
-			Code:    transport.SERVER_ERROR,
-			Message: "failed to create PR",
-		})
-		return
+		return nil, err
 	}
-	assignedReviewers := make([]string, 0, len(pr.Reviewers))
+	meta.IfVersion = version
+
+	// Отметить PR как MERGED (если до этого уже MERGED, время тоже самое(идемпотентная операция)
+	err = router.storage.MergePR(req.PullRequestId, meta)
+	if err != nil && !errors.Is(err, storage.ErrPRAlreadyMerged) {
+		return nil, fmt.Errorf("failed to soft-merge PR: %w", err)
+	}
+	pr, err := router.storage.GetPRByID(req.PullRequestId)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get PR: %w", err)
+	}
+	response := api.PostPullRequestMerge200JSONResponse{Headers: api.PostPullRequestMerge200ResponseHeaders{ETag: etag(pr.Version)}}
+	response.Body.Pr = apiPullRequest(*pr)
+	return response, nil
+}
+
+// PostPullRequestReassign Переназначение reviewer на другого из его команды
+func (router *Router) PostPullRequestReassign(ctx context.Context, request api.PostPullRequestReassignRequestObject) (api.PostPullRequestReassignResponseObject, error) {
+	req := request.Body
+	switch {
+	case req.PullRequestId == "":
+		return nil, errBadParam("pull_request_id")
+	case req.OldReviewerId == "":
+		return nil, errBadParam("old_reviewer_id")
+	}
+	if err := router.auth.ReviewScope(ctx, req.PullRequestId, req.OldReviewerId); err != nil {
+		return nil, err
+	}
+	meta := changeMetaFrom(ctx)
+	version, err := parseIfMatch(deref(request.Params.IfMatch))
+	if err != nil {
+		return nil, err
+	}
+	meta.IfVersion = version
+
+	pr, newReviewer, err := router.storage.ReassignReviewer(req.PullRequestId, req.OldReviewerId, meta)
+	if err != nil {
+		return nil, fmt.Errorf("failed to reassign PR: %w", err)
+	}
+	response := api.PostPullRequestReassign200JSONResponse{Headers: api.PostPullRequestReassign200ResponseHeaders{ETag: etag(pr.Version)}}
+	response.Body.Pr = apiPullRequest(*pr)
+	response.Body.ReplacedBy = newReviewer
+	return response, nil
+}
+
+// apiPullRequest PR в формате спецификации
+func apiPullRequest(pr domain.PullRequest) api.PullRequest {
+	reviewers := make([]string, 0, len(pr.Reviewers))
 	for _, reviewer := range pr.Reviewers {
-		assignedReviewers = append(assignedReviewers, reviewer.ID)
+		reviewers = append(reviewers, reviewer.ID)
 	}
-	w.WriteHeader(http.StatusCreated)
-	render.JSON(w, r, response{
-		PullRequestID:     pr.ID,
+	return api.PullRequest{
+		PullRequestId:     pr.ID,
 		PullRequestName:   pr.Name,
-		AuthorID:          pr.Author.ID,
-		Status:            pr.Status,
-		AssignedReviewers: assignedReviewers,
-	})
+		AuthorId:          pr.Author.ID,
+		Status:            api.PullRequestStatus(pr.Status),
+		AssignedReviewers: reviewers,
+		CreatedAt:         optionalTime(pr.CreatedAt),
+		MergedAt:          optionalTime(pr.MergedAt),
+	}
 }
 
-func (router *Router) PRPOSTMerge(w http.ResponseWriter, r *http.Request) {
-	type request struct {
-		PullRequestID string `json:"pull_request_id" validate:"required"`
+// optionalTime Время или nil, если не задано
+func optionalTime(t time.Time) *time.Time {
+	if t.IsZero() {
+		return nil
 	}
-	type responsePR struct {
-		PullRequestID   string   `json:"pull_request_id"`
-		PullRequestName string   `json:"pull_request_name"`
-		AuthorID        string   `json:"author_id"`
-		Status          string   `json:"status"`
-		AssignedReviews []string `json:"assigned_reviewers"`
-		MergedAt        string   `json:"merged_at"`
+	return &t
+}
+
+// optionalString Строка или nil, если пустая
+func optionalString(s string) *string {
+	if s == "" {
+		return nil
 	}
-	type response struct {
-		PR responsePR `json:"pr"`
+	return &s
+}
+
+// optionalChange Состояние до или после изменения (nil - не задано)
+func optionalChange(change map[string]any) *api.Change {
+	if change == nil {
+		return nil
 	}
+	res := api.Change(change)
+	return &res
+}
 
-	// decode and validation
-	var req request
-	if err := render.DecodeJSON(r.Body, &req); err != nil {
-		router.log.Error("failed to decode request", sl.Err(err))
-		w.WriteHeader(http.StatusBadRequest)
-		render.JSON(w, r, transport.ErrResponse{
-			Code:    transport.BAD_REQUEST,
-			Message: "failed to decode request",
-		})
-		return
-	}
-	if err := validator.New().Struct(req); err != nil {
-		router.log.Error("failed to validate request", sl.Err(err))
-		w.WriteHeader(http.StatusBadRequest)
-		render.JSON(w, r, transport.ErrResponse{
-			Code:    transport.BAD_REQUEST,
-			Message: "failed to validate request",
-		})
-		return
+// localTime Время из параметра в локальной зоне сервиса (время в БД хранится без зоны), нулевое, если не задано
+func localTime(t *time.Time) time.Time {
+	if t == nil {
+		return time.Time{}
 	}
+	return t.Local()
+}
 
-	// Отметить PR как MERGED (если до этого уже MERGED, время тоже самое(идемпотентная операция)
-	err := router.storage.MergePR(req.PullRequestID)
-	if err != nil && !errors.Is(err, storage.ErrPRAlreadyMerged) {
-		if errors.Is(err, storage.ErrPRNotFound) {
-			router.log.Error("PR not found", sl.Err(err))
-			w.WriteHeader(http.StatusNotFound)
-			render.JSON(w, r, transport.ErrResponse{
-				Code:    transport.NOT_FOUND,
-				Message: "resource not found",
-			})
-			return
-		}
-		router.log.Error("failed to soft-merge PR", sl.Err(err))
-		w.WriteHeader(http.StatusInternalServerError)
-		render.JSON(w, r, transport.ErrResponse{
-			Code:    transport.SERVER_ERROR,
-			Message: "failed to soft-merge PR",
-		})
-		return
+// PostPullRequestDecline Отказ reviewer от ревью с подбором замены (исключая всех, кто уже отказывался)
+func (router *Router) PostPullRequestDecline(ctx context.Context, request api.PostPullRequestDeclineRequestObject) (api.PostPullRequestDeclineResponseObject, error) {
+	req := request.Body
+	switch {
+	case req.PullRequestId == "":
+		return nil, errBadParam("pull_request_id")
+	case req.ReviewerId == "":
+		return nil, errBadParam("reviewer_id")
+	case req.Reason == "":
+		return nil, errBadParam("reason")
+	}
+	if err := router.auth.ReviewScope(ctx, req.PullRequestId, req.ReviewerId); err != nil {
+		return nil, err
 	}
-	pr, err := router.storage.GetPRByID(req.PullRequestID)
+	meta := changeMetaFrom(ctx)
+	version, err := parseIfMatch(deref(request.Params.IfMatch))
 	if err != nil {
-		if errors.Is(err, storage.ErrPRNotFound) {
-			router.log.Error("PR not found", sl.Err(err))
-			w.WriteHeader(http.StatusNotFound)
-			render.JSON(w, r, transport.ErrResponse{
-				Code:    transport.NOT_FOUND,
-				Message: "resource not found",
-			})
-			return
-		}
-		router.log.Error("failed to get PR", sl.Err(err))
-		w.WriteHeader(http.StatusInternalServerError)
-		render.JSON(w, r, transport.ErrResponse{
-			Code:    transport.SERVER_ERROR,
-			Message: "failed to get PR",
-		})
-		return
+		return nil, err
 	}
-	reviewers := make([]string, 0, len(pr.Reviewers))
-	for _, reviewer := range pr.Reviewers {
-		reviewers = append(reviewers, reviewer.ID)
+	meta.IfVersion = version
+
+	pr, newReviewer, err := router.storage.DeclineReview(req.PullRequestId, req.ReviewerId, req.Reason, meta)
+	if err != nil {
+		return nil, fmt.Errorf("failed to decline review: %w", err)
+	}
+	declines, err := router.storage.GetPRDeclines(req.PullRequestId)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get PR declines: %w", err)
 	}
-	w.WriteHeader(http.StatusOK)
-	render.JSON(w, r, response{
-		PR: responsePR{
-			PullRequestID:   pr.ID,
-			PullRequestName: pr.Name,
-			AuthorID:        pr.Author.ID,
-			Status:          pr.Status,
-			AssignedReviews: reviewers,
-			MergedAt:        pr.MergedAt.String(),
-		},
+
+	response := api.PostPullRequestDecline200JSONResponse{Headers: api.PostPullRequestDecline200ResponseHeaders{ETag: etag(pr.Version)}}
+	response.Body.Pr = apiPullRequest(*pr)
+	response.Body.ReplacedBy = optionalString(newReviewer)
+	response.Body.Declines = apiDeclines(declines)
+	return response, nil
+}
+
+// GetPullRequestDeclines История отказов от ревью PR
+func (router *Router) GetPullRequestDeclines(ctx context.Context, request api.GetPullRequestDeclinesRequestObject) (api.GetPullRequestDeclinesResponseObject, error) {
+	prID := request.Params.PullRequestId
+	if prID == "" {
+		return nil, errBadParam("pull_request_id")
+	}
+	declines, err := router.storage.GetPRDeclines(prID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get PR declines: %w", err)
+	}
+	return api.GetPullRequestDeclines200JSONResponse{PullRequestId: prID, Declines: apiDeclines(declines)}, nil
+}
+
+// apiDeclines История отказов в формате спецификации
+func apiDeclines(declines []domain.ReviewerDecline) []api.ReviewerDecline {
+	res := make([]api.ReviewerDecline, 0, len(declines))
+	for _, decline := range declines {
+		res = append(res, api.ReviewerDecline{
+			ReviewerId: decline.ReviewerID,
+			Reason:     decline.Reason,
+			ReplacedBy: optionalString(decline.ReplacedBy),
+			DeclinedAt: decline.DeclinedAt,
+		})
+	}
+	return res
+}
+
+// GetPullRequestOverdue Ревью с истёкшим SLA (фильтры по команде и reviewer необязательны)
+func (router *Router) GetPullRequestOverdue(ctx context.Context, request api.GetPullRequestOverdueRequestObject) (api.GetPullRequestOverdueResponseObject, error) {
+	reviews, err := router.storage.GetOverdueReviews(domain.OverdueFilter{
+		DueBefore:  time.Now(),
+		TeamName:   deref(request.Params.TeamName),
+		ReviewerID: deref(request.Params.ReviewerId),
 	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to get overdue reviews: %w", err)
+	}
+
+	apiReviews := make([]api.OverdueReview, 0, len(reviews))
+	for _, review := range reviews {
+		apiReviews = append(apiReviews, api.OverdueReview{
+			PullRequestId:   review.PullRequestID,
+			PullRequestName: review.PullRequestName,
+			AuthorId:        review.AuthorID,
+			ReviewerId:      review.ReviewerID,
+			TeamName:        review.TeamName,
+			AssignedAt:      review.AssignedAt,
+			DueAt:           review.DueAt,
+			OverdueAt:       optionalTime(review.OverdueAt),
+		})
+	}
+	return api.GetPullRequestOverdue200JSONResponse{Reviews: apiReviews}, nil
 }
 
-func (router *Router) PRPOSTReassign(w http.ResponseWriter, r *http.Request) {
-	type request struct {
-		PullRequestID string `json:"pull_request_id" validate:"required"`
-		OldReviewerID string `json:"old_reviewer_id" validate:"required"`
-	}
-	type responsePR struct {
-		PullResuestID   string   `json:"pull_request_id"`
-		PullRequestName string   `json:"pull_request_name"`
-		AuthorID        string   `json:"author_id"`
-		Status          string   `json:"status"`
-		AssignedReviews []string `json:"assigned_reviewers"`
-	}
-	type response struct {
-		PR         responsePR `json:"pr"`
-		ReplacedBy string     `json:"replaced_by"`
-	}
-	// Validation and decode
-	var req request
-	if err := render.DecodeJSON(r.Body, &req); err != nil {
-		router.log.Error("failed to decode request", sl.Err(err))
-		w.WriteHeader(http.StatusBadRequest)
-		render.JSON(w, r, transport.ErrResponse{
-			Code:    transport.BAD_REQUEST,
-			Message: "failed to decode request",
+// GetPullRequestGet PR с reviewer и хронологией назначений, замен и смены статуса из аудита
+func (router *Router) GetPullRequestGet(ctx context.Context, request api.GetPullRequestGetRequestObject) (api.GetPullRequestGetResponseObject, error) {
+	prID := request.Params.PullRequestId
+	if prID == "" {
+		return nil, errBadParam("pull_request_id")
+	}
+	pr, err := router.storage.GetPRByID(prID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get PR: %w", err)
+	}
+	events, err := router.storage.GetAuditEvents(domain.AuditFilter{PullRequestID: prID})
+	if err != nil {
+		return nil, fmt.Errorf("failed to get PR timeline: %w", err)
+	}
+
+	assignedReviewers := make([]string, 0, len(pr.Reviewers))
+	reviewers := make([]api.TeamMember, 0, len(pr.Reviewers))
+	for _, reviewer := range pr.Reviewers {
+		assignedReviewers = append(assignedReviewers, reviewer.ID)
+		reviewers = append(reviewers, api.TeamMember{
+			UserId:   reviewer.ID,
+			Username: reviewer.Name,
+			IsActive: reviewer.IsActive,
 		})
-		return
-	}
-	if err := validator.New().Struct(req); err != nil {
-		router.log.Error("failed to validate request", sl.Err(err))
-		w.WriteHeader(http.StatusBadRequest)
-		render.JSON(w, r, transport.ErrResponse{
-			Code:    transport.BAD_REQUEST,
-			Message: "failed to validate request",
+	}
+	timeline := make([]api.TimelineItem, 0, len(events))
+	for _, event := range events {
+		timeline = append(timeline, api.TimelineItem{
+			At:     event.CreatedAt,
+			Type:   event.Type,
+			Actor:  event.Actor,
+			Reason: optionalString(event.Reason),
+			Before: optionalChange(event.Before),
+			After:  optionalChange(event.After),
 		})
-		return
 	}
-	// TODO: Переназначить ревюера на другого из команды (если это возможно)
-	pr, newReviewer, err := router.storage.ReassignReviewer(req.PullRequestID, req.OldReviewerID)
+
+	response := api.GetPullRequestGet200JSONResponse{Headers: api.GetPullRequestGet200ResponseHeaders{ETag: etag(pr.Version)}}
+	response.Body.Pr = api.PullRequestDetails{
+		PullRequestId:     pr.ID,
+		PullRequestName:   pr.Name,
+		AuthorId:          pr.Author.ID,
+		Status:            api.PullRequestDetailsStatus(pr.Status),
+		AssignedReviewers: assignedReviewers,
+		Reviewers:         reviewers,
+		CreatedAt:         optionalTime(pr.CreatedAt),
+		MergedAt:          optionalTime(pr.MergedAt),
+	}
+	response.Body.Timeline = timeline
+	return response, nil
+}
+
+// GetPullRequestList Список PR по фильтрам с постраничной выдачей
+func (router *Router) GetPullRequestList(ctx context.Context, request api.GetPullRequestListRequestObject) (api.GetPullRequestListResponseObject, error) {
+	params := request.Params
+	filter, err := newPRFilter(string(deref(params.Status)), string(deref(params.Order)), deref(params.Cursor), params.Limit)
 	if err != nil {
-		if errors.Is(err, storage.ErrPRNotFound) || errors.Is(err, storage.ErrUserNotFound) {
-			router.log.Error("PR or user not found", sl.Err(err))
-			w.WriteHeader(http.StatusNotFound)
-			render.JSON(w, r, transport.ErrResponse{
-				Code:    transport.NOT_FOUND,
-				Message: "resource not found",
-			})
-			return
-		}
-		if errors.Is(err, storage.ErrPRAlreadyMerged) {
-			router.log.Error("PR already merged", sl.Err(err))
-			w.WriteHeader(http.StatusConflict)
-			render.JSON(w, r, transport.ErrResponse{
-				Code:    transport.PR_MERGED,
-				Message: "cannot reassign on merged PR",
-			})
-			return
-		}
-		if errors.Is(err, storage.ErrNoCandidate) {
-			router.log.Error("PR no candidate", sl.Err(err))
-			w.WriteHeader(http.StatusConflict)
-			render.JSON(w, r, transport.ErrResponse{
-				Code:    transport.NO_CANDIDATE,
-				Message: "resource not found",
-			})
-			return
+		return nil, err
+	}
+	filter.AuthorID = deref(params.AuthorId)
+	filter.ReviewerID = deref(params.ReviewerId)
+	filter.TeamName = deref(params.TeamName)
+	filter.NameQuery = deref(params.Q)
+	switch sortBy := string(deref(params.Sort)); sortBy {
+	case "", domain.PRSortCreatedAt, domain.PRSortMergedAt:
+		filter.SortBy = sortBy
+	default:
+		return nil, errBadParam("sort")
+	}
+	// Диапазоны времени [from, to)
+	filter.CreatedFrom = localTime(params.CreatedFrom)
+	filter.CreatedTo = localTime(params.CreatedTo)
+	filter.MergedFrom = localTime(params.MergedFrom)
+	filter.MergedTo = localTime(params.MergedTo)
+
+	prs, next, err := router.storage.ListPRs(filter)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list PRs: %w", err)
+	}
+	pullRequests := make([]api.PullRequest, 0, len(prs))
+	for _, pr := range prs {
+		pullRequests = append(pullRequests, apiPullRequest(*pr))
+	}
+	return api.GetPullRequestList200JSONResponse{
+		PullRequests: pullRequests,
+		NextCursor:   transport.EncodePRCursor(next),
+	}, nil
+}
+
+// newPRFilter Фильтр списка PR по status, order (asc|desc), cursor и limit (nil - по умолчанию)
+func newPRFilter(status, order, cursor string, limit *int) (domain.PRFilter, error) {
+	filter := domain.PRFilter{
+		Status: status,
+		Limit:  transport.DefaultPRListLimit,
+	}
+	if filter.Status != "" && filter.Status != "OPEN" && filter.Status != "MERGED" {
+		return filter, errBadParam("status")
+	}
+	switch order {
+	case "", "asc":
+	case "desc":
+		filter.Desc = true
+	default:
+		return filter, errBadParam("order")
+	}
+	if cursor != "" {
+		after, err := transport.DecodePRCursor(cursor)
+		if err != nil {
+			return filter, errBadParam("cursor")
 		}
-		if errors.Is(err, storage.ErrReviewerNotAssigned) {
-			router.log.Error("PR reviewer not assigned", sl.Err(err))
-			w.WriteHeader(http.StatusConflict)
-			render.JSON(w, r, transport.ErrResponse{
-				Code:    transport.NOT_ASSIGNED,
-				Message: "reviewer is not assigned to this PR",
-			})
-			return
+		filter.After = after
+	}
+	if limit != nil {
+		if *limit <= 0 || *limit > transport.MaxPRListLimit {
+			return filter, errBadParam("limit")
 		}
-		router.log.Error("failed to reassign PR", sl.Err(err))
-		w.WriteHeader(http.StatusInternalServerError)
-		render.JSON(w, r, transport.ErrResponse{
-			Code:    transport.SERVER_ERROR,
-			Message: "failed to reassign PR",
-		})
-		return
+		filter.Limit = *limit
 	}
-	reviewers := make([]string, 0, len(pr.Reviewers))
-	for _, reviewer := range pr.Reviewers {
-		reviewers = append(reviewers, reviewer.ID)
+	return filter, nil
+}
+
+// deref Значение необязательного параметра (нулевое, если не передан)
+func deref[T any](value *T) T {
+	if value == nil {
+		var zero T
+		return zero
 	}
-	w.WriteHeader(http.StatusOK)
-	render.JSON(w, r, response{
-		PR: responsePR{
-			PullResuestID:   pr.ID,
-			PullRequestName: pr.Name,
-			AuthorID:        pr.Author.ID,
-			Status:          pr.Status,
-			AssignedReviews: reviewers,
-		},
-		ReplacedBy: newReviewer,
-	})
+	return *value
+}
+
+// parseIfMatch Ожидаемая версия PR из If-Match (0 - без проверки)
+func parseIfMatch(ifMatch string) (int64, error) {
+	ifMatch = strings.TrimSpace(ifMatch)
+	if ifMatch == "" || ifMatch == "*" {
+		return 0, nil
+	}
+	version, err := strconv.ParseInt(strings.Trim(strings.TrimPrefix(ifMatch, "W/"), `"`), 10, 64)
+	if err != nil || version <= 0 {
+		return 0, errBadParam("If-Match")
+	}
+	return version, nil
+}
+
+// etag Значение ETag для версии PR
+func etag(version int64) *string {
+	value := `"` + strconv.FormatInt(version, 10) + `"`
+	return &value
 }
@@ -0,0 +1,83 @@
+package router
+
+import (
+	"math"
+	"net"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/sudo-odner/Backend-trainee-assignment-avito-2025/internal/auth"
+	"github.com/sudo-odner/Backend-trainee-assignment-avito-2025/internal/config"
+	"github.com/sudo-odner/Backend-trainee-assignment-avito-2025/internal/ratelimit"
+	"github.com/sudo-odner/Backend-trainee-assignment-avito-2025/internal/transport"
+)
+
+const (
+	// defaultRateLimitRoute Метка лимита по умолчанию (общий bucket клиента для маршрутов без своего лимита)
+	defaultRateLimitRoute = "default"
+	// ipRateLimitRoute Метка лимита на IP до проверки токена
+	ipRateLimitRoute = "ip"
+)
+
+// rateLimitIP Ограничение частоты запросов с одного IP до проверки токена: запросы с неверным токеном
+// тоже расходуют лимит и не доходят до поиска токена в БД
+func (router *Router) rateLimitIP(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if !router.allow(w, r, "ip:"+clientIP(r), ipRateLimitRoute, router.cfg.RateLimit.IP) {
+			return
+		}
+		next.ServeHTTP(w, r)
+	})
+}
+
+// rateLimit Ограничение частоты запросов клиента (токен или IP) по лимитам маршрутов из конфига
+func (router *Router) rateLimit(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		route := r.Method + " " + r.URL.Path
+		limit, ok := router.cfg.RateLimit.Routes[route]
+		if !ok {
+			route = defaultRateLimitRoute
+			limit = router.cfg.RateLimit.Default
+		}
+		if !router.allow(w, r, clientKey(r), route, limit) {
+			return
+		}
+		next.ServeHTTP(w, r)
+	})
+}
+
+// allow Списание запроса из bucket клиента по лимиту route, при превышении - ответ 429.
+// Отказы не пишутся в лог (под нагрузкой их слишком много), их число есть в метриках
+func (router *Router) allow(w http.ResponseWriter, r *http.Request, client, route string, limit config.RateLimit) bool {
+	allowed, wait := router.limiter.Allow(client+"|"+route, route, ratelimit.Limit{
+		Rate:  limit.RPS,
+		Burst: limit.Burst,
+	}, time.Now())
+	if allowed {
+		return true
+	}
+	w.Header().Set("Retry-After", strconv.Itoa(int(math.Ceil(wait.Seconds()))))
+	router.writeError(w, r, http.StatusTooManyRequests, transport.RATE_LIMITED, "too many requests")
+	return false
+}
+
+// clientKey Клиент запроса: токен, если запрос аутентифицирован, иначе IP
+func clientKey(r *http.Request) string {
+	if principal := auth.PrincipalFrom(r.Context()); principal != nil {
+		if principal.TokenID == 0 {
+			return "token:" + principal.Name
+		}
+		return "token:" + strconv.FormatInt(principal.TokenID, 10)
+	}
+	return "ip:" + clientIP(r)
+}
+
+// clientIP IP клиента без порта
+func clientIP(r *http.Request) string {
+	host, _, err := net.SplitHostPort(r.RemoteAddr)
+	if err != nil {
+		return r.RemoteAddr
+	}
+	return host
+}
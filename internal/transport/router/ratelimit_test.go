@@ -0,0 +1,49 @@
+package router
+
+import (
+	"io"
+	"log/slog"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/sudo-odner/Backend-trainee-assignment-avito-2025/internal/auth"
+	"github.com/sudo-odner/Backend-trainee-assignment-avito-2025/internal/config"
+	"github.com/sudo-odner/Backend-trainee-assignment-avito-2025/internal/ratelimit"
+)
+
+// TestRateLimitIPBeforeAuthentication Запросы с неверным токеном ограничиваются по IP до поиска токена
+func TestRateLimitIPBeforeAuthentication(t *testing.T) {
+	cfg := &config.Config{}
+	cfg.Auth.Enabled = true
+	cfg.RateLimit.IP = config.RateLimit{RPS: 0.001, Burst: 1}
+	router := &Router{
+		log: slog.New(slog.NewTextHandler(io.Discard, nil)),
+		cfg: cfg,
+		// Без storage: поиск неизвестного токена в БД упадёт
+		auth:    auth.New(nil, "admin-token"),
+		limiter: ratelimit.New(time.Minute),
+	}
+	handler := router.rateLimitIP(router.authenticate(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+	})))
+
+	for i, tc := range []struct {
+		token string
+		want  int
+	}{
+		{token: "admin-token", want: http.StatusOK},
+		{token: "guessed-token", want: http.StatusTooManyRequests},
+	} {
+		req := httptest.NewRequest(http.MethodGet, "/audit", nil)
+		req.RemoteAddr = "203.0.113.7:51234"
+		req.Header.Set("Authorization", "Bearer "+tc.token)
+		rec := httptest.NewRecorder()
+		handler.ServeHTTP(rec, req)
+
+		if rec.Code != tc.want {
+			t.Fatalf("request %d: status = %d, want %d", i+1, rec.Code, tc.want)
+		}
+	}
+}
@@ -1,23 +1,99 @@
 package router
 
 import (
+	"context"
 	"log/slog"
 	"net/http"
+	"strings"
+	"time"
 
+	"github.com/getkin/kin-openapi/routers"
 	"github.com/go-chi/chi/v5"
 	"github.com/go-chi/chi/v5/middleware"
-	"github.com/sudo-odner/Backend-trainee-assignment-avito-2025/internal/storage/postgresql"
+	"github.com/sudo-odner/Backend-trainee-assignment-avito-2025/internal/auth"
+	"github.com/sudo-odner/Backend-trainee-assignment-avito-2025/internal/config"
+	"github.com/sudo-odner/Backend-trainee-assignment-avito-2025/internal/domain"
+	"github.com/sudo-odner/Backend-trainee-assignment-avito-2025/internal/ratelimit"
+	"github.com/sudo-odner/Backend-trainee-assignment-avito-2025/internal/transport/api"
+	"github.com/sudo-odner/Backend-trainee-assignment-avito-2025/internal/transport/graphqlserver"
+	"github.com/sudo-odner/Backend-trainee-assignment-avito-2025/pkg/logger/sl"
+	"github.com/swaggest/swgui/v5emb"
 )
 
+// HeaderActor Заголовок с инициатором изменения (попадает в аудит)
+const HeaderActor = "X-Actor"
+
+// Storage Данные для обработчиков HTTP API (токены и GraphQL используют свои подмножества)
+type Storage interface {
+	auth.Storage
+	graphqlserver.Storage
+
+	// Команды и пользователи
+	CreateTeamWithUser(nameTeam string, users []domain.User, meta domain.ChangeMeta) error
+	DeactivateTeamUsers(teamName string, meta domain.ChangeMeta) (int, error)
+	RebalanceTeam(teamName string, dryRun bool, meta domain.ChangeMeta) ([]domain.RebalanceMove, error)
+	ImportTeams(teams []domain.Team, dryRun bool, meta domain.ChangeMeta) (*domain.ImportSummary, error)
+	SetUserIsActive(userID string, isActive bool, meta domain.ChangeMeta) error
+
+	// Pull Request
+	CreatePRWithReviewers(prID, prName, authorID string, meta domain.ChangeMeta) (*domain.PullRequest, error)
+	MergePR(prID string, meta domain.ChangeMeta) error
+	ReassignReviewer(prID, oldReviewerID string, meta domain.ChangeMeta) (*domain.PullRequest, string, error)
+	DeclineReview(prID, reviewerID, reason string, meta domain.ChangeMeta) (*domain.PullRequest, string, error)
+	GetPRDeclines(prID string) ([]domain.ReviewerDecline, error)
+	GetOverdueReviews(filter domain.OverdueFilter) ([]domain.ReviewAssignment, error)
+
+	// Статистика и выгрузки
+	GetTeamStat(filter domain.StatFilter) ([]domain.TeamStat, error)
+	GetPRStat(filter domain.StatFilter) (*domain.PRStat, error)
+	GetTeamLoads(filter domain.StatFilter) ([]domain.TeamLoad, error)
+	EachReviewStat(filter domain.StatFilter, fn func(stat domain.UserReviewStat) error) error
+	EachPullRequest(authorID string, fn func(pr *domain.PullRequest) error) error
+	EachAuditEvent(filter domain.AuditFilter, fn func(event domain.AuditEvent) error) error
+	GetAuditEvents(filter domain.AuditFilter) ([]domain.AuditEvent, error)
+
+	// Резервное копирование
+	DumpSnapshot() (*domain.Snapshot, error)
+	RestoreSnapshot(snapshot *domain.Snapshot, meta domain.ChangeMeta) error
+
+	// API токены
+	CreateAPIToken(name, tokenHash, role, userID, teamName string) (*domain.APIToken, error)
+	GetAPITokens() ([]domain.APIToken, error)
+	RevokeAPIToken(tokenID int64) error
+
+	// Webhook
+	CreateWebhook(url, secret string, eventTypes []string) (*domain.Webhook, error)
+	GetWebhooks() ([]domain.Webhook, error)
+	DeactivateWebhook(webhookID int64) error
+	GetDeadDeliveries(webhookID int64) ([]domain.WebhookDelivery, error)
+	RetryDeadDelivery(deliveryID int64) error
+
+	// Интеграции
+	RegisterIntegrationDelivery(provider, deliveryID string) (bool, error)
+	ForgetIntegrationDelivery(provider, deliveryID string) error
+
+	// Ключи идемпотентности
+	ReserveIdempotencyKey(key, principal, route, bodyHash string, now time.Time, ttl time.Duration) (*domain.IdempotentResponse, error)
+	CompleteIdempotencyKey(key, principal, route string, statusCode int, contentType string, body []byte) error
+	ReleaseIdempotencyKey(key, principal, route string) error
+}
+
 type Router struct {
 	log     *slog.Logger
-	storage *postgresql.Storage
+	storage Storage
+	cfg     *config.Config
+	auth    *auth.Authenticator
+	limiter *ratelimit.Limiter
+	// specRouter Операции спецификации для проверки запросов (nil - проверка отключена)
+	specRouter routers.Router
 }
 
-func New(log *slog.Logger, storage *postgresql.Storage) http.Handler {
+func New(log *slog.Logger, storage Storage, cfg *config.Config) http.Handler {
 	r := Router{
 		log:     log,
 		storage: storage,
+		cfg:     cfg,
+		auth:    auth.New(storage, cfg.Auth.AdminToken),
 	}
 	// Init router
 	router := chi.NewRouter()
@@ -25,30 +101,119 @@ func New(log *slog.Logger, storage *postgresql.Storage) http.Handler {
 	// Init middleware
 	router.Use(middleware.RequestID)
 	router.Use(middleware.Logger)
-	router.Use(middleware.Recoverer)
+	router.Use(r.recoverer)
 	router.Use(middleware.URLFormat)
+	// Лимит на IP до проверки токена, лимиты клиента и маршрута - после (клиент - владелец токена)
+	if cfg.RateLimit.Enabled {
+		r.limiter = ratelimit.New(cfg.RateLimit.IdleTTL)
+		router.Use(r.rateLimitIP)
+	}
+	router.Use(r.authenticate)
+	if cfg.RateLimit.Enabled {
+		router.Use(r.rateLimit)
+	}
+	router.Use(limitRequestBody)
+	if cfg.OpenAPI.ValidateRequests {
+		specRouter, err := newSpecRouter()
+		if err != nil {
+			log.Error("failed to load OpenAPI spec", sl.Err(err))
+			panic(err)
+		}
+		r.specRouter = specRouter
+		router.Use(r.validateRequest)
+	}
+	router.Use(r.idempotency)
+
+	router.NotFound(r.notFound)
+	router.MethodNotAllowed(r.methodNotAllowed)
 
 	//Router
-	// Teams
-	router.Route("/team", func(team chi.Router) {
-		team.Post("/add", r.TPOSTAdd)
-		team.Get("/get", r.TGET)
-		team.Post("/deactivate", r.DeactivateTeamUsers)
-	})
-	// Users
-	router.Route("/users", func(users chi.Router) {
-		users.Post("/setIsActive", r.UserPOSTSetIsActivate)
-		users.Get("/getReview", r.UserGETGetReview)
-	})
-	// PullRequests
-	router.Route("/pullRequest", func(pullRequest chi.Router) {
-		pullRequest.Post("/create", r.PRPOSTCreate)
-		pullRequest.Post("/merge", r.PRPOSTMerge)
-		pullRequest.Post("/reassign", r.PRPOSTReassign)
-	})
-	// Statistics
-	router.Route("/statistic", func(statistics chi.Router) {
-		statistics.Get("/reviews", r.StatGetReviews)
+	// Операции task/openapi.yml: обработчики реализуют сгенерированный api.StrictServerInterface
+	api.HandlerWithOptions(
+		api.NewStrictHandlerWithOptions(&r, []api.StrictMiddlewareFunc{withChangeMeta, withAccept}, api.StrictHTTPServerOptions{
+			RequestErrorHandlerFunc:  r.requestError,
+			ResponseErrorHandlerFunc: r.respondError,
+		}),
+		api.ChiServerOptions{
+			BaseRouter:       router,
+			ErrorHandlerFunc: r.requestError,
+		},
+	)
+	// GraphQL
+	if cfg.GraphQL.Enabled {
+		graphQL, err := graphqlserver.New(log, storage, cfg)
+		if err != nil {
+			log.Error("failed to load GraphQL schema", sl.Err(err))
+			panic(err)
+		}
+		router.Post("/graphql", graphQL.ServeHTTP)
+	}
+	// Metrics
+	router.Get("/metrics", r.MetricsGET)
+	// API spec
+	router.Get(strings.TrimSuffix(SpecPath, ".yaml"), r.OpenAPIGET)
+	router.Mount(DocsPath, v5emb.New(cfg.ServiceName, SpecPath, DocsPath))
+	// Integrations
+	router.Route("/integrations", func(integrations chi.Router) {
+		if cfg.Integrations.GitHub.Enabled {
+			integrations.Post("/github/webhook", r.GitHubPOSTWebhook)
+		}
+		if cfg.Integrations.GitLab.Enabled {
+			integrations.Post("/gitlab/webhook", r.GitLabPOSTWebhook)
+		}
 	})
 	return router
 }
+
+// changeMetaKey Ключ контекста с инициатором изменения для сгенерированных обработчиков
+type changeMetaKey struct{}
+
+// withChangeMeta Кладёт инициатор изменения из запроса в контекст обработчика спецификации
+func withChangeMeta(next api.StrictHandlerFunc, _ string) api.StrictHandlerFunc {
+	return func(ctx context.Context, w http.ResponseWriter, r *http.Request, request any) (any, error) {
+		return next(context.WithValue(ctx, changeMetaKey{}, changeMeta(r)), w, r, request)
+	}
+}
+
+// changeMetaFrom Инициатор изменения, сохранённый withChangeMeta
+func changeMetaFrom(ctx context.Context) domain.ChangeMeta {
+	meta, _ := ctx.Value(changeMetaKey{}).(domain.ChangeMeta)
+	return meta
+}
+
+// acceptKey Ключ контекста с заголовком Accept (в спецификации не описывается как параметр)
+type acceptKey struct{}
+
+// withAccept Кладёт заголовок Accept в контекст обработчика спецификации
+func withAccept(next api.StrictHandlerFunc, _ string) api.StrictHandlerFunc {
+	return func(ctx context.Context, w http.ResponseWriter, r *http.Request, request any) (any, error) {
+		return next(context.WithValue(ctx, acceptKey{}, r.Header.Get("Accept")), w, r, request)
+	}
+}
+
+// acceptFrom Заголовок Accept, сохранённый withAccept
+func acceptFrom(ctx context.Context) string {
+	accept, _ := ctx.Value(acceptKey{}).(string)
+	return accept
+}
+
+// changeMeta Инициатор изменения из запроса (токен не-admin всегда действует от имени своего пользователя)
+func changeMeta(r *http.Request) domain.ChangeMeta {
+	return auth.ChangeMeta(r.Context(), r.Header.Get(HeaderActor))
+}
+
+// bodyLimits Ограничения размера тела для маршрутов, принимающих файлы
+var bodyLimits = map[string]int64{
+	"/team/import":   maxRosterSize,
+	"/admin/restore": maxArchiveSize,
+}
+
+// limitRequestBody Ограничивает тело запроса до разбора сгенерированными обработчиками
+func limitRequestBody(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if limit, ok := bodyLimits[r.URL.Path]; ok {
+			r.Body = http.MaxBytesReader(w, r.Body, limit)
+		}
+		next.ServeHTTP(w, r)
+	})
+}
@@ -1,40 +1,134 @@
 package router
 
 import (
-	"net/http"
+	"context"
+	"fmt"
+	"time"
 
-	"github.com/go-chi/render"
-	"github.com/sudo-odner/Backend-trainee-assignment-avito-2025/internal/transport"
-	"github.com/sudo-odner/Backend-trainee-assignment-avito-2025/pkg/logger/sl"
+	"github.com/sudo-odner/Backend-trainee-assignment-avito-2025/internal/domain"
+	"github.com/sudo-odner/Backend-trainee-assignment-avito-2025/internal/fairness"
+	"github.com/sudo-odner/Backend-trainee-assignment-avito-2025/internal/transport/api"
 )
 
-func (router *Router) StatGetReviews(w http.ResponseWriter, r *http.Request) {
-	type responseReviewer struct {
-		UserID      string `json:"user_id"`
-		ReviewCount int    `json:"review_count"`
+// GetStatisticReviews Число назначений на ревью по пользователям
+func (router *Router) GetStatisticReviews(ctx context.Context, request api.GetStatisticReviewsRequestObject) (api.GetStatisticReviewsResponseObject, error) {
+	params := request.Params
+	filter, err := newStatFilter(params.From, params.To, params.TeamName, string(deref(params.Status)))
+	if err != nil {
+		return nil, err
+	}
+
+	stat, err := router.storage.GetReviewStat(filter)
+	if err != nil {
+		return nil, fmt.Errorf("failed get review stat: %w", err)
+	}
+	return api.GetStatisticReviews200JSONResponse{ReviewStat: apiReviewStat(stat)}, nil
+}
+
+// apiReviewStat Назначения по пользователям в формате спецификации
+func apiReviewStat(stat []domain.UserReviewStat) []api.ReviewStat {
+	res := make([]api.ReviewStat, 0, len(stat))
+	for _, reviewer := range stat {
+		res = append(res, api.ReviewStat{UserId: reviewer.UserID, ReviewCount: reviewer.ReviewCount})
 	}
-	type response struct {
-		ReviewStat []responseReviewer `json:"review_stat"`
+	return res
+}
+
+// GetStatisticTeams Сводка по командам
+func (router *Router) GetStatisticTeams(ctx context.Context, request api.GetStatisticTeamsRequestObject) (api.GetStatisticTeamsResponseObject, error) {
+	params := request.Params
+	filter, err := newStatFilter(params.From, params.To, params.TeamName, string(deref(params.Status)))
+	if err != nil {
+		return nil, err
 	}
-	stat, err := router.storage.GetReviewStat()
+
+	stat, err := router.storage.GetTeamStat(filter)
 	if err != nil {
-		router.log.Error("Failed get reviewStat", sl.Err(err))
-		w.WriteHeader(http.StatusInternalServerError)
-		render.JSON(w, r, transport.ErrResponse{
-			Code:    transport.SERVER_ERROR,
-			Message: "failed get review stat",
+		return nil, fmt.Errorf("failed get team stat: %w", err)
+	}
+	teams := make([]api.TeamStat, 0, len(stat))
+	for _, team := range stat {
+		teams = append(teams, api.TeamStat{
+			TeamName:           team.TeamName,
+			Members:            team.Members,
+			ActiveMembers:      team.ActiveMembers,
+			PullRequests:       team.PullRequests,
+			OpenPullRequests:   team.OpenPullRequests,
+			MergedPullRequests: team.MergedPullRequests,
+			ReviewAssignments:  team.ReviewAssignments,
+			AvgReviewersPerPr:  team.AvgReviewersPerPR,
 		})
-		return
 	}
-	w.WriteHeader(http.StatusOK)
-	reviewersStat := make([]responseReviewer, 0, len(stat))
-	for _, reviewer := range stat {
-		reviewersStat = append(reviewersStat, responseReviewer{
-			UserID:      reviewer.UserID,
-			ReviewCount: reviewer.ReviewCount,
+	return api.GetStatisticTeams200JSONResponse{TeamStat: teams}, nil
+}
+
+// GetStatisticPullRequests Сводка по PR и время до merge
+func (router *Router) GetStatisticPullRequests(ctx context.Context, request api.GetStatisticPullRequestsRequestObject) (api.GetStatisticPullRequestsResponseObject, error) {
+	params := request.Params
+	filter, err := newStatFilter(params.From, params.To, params.TeamName, string(deref(params.Status)))
+	if err != nil {
+		return nil, err
+	}
+
+	stat, err := router.storage.GetPRStat(filter)
+	if err != nil {
+		return nil, fmt.Errorf("failed get PR stat: %w", err)
+	}
+	response := api.GetStatisticPullRequests200JSONResponse{
+		PullRequests:       stat.PullRequests,
+		OpenPullRequests:   stat.OpenPullRequests,
+		MergedPullRequests: stat.MergedPullRequests,
+		AvgReviewersPerPr:  stat.AvgReviewersPerPR,
+	}
+	response.TimeToMerge.P50Seconds = stat.TimeToMergeP50.Seconds()
+	response.TimeToMerge.P90Seconds = stat.TimeToMergeP90.Seconds()
+	response.TimeToMerge.P99Seconds = stat.TimeToMergeP99.Seconds()
+	return response, nil
+}
+
+// GetStatisticFairness Равномерность нагрузки на ревью внутри команд
+func (router *Router) GetStatisticFairness(ctx context.Context, request api.GetStatisticFairnessRequestObject) (api.GetStatisticFairnessResponseObject, error) {
+	params := request.Params
+	filter, err := newStatFilter(params.From, params.To, params.TeamName, string(deref(params.Status)))
+	if err != nil {
+		return nil, err
+	}
+
+	loads, err := router.storage.GetTeamLoads(filter)
+	if err != nil {
+		return nil, fmt.Errorf("failed get team loads: %w", err)
+	}
+	teams := make([]api.TeamFairness, 0, len(loads))
+	for _, team := range loads {
+		members := make([]api.ReviewStat, 0, len(team.Members))
+		counts := make([]int, 0, len(team.Members))
+		for _, member := range team.Members {
+			members = append(members, api.ReviewStat{UserId: member.UserID, ReviewCount: member.ReviewCount})
+			counts = append(counts, member.ReviewCount)
+		}
+		metrics := fairness.Compute(counts)
+		teams = append(teams, api.TeamFairness{
+			TeamName: team.TeamName,
+			Members:  members,
+			Min:      metrics.Min,
+			Max:      metrics.Max,
+			Mean:     metrics.Mean,
+			Stddev:   metrics.StdDev,
+			Gini:     metrics.Gini,
 		})
 	}
-	render.JSON(w, r, response{
-		ReviewStat: reviewersStat,
-	})
+	return api.GetStatisticFairness200JSONResponse{Teams: teams}, nil
+}
+
+// newStatFilter Общие фильтры статистики (from, to, team_name, status)
+func newStatFilter(from, to *time.Time, teamName *string, status string) (domain.StatFilter, error) {
+	if status != "" && status != "OPEN" && status != "MERGED" {
+		return domain.StatFilter{}, errBadParam("status")
+	}
+	return domain.StatFilter{
+		From:     localTime(from),
+		To:       localTime(to),
+		TeamName: deref(teamName),
+		Status:   status,
+	}, nil
 }
@@ -1,193 +1,141 @@
 package router
 
 import (
-	"errors"
+	"context"
+	"fmt"
 	"net/http"
 
-	"github.com/go-chi/render"
-	"github.com/go-playground/validator/v10"
 	"github.com/sudo-odner/Backend-trainee-assignment-avito-2025/internal/domain"
-	"github.com/sudo-odner/Backend-trainee-assignment-avito-2025/internal/storage"
+	"github.com/sudo-odner/Backend-trainee-assignment-avito-2025/internal/roster"
 	"github.com/sudo-odner/Backend-trainee-assignment-avito-2025/internal/transport"
-	"github.com/sudo-odner/Backend-trainee-assignment-avito-2025/pkg/logger/sl"
+	"github.com/sudo-odner/Backend-trainee-assignment-avito-2025/internal/transport/api"
 )
 
-func (router *Router) TPOSTAdd(w http.ResponseWriter, r *http.Request) {
-	type request struct {
-		TeamName string `json:"team_name" validate:"required"`
-		Members  []struct {
-			UserID   string `json:"user_id" validate:"required"`
-			Username string `json:"username" validate:"required"`
-			IsActive bool   `json:"is_active" validate:"required"`
-		}
+// PostTeamAdd Создание команды с участниками (создаёт/обновляет пользователей)
+func (router *Router) PostTeamAdd(ctx context.Context, request api.PostTeamAddRequestObject) (api.PostTeamAddResponseObject, error) {
+	req := request.Body
+	if req.TeamName == "" {
+		return nil, errBadParam("team_name")
 	}
-	type response struct {
-		TeamName string `json:"team_name"`
-		Members  []struct {
-			UserID   string `json:"user_id"`
-			Username string `json:"username"`
-			IsActive bool   `json:"is_active"`
+
+	// Создаем юзеров в объект меж сервисами
+	users := make([]domain.User, 0, len(req.Members))
+	for _, member := range req.Members {
+		if member.UserId == "" || member.Username == "" {
+			return nil, errBadParam("members")
 		}
+		users = append(users, domain.User{ID: member.UserId, Name: member.Username, IsActive: member.IsActive})
 	}
 
-	// Декодирование и валидация request
-	var req request
-	if err := render.DecodeJSON(r.Body, &req); err != nil {
-		router.log.Error("failed to decode request", sl.Err(err))
-		w.WriteHeader(http.StatusBadRequest)
-		render.JSON(w, r, transport.ErrResponse{
-			Code:    transport.BAD_REQUEST,
-			Message: "failed to decode request",
-		})
-		return
-	}
-	if err := validator.New().Struct(req); err != nil {
-		router.log.Error("failed to validate request", sl.Err(err))
-		w.WriteHeader(http.StatusBadRequest)
-		render.JSON(w, r, transport.ErrResponse{
-			Code:    transport.BAD_REQUEST,
-			Message: "failed to validate request",
-		})
-		return
+	if err := router.storage.CreateTeamWithUser(req.TeamName, users, changeMetaFrom(ctx)); err != nil {
+		return nil, fmt.Errorf("failed to create team: %w", err)
 	}
+	return api.PostTeamAdd201JSONResponse{Team: apiTeam(domain.Team{Name: req.TeamName, Users: users})}, nil
+}
 
-	// Создаем юзеров в объект меж сервисами
-	users := make([]domain.User, 0)
-	for _, user := range req.Members {
-		users = append(users, domain.User{ID: user.UserID, Name: user.Username, IsActive: user.IsActive})
+// GetTeamGet Команда с участниками
+func (router *Router) GetTeamGet(ctx context.Context, request api.GetTeamGetRequestObject) (api.GetTeamGetResponseObject, error) {
+	if request.Params.TeamName == "" {
+		return nil, errBadParam("team_name")
 	}
-
-	err := router.storage.CreateTeamWithUser(req.TeamName, users)
+	team, err := router.storage.GetTeam(request.Params.TeamName)
 	if err != nil {
-		if errors.Is(err, storage.ErrTeamAlreadyExists) {
-			router.log.Error("failed to create team", sl.Err(err))
-			w.WriteHeader(http.StatusBadRequest)
-			render.JSON(w, r, transport.ErrResponse{
-				Code:    transport.TEAM_EXISTS,
-				Message: "team_name already exists",
-			})
-			return
-		}
-		router.log.Error("failed to create team", sl.Err(err))
-		w.WriteHeader(http.StatusInternalServerError)
-		render.JSON(w, r, transport.ErrResponse{
-			Code:    transport.SERVER_ERROR,
-			Message: err.Error(),
-		})
-		return
+		return nil, fmt.Errorf("failed to get team: %w", err)
 	}
+	return api.GetTeamGet200JSONResponse(apiTeam(*team)), nil
+}
 
-	w.WriteHeader(http.StatusCreated)
-	render.JSON(w, r, response{
-		TeamName: req.TeamName,
-		Members: []struct {
-			UserID   string `json:"user_id"`
-			Username string `json:"username"`
-			IsActive bool   `json:"is_active"`
-		}(req.Members),
-	})
+// apiTeam Команда в формате спецификации
+func apiTeam(team domain.Team) api.Team {
+	members := make([]api.TeamMember, 0, len(team.Users))
+	for _, user := range team.Users {
+		members = append(members, api.TeamMember{
+			UserId:   user.ID,
+			Username: user.Name,
+			IsActive: user.IsActive,
+		})
+	}
+	return api.Team{TeamName: team.Name, Members: members}
 }
 
-func (router *Router) TGET(w http.ResponseWriter, r *http.Request) {
-	type respMembers struct {
-		UserID   string `json:"user_id"`
-		Username string `json:"username"`
-		IsActive bool   `json:"is_active"`
+// PostTeamDeactivate Деактивация всех участников команды со снятием с ревью открытых PR
+func (router *Router) PostTeamDeactivate(ctx context.Context, request api.PostTeamDeactivateRequestObject) (api.PostTeamDeactivateResponseObject, error) {
+	req := request.Body
+	if req.TeamName == "" {
+		return nil, errBadParam("team_name")
 	}
-	type response struct {
-		TeamName string `json:"team_name"`
-		Members  []respMembers
+	if err := router.auth.TeamScope(ctx, req.TeamName); err != nil {
+		return nil, err
 	}
 
-	teamName := r.URL.Query().Get("team_name")
-
-	infoTeam, err := router.storage.GetTeam(teamName)
+	count, err := router.storage.DeactivateTeamUsers(req.TeamName, changeMetaFrom(ctx))
 	if err != nil {
-		if errors.Is(err, storage.ErrTeamNotFound) {
-			router.log.Error("failed to find team", sl.Err(err))
-			w.WriteHeader(http.StatusNotFound)
-			render.JSON(w, r, transport.ErrResponse{
-				Code:    transport.NOT_FOUND,
-				Message: "resource not found",
-			})
-			return
-		}
-		router.log.Error("failed to get team", sl.Err(err))
-		w.WriteHeader(http.StatusInternalServerError)
-		render.JSON(w, r, transport.ErrResponse{
-			Code:    transport.SERVER_ERROR,
-			Message: err.Error(),
-		})
-		return
+		return nil, fmt.Errorf("failed to deactivate team users: %w", err)
 	}
+	return api.PostTeamDeactivate200JSONResponse{TeamName: req.TeamName, DeactivateCount: count}, nil
+}
 
-	members := make([]respMembers, 0, len(infoTeam.Users))
-	for _, user := range infoTeam.Users {
-		members = append(members, respMembers{
-			UserID:   user.ID,
-			Username: user.Name,
-			IsActive: user.IsActive,
+// PostTeamRebalance Выравнивание нагрузки на ревью внутри команды (dry_run - только план)
+func (router *Router) PostTeamRebalance(ctx context.Context, request api.PostTeamRebalanceRequestObject) (api.PostTeamRebalanceResponseObject, error) {
+	req := request.Body
+	if req.TeamName == "" {
+		return nil, errBadParam("team_name")
+	}
+	if err := router.auth.TeamScope(ctx, req.TeamName); err != nil {
+		return nil, err
+	}
+	dryRun := deref(req.DryRun)
+
+	moves, err := router.storage.RebalanceTeam(req.TeamName, dryRun, changeMetaFrom(ctx))
+	if err != nil {
+		return nil, fmt.Errorf("failed to rebalance team: %w", err)
+	}
+	apiMoves := make([]api.RebalanceMove, 0, len(moves))
+	for _, move := range moves {
+		apiMoves = append(apiMoves, api.RebalanceMove{
+			PullRequestId:  move.PullRequestID,
+			FromReviewerId: move.FromReviewerID,
+			ToReviewerId:   move.ToReviewerID,
 		})
 	}
-	w.WriteHeader(http.StatusOK)
-	render.JSON(w, r, response{
-		TeamName: teamName,
-		Members:  members,
-	})
+	return api.PostTeamRebalance200JSONResponse{TeamName: req.TeamName, DryRun: dryRun, Moves: apiMoves}, nil
 }
 
-func (router *Router) DeactivateTeamUsers(w http.ResponseWriter, r *http.Request) {
-	type request struct {
-		TeamName string `json:"team_name" validate:"required"`
-	}
-	type response struct {
-		TeamName        string `json:"team_name"`
-		DeactivateCount int    `json:"deactivate_count"`
+// maxRosterSize Ограничение размера файла состава команд
+const maxRosterSize = 10 << 20
+
+// PostTeamImport Импорт состава команд из YAML или CSV
+func (router *Router) PostTeamImport(ctx context.Context, request api.PostTeamImportRequestObject) (api.PostTeamImportResponseObject, error) {
+	// Формат: query параметр format (yaml|csv), иначе по Content-Type
+	format := string(deref(request.Params.Format))
+	switch format {
+	case roster.FormatYAML, roster.FormatCSV:
+	case "":
+		format = roster.FormatByName(request.ContentType)
+	default:
+		return nil, errBadParam("format")
 	}
+	dryRun := deref(request.Params.DryRun)
 
-	// Декодирование и валидация request
-	var req request
-	if err := render.DecodeJSON(r.Body, &req); err != nil {
-		router.log.Error("failed to decode request", sl.Err(err))
-		w.WriteHeader(http.StatusBadRequest)
-		render.JSON(w, r, transport.ErrResponse{
-			Code:    transport.BAD_REQUEST,
-			Message: "failed to decode request",
-		})
-		return
-	}
-	if err := validator.New().Struct(req); err != nil {
-		router.log.Error("failed to validate request", sl.Err(err))
-		w.WriteHeader(http.StatusBadRequest)
-		render.JSON(w, r, transport.ErrResponse{
-			Code:    transport.BAD_REQUEST,
-			Message: "failed to validate request",
-		})
-		return
+	// Разбор и проверка состава до применения
+	teams, err := roster.Parse(request.Body, format)
+	if err != nil {
+		return nil, &responseError{status: http.StatusBadRequest, code: transport.BAD_REQUEST, message: err.Error()}
+	}
+	if err := router.auth.RosterScope(ctx, teams); err != nil {
+		return nil, err
 	}
 
-	count, err := router.storage.DeactivateTeamUsers(req.TeamName)
+	summary, err := router.storage.ImportTeams(teams, dryRun, changeMetaFrom(ctx))
 	if err != nil {
-		if errors.Is(err, storage.ErrTeamNotFound) {
-			router.log.Error("failed to deactivate team", sl.Err(err))
-			w.WriteHeader(http.StatusNotFound)
-			render.JSON(w, r, transport.ErrResponse{
-				Code:    transport.NOT_FOUND,
-				Message: "resource not found",
-			})
-			return
-		}
-		router.log.Error("failed to deactivate team users", sl.Err(err))
-		w.WriteHeader(http.StatusInternalServerError)
-		render.JSON(w, r, transport.ErrResponse{
-			Code:    transport.SERVER_ERROR,
-			Message: "failed to deactivate team users",
-		})
-		return
+		return nil, fmt.Errorf("failed to import teams: %w", err)
 	}
-	w.WriteHeader(http.StatusOK)
-	render.JSON(w, r, response{
-		TeamName:        req.TeamName,
-		DeactivateCount: count,
-	})
+	return api.PostTeamImport200JSONResponse{
+		DryRun:       dryRun,
+		CreatedTeams: summary.CreatedTeams,
+		Created:      summary.Created,
+		Updated:      summary.Updated,
+		Moved:        summary.Moved,
+		Unchanged:    summary.Unchanged,
+	}, nil
 }
@@ -0,0 +1,74 @@
+package router
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/sudo-odner/Backend-trainee-assignment-avito-2025/internal/auth"
+	"github.com/sudo-odner/Backend-trainee-assignment-avito-2025/internal/domain"
+	"github.com/sudo-odner/Backend-trainee-assignment-avito-2025/internal/transport/api"
+)
+
+// apiToken Токен в формате спецификации (без секрета)
+func apiToken(token domain.APIToken) api.APIToken {
+	return api.APIToken{
+		TokenId:   token.ID,
+		Name:      token.Name,
+		Role:      api.TokenRole(token.Role),
+		UserId:    optionalString(token.UserID),
+		TeamName:  optionalString(token.TeamName),
+		CreatedAt: token.CreatedAt,
+	}
+}
+
+// PostAuthTokensCreate Выпуск API токена (сам токен отдаётся только один раз)
+func (router *Router) PostAuthTokensCreate(ctx context.Context, request api.PostAuthTokensCreateRequestObject) (api.PostAuthTokensCreateResponseObject, error) {
+	req := request.Body
+	role, userID, teamName := string(req.Role), deref(req.UserId), deref(req.TeamName)
+	switch {
+	case req.Name == "":
+		return nil, errBadParam("name")
+	case role != domain.RoleAdmin && role != domain.RoleUser && role != domain.RoleTeamLead:
+		return nil, errBadParam("role")
+	case role != domain.RoleAdmin && userID == "":
+		return nil, errBadParam("user_id")
+	// Команда задаётся только руководителю
+	case (role == domain.RoleTeamLead) != (teamName != ""):
+		return nil, errBadParam("team_name")
+	}
+
+	secret, err := auth.NewToken()
+	if err != nil {
+		return nil, fmt.Errorf("failed to generate token: %w", err)
+	}
+	token, err := router.storage.CreateAPIToken(req.Name, auth.HashToken(secret), role, userID, teamName)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create token: %w", err)
+	}
+	return api.PostAuthTokensCreate201JSONResponse{Token: apiToken(*token), Secret: secret}, nil
+}
+
+// GetAuthTokensList Действующие API токены
+func (router *Router) GetAuthTokensList(ctx context.Context, request api.GetAuthTokensListRequestObject) (api.GetAuthTokensListResponseObject, error) {
+	tokens, err := router.storage.GetAPITokens()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get tokens: %w", err)
+	}
+	apiTokens := make([]api.APIToken, 0, len(tokens))
+	for _, token := range tokens {
+		apiTokens = append(apiTokens, apiToken(token))
+	}
+	return api.GetAuthTokensList200JSONResponse{Tokens: apiTokens}, nil
+}
+
+// PostAuthTokensRevoke Отзыв API токена
+func (router *Router) PostAuthTokensRevoke(ctx context.Context, request api.PostAuthTokensRevokeRequestObject) (api.PostAuthTokensRevokeResponseObject, error) {
+	tokenID := request.Body.TokenId
+	if tokenID == 0 {
+		return nil, errBadParam("token_id")
+	}
+	if err := router.storage.RevokeAPIToken(tokenID); err != nil {
+		return nil, fmt.Errorf("failed to revoke token: %w", err)
+	}
+	return api.PostAuthTokensRevoke200JSONResponse{TokenId: tokenID, Revoked: true}, nil
+}
@@ -1,172 +1,89 @@
 package router
 
 import (
-	"errors"
-	"net/http"
+	"context"
+	"fmt"
 
-	"github.com/go-chi/render"
-	"github.com/go-playground/validator/v10"
-	"github.com/sudo-odner/Backend-trainee-assignment-avito-2025/internal/storage"
 	"github.com/sudo-odner/Backend-trainee-assignment-avito-2025/internal/transport"
-	"github.com/sudo-odner/Backend-trainee-assignment-avito-2025/pkg/logger/sl"
+	"github.com/sudo-odner/Backend-trainee-assignment-avito-2025/internal/transport/api"
 )
 
-func (router *Router) UserPOSTSetIsActivate(w http.ResponseWriter, r *http.Request) {
-	type request struct {
-		UserID   string `json:"user_id"`
-		IsActive bool   `json:"is_active"`
+// PostUsersSetIsActive Установка флага активности пользователя
+func (router *Router) PostUsersSetIsActive(ctx context.Context, request api.PostUsersSetIsActiveRequestObject) (api.PostUsersSetIsActiveResponseObject, error) {
+	req := request.Body
+	if req.UserId == "" {
+		return nil, errBadParam("user_id")
 	}
-
-	type responseUser struct {
-		UserID   string `json:"user_id"`
-		Username string `json:"username"`
-		TeamName string `json:"team_name"`
-		IsActive bool   `json:"is_active"`
-	}
-
-	type response struct {
-		User responseUser `json:"user"`
-	}
-
-	// Валидация и декодирование запроса
-	var req request
-	if err := render.DecodeJSON(r.Body, &req); err != nil {
-		router.log.Error("failed decode request", sl.Err(err))
-		w.WriteHeader(http.StatusBadRequest)
-		render.JSON(w, r, transport.ErrResponse{
-			Code:    transport.BAD_REQUEST,
-			Message: "failed decode request",
-		})
-		return
-	}
-
-	if err := validator.New().Struct(req); err != nil {
-		router.log.Error("failed validate request", sl.Err(err))
-		w.WriteHeader(http.StatusBadRequest)
-		render.JSON(w, r, transport.ErrResponse{
-			Code:    transport.BAD_REQUEST,
-			Message: "failed validate request",
-		})
-		return
+	if err := router.auth.UserScope(ctx, req.UserId); err != nil {
+		return nil, err
 	}
 
 	// Получение информации о пользоватлеле
-	user, err := router.storage.GetUserByID(req.UserID)
+	user, err := router.storage.GetUserByID(req.UserId)
 	if err != nil {
-		if errors.Is(err, storage.ErrUserNotFound) {
-			router.log.Error("user not found", sl.Err(err))
-			w.WriteHeader(http.StatusNotFound)
-			render.JSON(w, r, transport.ErrResponse{
-				Code:    transport.NOT_FOUND,
-				Message: "resource not found",
-			})
-			return
-		}
-		router.log.Error("failed get user by id", sl.Err(err))
-		w.WriteHeader(http.StatusInternalServerError)
-		render.JSON(w, r, transport.ErrResponse{
-			Code:    transport.SERVER_ERROR,
-			Message: "failed get user by id",
-		})
-		return
+		return nil, fmt.Errorf("failed get user by id: %w", err)
 	}
 
 	// Установть флаг активности
-	if err := router.storage.SetUserIsActive(req.UserID, req.IsActive); err != nil {
-		if errors.Is(err, storage.ErrUserNotFound) {
-			router.log.Error("user not found", sl.Err(err))
-			w.WriteHeader(http.StatusNotFound)
-			render.JSON(w, r, transport.ErrResponse{
-				Code:    transport.NOT_FOUND,
-				Message: "resource not found",
-			})
-			return
-		}
-		router.log.Error("failed set user is_active", sl.Err(err))
-		w.WriteHeader(http.StatusInternalServerError)
-		render.JSON(w, r, transport.ErrResponse{
-			Code:    transport.SERVER_ERROR,
-			Message: "failed set user is_active",
-		})
-		return
+	if err := router.storage.SetUserIsActive(req.UserId, req.IsActive, changeMetaFrom(ctx)); err != nil {
+		return nil, fmt.Errorf("failed set user is_active: %w", err)
 	}
 	// Получить команду пользователя
 	teamName, err := router.storage.GetUserTeamByID(user.ID)
 	if err != nil {
-		if errors.Is(err, storage.ErrUserNotFound) {
-			router.log.Error("user not found", sl.Err(err))
-			w.WriteHeader(http.StatusNotFound)
-			render.JSON(w, r, transport.ErrResponse{
-				Code:    transport.NOT_FOUND,
-				Message: "resource not found",
-			})
-			return
-		}
-		router.log.Error("failed get user by id", sl.Err(err))
-		w.WriteHeader(http.StatusInternalServerError)
-		render.JSON(w, r, transport.ErrResponse{
-			Code:    transport.SERVER_ERROR,
-			Message: "failed get user by id",
-		})
-		return
+		return nil, fmt.Errorf("failed get user team: %w", err)
 	}
 
-	w.WriteHeader(http.StatusOK)
-	render.JSON(w, r, response{
-		User: responseUser{
-			UserID:   user.ID,
+	return api.PostUsersSetIsActive200JSONResponse{
+		User: api.User{
+			UserId:   user.ID,
 			Username: user.Name,
 			TeamName: teamName,
 			IsActive: req.IsActive,
 		},
-	})
+	}, nil
 }
 
-func (router *Router) UserGETGetReview(w http.ResponseWriter, r *http.Request) {
-	type responsePR struct {
-		PullRequestID   string `json:"pull_request_id"`
-		PullRequestName string `json:"pull_request_name"`
-		AuthorID        string `json:"author_id"`
-		Status          string `json:"status"`
+// GetUsersGetReview Очередь пользователя: PR, где он reviewer (role=reviewer, по умолчанию) или автор (role=author)
+func (router *Router) GetUsersGetReview(ctx context.Context, request api.GetUsersGetReviewRequestObject) (api.GetUsersGetReviewResponseObject, error) {
+	params := request.Params
+	if params.UserId == "" {
+		return nil, errBadParam("user_id")
 	}
-	type response struct {
-		UserID       string       `json:"user_id"`
-		PullRequests []responsePR `json:"pull_requests"`
+	filter, err := newPRFilter(string(deref(params.Status)), string(deref(params.Order)), deref(params.Cursor), params.Limit)
+	if err != nil {
+		return nil, err
+	}
+	switch deref(params.Role) {
+	case "", api.Reviewer:
+		filter.ReviewerID = params.UserId
+	case api.Author:
+		filter.AuthorID = params.UserId
+	default:
+		return nil, errBadParam("role")
 	}
 
-	userID := r.URL.Query().Get("user_id")
+	// Проверка на существование пользователя
+	if _, err := router.storage.GetUserByID(params.UserId); err != nil {
+		return nil, fmt.Errorf("failed get user by id: %w", err)
+	}
 
-	prs, err := router.storage.GetUserPRsByID(userID)
+	prs, next, err := router.storage.ListPRs(filter)
 	if err != nil {
-		if errors.Is(err, storage.ErrUserNotFound) {
-			router.log.Error("user not found", sl.Err(err))
-			w.WriteHeader(http.StatusNotFound)
-			render.JSON(w, r, transport.ErrResponse{
-				Code:    transport.NOT_FOUND,
-				Message: "resource not found",
-			})
-			return
-		}
-		router.log.Error("failed get user by id", sl.Err(err))
-		w.WriteHeader(http.StatusInternalServerError)
-		render.JSON(w, r, transport.ErrResponse{
-			Code:    transport.SERVER_ERROR,
-			Message: "failed get user by id",
-		})
-		return
+		return nil, fmt.Errorf("failed get user PRs: %w", err)
 	}
-	responsePRs := make([]responsePR, 0, len(prs))
+	pullRequests := make([]api.PullRequestShort, 0, len(prs))
 	for _, pr := range prs {
-		responsePRs = append(responsePRs, responsePR{
-			PullRequestID:   pr.ID,
+		pullRequests = append(pullRequests, api.PullRequestShort{
+			PullRequestId:   pr.ID,
 			PullRequestName: pr.Name,
-			AuthorID:        pr.Author.ID,
-			Status:          pr.Status,
+			AuthorId:        pr.Author.ID,
+			Status:          api.PullRequestShortStatus(pr.Status),
 		})
 	}
-	w.WriteHeader(http.StatusOK)
-	render.JSON(w, r, response{
-		UserID:       userID,
-		PullRequests: responsePRs,
-	})
+	return api.GetUsersGetReview200JSONResponse{
+		UserId:       params.UserId,
+		PullRequests: pullRequests,
+		NextCursor:   transport.EncodePRCursor(next),
+	}, nil
 }
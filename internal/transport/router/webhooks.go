@@ -0,0 +1,99 @@
+package router
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+
+	"github.com/sudo-odner/Backend-trainee-assignment-avito-2025/internal/domain"
+	"github.com/sudo-odner/Backend-trainee-assignment-avito-2025/internal/transport/api"
+)
+
+// apiWebhook Webhook в формате спецификации (без секрета)
+func apiWebhook(webhook domain.Webhook) api.Webhook {
+	return api.Webhook{
+		WebhookId:  webhook.ID,
+		Url:        webhook.URL,
+		EventTypes: webhook.EventTypes,
+		IsActive:   webhook.IsActive,
+		CreatedAt:  webhook.CreatedAt,
+	}
+}
+
+// PostWebhooksAdd Регистрация webhook (пустой список событий - подписка на все)
+func (router *Router) PostWebhooksAdd(ctx context.Context, request api.PostWebhooksAddRequestObject) (api.PostWebhooksAddResponseObject, error) {
+	req := request.Body
+	if target, err := url.Parse(req.Url); err != nil || target.Scheme == "" || target.Host == "" {
+		return nil, errBadParam("url")
+	}
+	if req.Secret == "" {
+		return nil, errBadParam("secret")
+	}
+
+	webhook, err := router.storage.CreateWebhook(req.Url, req.Secret, deref(req.EventTypes))
+	if err != nil {
+		return nil, fmt.Errorf("failed to create webhook: %w", err)
+	}
+	return api.PostWebhooksAdd201JSONResponse{Webhook: apiWebhook(*webhook)}, nil
+}
+
+// GetWebhooksList Активные webhook
+func (router *Router) GetWebhooksList(ctx context.Context, request api.GetWebhooksListRequestObject) (api.GetWebhooksListResponseObject, error) {
+	webhooks, err := router.storage.GetWebhooks()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get webhooks: %w", err)
+	}
+	apiWebhooks := make([]api.Webhook, 0, len(webhooks))
+	for _, webhook := range webhooks {
+		apiWebhooks = append(apiWebhooks, apiWebhook(webhook))
+	}
+	return api.GetWebhooksList200JSONResponse{Webhooks: apiWebhooks}, nil
+}
+
+// PostWebhooksDelete Отключение webhook
+func (router *Router) PostWebhooksDelete(ctx context.Context, request api.PostWebhooksDeleteRequestObject) (api.PostWebhooksDeleteResponseObject, error) {
+	webhookID := request.Body.WebhookId
+	if webhookID == 0 {
+		return nil, errBadParam("webhook_id")
+	}
+	if err := router.storage.DeactivateWebhook(webhookID); err != nil {
+		return nil, fmt.Errorf("failed to delete webhook: %w", err)
+	}
+	return api.PostWebhooksDelete200JSONResponse{WebhookId: webhookID, IsActive: false}, nil
+}
+
+// GetWebhooksDeadLetters Доставки, исчерпавшие попытки (фильтр по webhook необязателен)
+func (router *Router) GetWebhooksDeadLetters(ctx context.Context, request api.GetWebhooksDeadLettersRequestObject) (api.GetWebhooksDeadLettersResponseObject, error) {
+	deliveries, err := router.storage.GetDeadDeliveries(deref(request.Params.WebhookId))
+	if err != nil {
+		return nil, fmt.Errorf("failed to get dead deliveries: %w", err)
+	}
+	apiDeliveries := make([]api.DeadDelivery, 0, len(deliveries))
+	for _, delivery := range deliveries {
+		apiDeliveries = append(apiDeliveries, api.DeadDelivery{
+			DeliveryId: delivery.ID,
+			WebhookId:  delivery.WebhookID,
+			Url:        delivery.URL,
+			EventId:    delivery.EventID,
+			EventType:  delivery.EventType,
+			Payload:    delivery.Payload,
+			OccurredAt: delivery.OccurredAt,
+			Attempts:   delivery.Attempts,
+			LastError:  delivery.LastError,
+			FailedAt:   delivery.UpdatedAt,
+		})
+	}
+	return api.GetWebhooksDeadLetters200JSONResponse{Deliveries: apiDeliveries}, nil
+}
+
+// PostWebhooksRetry Повтор недоставленного события
+func (router *Router) PostWebhooksRetry(ctx context.Context, request api.PostWebhooksRetryRequestObject) (api.PostWebhooksRetryResponseObject, error) {
+	deliveryID := request.Body.DeliveryId
+	if deliveryID == 0 {
+		return nil, errBadParam("delivery_id")
+	}
+	if err := router.storage.RetryDeadDelivery(deliveryID); err != nil {
+		return nil, fmt.Errorf("failed to retry delivery: %w", err)
+	}
+	return api.PostWebhooksRetry200JSONResponse{DeliveryId: deliveryID, Status: "PENDING"}, nil
+}
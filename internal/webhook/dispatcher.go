@@ -0,0 +1,213 @@
+package webhook
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"log/slog"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/sudo-odner/Backend-trainee-assignment-avito-2025/internal/domain"
+	"github.com/sudo-odner/Backend-trainee-assignment-avito-2025/pkg/logger/sl"
+)
+
+const (
+	HeaderSignature  = "X-Signature-256"
+	HeaderEventType  = "X-Event-Type"
+	HeaderEventID    = "X-Event-ID"
+	HeaderDeliveryID = "X-Delivery-ID"
+)
+
+// defaultPollInterval Интервал опроса outbox, если в конфиге задан неположительный
+const defaultPollInterval = 2 * time.Second
+
+type DeliveryStorage interface {
+	FanOutOutboxEvents(now time.Time, limit int) (int, error)
+	ClaimDueDeliveries(now time.Time, lease time.Duration, limit int) ([]domain.WebhookDelivery, error)
+	MarkDeliverySucceeded(deliveryID int64, now time.Time) error
+	MarkDeliveryFailed(deliveryID int64, lastError string, nextAttemptAt time.Time, dead bool, now time.Time) error
+}
+
+type Options struct {
+	PollInterval time.Duration
+	Timeout      time.Duration
+	MaxAttempts  int
+	BaseBackoff  time.Duration
+	MaxBackoff   time.Duration
+	BatchSize    int
+}
+
+// Dispatcher Доставка событий outbox на зарегистрированные webhook
+type Dispatcher struct {
+	log     *slog.Logger
+	storage DeliveryStorage
+	client  *http.Client
+	opts    Options
+}
+
+func NewDispatcher(log *slog.Logger, storage DeliveryStorage, opts Options) *Dispatcher {
+	if opts.PollInterval <= 0 {
+		log.Warn("invalid webhook poll interval, using default",
+			slog.Duration("interval", opts.PollInterval), slog.Duration("default", defaultPollInterval))
+		opts.PollInterval = defaultPollInterval
+	}
+	return &Dispatcher{
+		log:     log,
+		storage: storage,
+		client:  &http.Client{Timeout: opts.Timeout},
+		opts:    opts,
+	}
+}
+
+// Event Тело запроса, отправляемого на webhook
+type Event struct {
+	ID         int64           `json:"id"`
+	Type       string          `json:"type"`
+	OccurredAt time.Time       `json:"occurred_at"`
+	Data       json.RawMessage `json:"data"`
+}
+
+// Sign HMAC-SHA256 подпись тела запроса в формате "sha256=<hex>"
+func Sign(secret string, body []byte) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write(body)
+	return "sha256=" + hex.EncodeToString(mac.Sum(nil))
+}
+
+// Verify Проверка подписи "sha256=<hex>" тела запроса
+func Verify(secret string, body []byte, signature string) bool {
+	return hmac.Equal([]byte(Sign(secret, body)), []byte(signature))
+}
+
+// Run Запуск доставки до отмены контекста
+func (d *Dispatcher) Run(ctx context.Context) {
+	ticker := time.NewTicker(d.opts.PollInterval)
+	defer ticker.Stop()
+
+	for {
+		d.dispatch(ctx)
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+func (d *Dispatcher) dispatch(ctx context.Context) {
+	// Раскладываем новые события по подписанным webhook
+	if _, err := d.storage.FanOutOutboxEvents(time.Now(), d.opts.BatchSize); err != nil {
+		d.log.Error("failed to fan out outbox events", sl.Err(err))
+		return
+	}
+
+	// Доставки пакета отправляются по очереди, поэтому захват должен покрывать таймауты всех запросов пакета
+	// с запасом на запись результата
+	claimedAt := time.Now()
+	lease := d.lease()
+	deliveries, err := d.storage.ClaimDueDeliveries(claimedAt, lease, d.opts.BatchSize)
+	if err != nil {
+		d.log.Error("failed to claim webhook deliveries", sl.Err(err))
+		return
+	}
+	for _, delivery := range deliveries {
+		if ctx.Err() != nil {
+			return
+		}
+		// Не начинаем отправку, которая может не уложиться в захват: после его окончания
+		// оставшиеся доставки заберёт следующий опрос (в том числе другой экземпляр)
+		if time.Since(claimedAt)+d.opts.Timeout >= lease {
+			d.log.Warn("webhook delivery lease is running out, leaving rest of batch",
+				slog.Int64("delivery_id", delivery.ID))
+			return
+		}
+		d.deliver(ctx, delivery)
+	}
+}
+
+// lease Время захвата пакета доставок: таймауты всех запросов пакета и ещё одного на запись результатов
+func (d *Dispatcher) lease() time.Duration {
+	return time.Duration(max(d.opts.BatchSize, 1)+1) * d.opts.Timeout
+}
+
+func (d *Dispatcher) deliver(ctx context.Context, delivery domain.WebhookDelivery) {
+	err := d.send(ctx, delivery)
+	now := time.Now()
+	if err == nil {
+		if err := d.storage.MarkDeliverySucceeded(delivery.ID, now); err != nil {
+			d.log.Error("failed to mark webhook delivery succeeded", sl.Err(err))
+		}
+		return
+	}
+
+	attempts := delivery.Attempts + 1
+	dead := attempts >= d.opts.MaxAttempts
+	if dead {
+		d.log.Warn("webhook delivery moved to dead-letter",
+			slog.Int64("delivery_id", delivery.ID),
+			slog.String("url", delivery.URL),
+			sl.Err(err))
+	} else {
+		d.log.Debug("webhook delivery failed, will retry",
+			slog.Int64("delivery_id", delivery.ID),
+			slog.Int("attempts", attempts),
+			sl.Err(err))
+	}
+	if err := d.storage.MarkDeliveryFailed(delivery.ID, err.Error(), now.Add(d.backoff(attempts)), dead, now); err != nil {
+		d.log.Error("failed to mark webhook delivery failed", sl.Err(err))
+	}
+}
+
+func (d *Dispatcher) send(ctx context.Context, delivery domain.WebhookDelivery) error {
+	body, err := json.Marshal(Event{
+		ID:         delivery.EventID,
+		Type:       delivery.EventType,
+		OccurredAt: delivery.OccurredAt,
+		Data:       delivery.Payload,
+	})
+	if err != nil {
+		return fmt.Errorf("marshal event: %w", err)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, delivery.URL, bytes.NewReader(body))
+	if err != nil {
+		return fmt.Errorf("build request: %w", err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set(HeaderSignature, Sign(delivery.Secret, body))
+	req.Header.Set(HeaderEventType, delivery.EventType)
+	req.Header.Set(HeaderEventID, strconv.FormatInt(delivery.EventID, 10))
+	req.Header.Set(HeaderDeliveryID, strconv.FormatInt(delivery.ID, 10))
+
+	resp, err := d.client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		_, _ = io.Copy(io.Discard, resp.Body)
+		_ = resp.Body.Close()
+	}()
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return fmt.Errorf("unexpected status %d", resp.StatusCode)
+	}
+	return nil
+}
+
+// backoff Экспоненциальная задержка перед следующей попыткой
+func (d *Dispatcher) backoff(attempts int) time.Duration {
+	delay := d.opts.BaseBackoff
+	for i := 1; i < attempts && delay < d.opts.MaxBackoff; i++ {
+		delay *= 2
+	}
+	if delay > d.opts.MaxBackoff {
+		delay = d.opts.MaxBackoff
+	}
+	return delay
+}
@@ -0,0 +1,48 @@
+package webhook
+
+import (
+	"io"
+	"log/slog"
+	"testing"
+	"time"
+)
+
+func TestNewDispatcherDefaultsPollInterval(t *testing.T) {
+	log := slog.New(slog.NewTextHandler(io.Discard, nil))
+	for _, interval := range []time.Duration{0, -time.Second} {
+		if d := NewDispatcher(log, nil, Options{PollInterval: interval}); d.opts.PollInterval != defaultPollInterval {
+			t.Errorf("NewDispatcher(%v) poll interval = %v, want %v", interval, d.opts.PollInterval, defaultPollInterval)
+		}
+	}
+	if d := NewDispatcher(log, nil, Options{PollInterval: time.Second}); d.opts.PollInterval != time.Second {
+		t.Errorf("NewDispatcher(1s) poll interval = %v, want 1s", d.opts.PollInterval)
+	}
+}
+
+func TestVerify(t *testing.T) {
+	body := []byte(`{"action":"opened","number":42}`)
+	// Подпись, посчитанная вне сервиса: printf '%s' "$body" | openssl dgst -sha256 -hmac secret
+	const recorded = "sha256=34cb41688a32bdffeac3b21151f485c6f356697fe0306082d9c1baf04b9b140b"
+
+	tests := []struct {
+		name      string
+		secret    string
+		body      []byte
+		signature string
+		want      bool
+	}{
+		{name: "recorded signature", secret: "secret", body: body, signature: recorded, want: true},
+		{name: "signed by service", secret: "secret", body: body, signature: Sign("secret", body), want: true},
+		{name: "other secret", secret: "other", body: body, signature: recorded},
+		{name: "modified body", secret: "secret", body: []byte(`{"action":"closed","number":42}`), signature: recorded},
+		{name: "without prefix", secret: "secret", body: body, signature: recorded[len("sha256="):]},
+		{name: "empty signature", secret: "secret", body: body},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := Verify(tt.secret, tt.body, tt.signature); got != tt.want {
+				t.Errorf("Verify() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1 @@
+drop table pr_reviewer_declines;
\ No newline at end of file
@@ -0,0 +1,8 @@
+create table if not exists pr_reviewer_declines (
+    internal_id bigserial primary key,
+    pull_request_id text references pull_requests(id),
+    reviewer_id text references users(id),
+    reason text not null,
+    replaced_by text references users(id),
+    declined_at timestamp not null default now()
+);
@@ -0,0 +1,4 @@
+drop index if exists pr_reviewers_due_at_idx;
+alter table pr_reviewers drop column overdue_at;
+alter table pr_reviewers drop column due_at;
+alter table pr_reviewers drop column assigned_at;
@@ -0,0 +1,4 @@
+alter table pr_reviewers add column if not exists assigned_at timestamp not null default now();
+alter table pr_reviewers add column if not exists due_at timestamp;
+alter table pr_reviewers add column if not exists overdue_at timestamp;
+create index if not exists pr_reviewers_due_at_idx on pr_reviewers (due_at) where due_at is not null;
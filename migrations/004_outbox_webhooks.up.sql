@@ -0,0 +1,27 @@
+create table if not exists outbox_events (
+    id bigserial primary key,
+    event_type text not null,
+    payload jsonb not null,
+    created_at timestamp not null default now(),
+    dispatched_at timestamp
+);
+create index if not exists outbox_events_pending_idx on outbox_events (id) where dispatched_at is null;
+create table if not exists webhooks (
+    id bigserial primary key,
+    url text not null,
+    secret text not null,
+    event_types text[] not null default '{}',
+    is_active boolean not null default true,
+    created_at timestamp not null default now()
+);
+create table if not exists webhook_deliveries (
+    id bigserial primary key,
+    event_id bigint references outbox_events(id),
+    webhook_id bigint references webhooks(id),
+    status text not null,
+    attempts int not null default 0,
+    next_attempt_at timestamp not null,
+    last_error text,
+    updated_at timestamp not null default now()
+);
+create index if not exists webhook_deliveries_pending_idx on webhook_deliveries (next_attempt_at) where status = 'PENDING';
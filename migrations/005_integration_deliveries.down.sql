@@ -0,0 +1 @@
+drop table integration_deliveries;
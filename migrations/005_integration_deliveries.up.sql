@@ -0,0 +1,6 @@
+create table if not exists integration_deliveries (
+    provider text not null,
+    delivery_id text not null,
+    received_at timestamp not null default now(),
+    primary key (provider, delivery_id)
+);
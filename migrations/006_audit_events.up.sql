@@ -0,0 +1,16 @@
+create table if not exists audit_events (
+    id bigserial primary key,
+    event_type text not null,
+    pull_request_id text,
+    user_ids text[] not null default '{}',
+    team_name text,
+    actor text not null,
+    reason text,
+    before jsonb,
+    after jsonb,
+    created_at timestamp not null
+);
+create index if not exists audit_events_pull_request_id_idx on audit_events (pull_request_id);
+create index if not exists audit_events_user_ids_idx on audit_events using gin (user_ids);
+create index if not exists audit_events_team_name_idx on audit_events (team_name);
+create index if not exists audit_events_created_at_idx on audit_events (created_at);
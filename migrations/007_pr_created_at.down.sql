@@ -0,0 +1 @@
+alter table pull_requests drop column created_at;
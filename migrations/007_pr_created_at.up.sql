@@ -0,0 +1,6 @@
+alter table pull_requests add column if not exists created_at timestamp;
+-- Для уже существующих PR время создания восстанавливается из аудита (если есть)
+update pull_requests pr
+set created_at = a.created_at
+from audit_events a
+where a.pull_request_id = pr.id and a.event_type = 'pr.created' and pr.created_at is null;
@@ -0,0 +1,7 @@
+drop index if exists pull_requests_name_trgm_idx;
+drop index if exists teams_users_user_id_idx;
+drop index if exists pr_reviewers_reviewer_id_pull_request_id_idx;
+drop index if exists pull_requests_status_idx;
+drop index if exists pull_requests_author_id_idx;
+drop index if exists pull_requests_merged_at_id_idx;
+drop index if exists pull_requests_created_at_id_idx;
@@ -0,0 +1,12 @@
+-- Keyset пагинация списка PR по времени создания и merge (PR без времени идут первыми)
+create index if not exists pull_requests_created_at_id_idx
+    on pull_requests ((coalesce(created_at, 'epoch'::timestamp)), id);
+create index if not exists pull_requests_merged_at_id_idx
+    on pull_requests ((coalesce(merged_at, 'epoch'::timestamp)), id);
+create index if not exists pull_requests_author_id_idx on pull_requests (author_id);
+create index if not exists pull_requests_status_idx on pull_requests (status);
+create index if not exists pr_reviewers_reviewer_id_pull_request_id_idx on pr_reviewers (reviewer_id, pull_request_id);
+create index if not exists teams_users_user_id_idx on teams_users (user_id);
+-- Поиск по подстроке в названии PR
+create extension if not exists pg_trgm;
+create index if not exists pull_requests_name_trgm_idx on pull_requests using gin (name gin_trgm_ops);
@@ -0,0 +1,15 @@
+create table if not exists idempotency_keys (
+    key text not null,
+    -- Владелец токена: один и тот же ключ разных клиентов не пересекается
+    principal text not null,
+    route text not null,
+    body_hash text not null,
+    -- Пусто, пока первый запрос ещё обрабатывается
+    status_code int,
+    content_type text,
+    response_body bytea,
+    created_at timestamp not null,
+    expires_at timestamp not null,
+    primary key (key, principal, route)
+);
+create index if not exists idempotency_keys_expires_at_idx on idempotency_keys (expires_at);
@@ -0,0 +1,2 @@
+-- Версия PR для оптимистичной блокировки, увеличивается при каждом изменении reviewer или статуса
+alter table pull_requests add column if not exists version bigint not null default 1;
@@ -0,0 +1,11 @@
+create table if not exists api_tokens (
+    id bigserial primary key,
+    name text not null,
+    -- sha256 от токена, сам токен не хранится
+    token_hash text unique not null,
+    role text not null check (role in ('admin', 'user')),
+    -- Пользователь, от имени которого действует токен роли user
+    user_id text references users(id),
+    created_at timestamp not null,
+    revoked_at timestamp
+);
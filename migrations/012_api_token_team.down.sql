@@ -0,0 +1,4 @@
+delete from api_tokens where role = 'team_lead';
+alter table api_tokens drop constraint if exists api_tokens_role_check;
+alter table api_tokens add constraint api_tokens_role_check check (role in ('admin', 'user'));
+alter table api_tokens drop column team_name;
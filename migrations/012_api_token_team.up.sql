@@ -0,0 +1,15 @@
+-- Токен руководителя команды ограничен своей командой
+alter table api_tokens add column if not exists team_name text references teams(name);
+-- Ограничение роли меняется один раз: миграции выполняются при каждом старте, а замена перепроверяет всю таблицу
+do $$
+begin
+    if not exists (
+        select from pg_constraint
+        where conrelid = 'api_tokens'::regclass
+          and conname = 'api_tokens_role_check'
+          and pg_get_constraintdef(oid) like '%team_lead%'
+    ) then
+        alter table api_tokens drop constraint if exists api_tokens_role_check;
+        alter table api_tokens add constraint api_tokens_role_check check (role in ('admin', 'user', 'team_lead'));
+    end if;
+end $$;
@@ -0,0 +1,4 @@
+// Package apiclient HTTP клиент сервиса, сгенерированный из task/openapi.yml
+package apiclient
+
+//go:generate go tool oapi-codegen -config oapi-codegen.yaml ../../task/openapi.yml
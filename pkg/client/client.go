@@ -0,0 +1,200 @@
+// Package client Go клиент HTTP API сервиса назначения reviewer
+package client
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/sudo-odner/Backend-trainee-assignment-avito-2025/pkg/apiclient"
+)
+
+const (
+	headerActor = "X-Actor"
+	headerETag  = "ETag"
+)
+
+// Options Настройки клиента; нулевые значения заменяются значениями по умолчанию
+type Options struct {
+	// Bearer токен API (пусто - без аутентификации)
+	Token string
+	// Инициатор изменений для аудита (учитывается только для admin токена)
+	Actor string
+	// HTTP клиент (по умолчанию http.Client с Timeout)
+	HTTPClient *http.Client
+	Timeout    time.Duration
+	// Попытки идемпотентных запросов (первая попытка и повторы)
+	MaxAttempts int
+	BaseBackoff time.Duration
+	MaxBackoff  time.Duration
+}
+
+// Client Клиент API поверх сгенерированного apiclient: ошибки по кодам ответа и повторы идемпотентных запросов
+type Client struct {
+	api  *apiclient.ClientWithResponses
+	opts Options
+}
+
+func New(baseURL string, opts Options) *Client {
+	if opts.Timeout <= 0 {
+		opts.Timeout = 10 * time.Second
+	}
+	if opts.HTTPClient == nil {
+		opts.HTTPClient = &http.Client{Timeout: opts.Timeout}
+	}
+	if opts.MaxAttempts <= 0 {
+		opts.MaxAttempts = 3
+	}
+	if opts.BaseBackoff <= 0 {
+		opts.BaseBackoff = 100 * time.Millisecond
+	}
+	if opts.MaxBackoff <= 0 {
+		opts.MaxBackoff = 2 * time.Second
+	}
+	c := &Client{opts: opts}
+	c.api = &apiclient.ClientWithResponses{ClientInterface: &apiclient.Client{
+		Server:         strings.TrimSuffix(baseURL, "/") + "/",
+		Client:         &retryDoer{http: opts.HTTPClient, opts: opts},
+		RequestEditors: []apiclient.RequestEditorFn{c.setHeaders},
+	}}
+	return c
+}
+
+// setHeaders Заголовки, общие для всех запросов
+func (c *Client) setHeaders(_ context.Context, req *http.Request) error {
+	req.Header.Set("Accept", "application/json")
+	if c.opts.Token != "" {
+		req.Header.Set("Authorization", "Bearer "+c.opts.Token)
+	}
+	if c.opts.Actor != "" {
+		req.Header.Set(headerActor, c.opts.Actor)
+	}
+	return nil
+}
+
+// idempotentKey Ключ контекста: повтор запроса безопасен
+type idempotentKey struct{}
+
+// idempotent Разрешает повторы запроса: GET и операции, повтор которых не меняет результат
+func idempotent(ctx context.Context) context.Context {
+	return context.WithValue(ctx, idempotentKey{}, true)
+}
+
+// retryDoer Отправка запросов с повтором идемпотентных по сетевой ошибке, 429 и ошибке сервера
+type retryDoer struct {
+	http *http.Client
+	opts Options
+}
+
+func (d *retryDoer) Do(req *http.Request) (*http.Response, error) {
+	ctx := req.Context()
+	attempts := 1
+	if retry, _ := ctx.Value(idempotentKey{}).(bool); retry {
+		attempts = d.opts.MaxAttempts
+	}
+	for attempt := 1; ; attempt++ {
+		if attempt > 1 && req.GetBody != nil {
+			body, err := req.GetBody()
+			if err != nil {
+				return nil, fmt.Errorf("build request: %w", err)
+			}
+			req.Body = body
+		}
+
+		resp, err := d.http.Do(req)
+		if err != nil {
+			if ctx.Err() != nil {
+				return nil, ctx.Err()
+			}
+			err = &networkError{err: err}
+			if attempt >= attempts {
+				return nil, err
+			}
+		} else if attempt >= attempts || !retryableStatus(resp.StatusCode) {
+			return resp, nil
+		}
+
+		// Сервер может попросить подождать дольше (429 Retry-After)
+		delay := d.backoff(attempt)
+		if resp != nil {
+			if retryAfter := parseRetryAfter(resp.Header); retryAfter > delay {
+				delay = retryAfter
+			}
+			_, _ = io.Copy(io.Discard, resp.Body)
+			_ = resp.Body.Close()
+		}
+		timer := time.NewTimer(delay)
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return nil, ctx.Err()
+		case <-timer.C:
+		}
+	}
+}
+
+// backoff Экспоненциальная задержка перед повтором
+func (d *retryDoer) backoff(attempt int) time.Duration {
+	delay := d.opts.BaseBackoff
+	for i := 1; i < attempt && delay < d.opts.MaxBackoff; i++ {
+		delay *= 2
+	}
+	if delay > d.opts.MaxBackoff {
+		delay = d.opts.MaxBackoff
+	}
+	return delay
+}
+
+// networkError Запрос не дошёл до сервиса или ответ не получен
+type networkError struct {
+	err error
+}
+
+func (e *networkError) Error() string {
+	return "send request: " + e.err.Error()
+}
+
+func (e *networkError) Unwrap() error {
+	return e.err
+}
+
+// retryableStatus Повтор имеет смысл: 429 или ошибка сервера
+func retryableStatus(status int) bool {
+	return status == http.StatusTooManyRequests || status >= http.StatusInternalServerError
+}
+
+// responseError Ошибка по ответу без ожидаемого тела: ответ API с ошибкой или неожиданный формат
+func responseError(resp *http.Response, body []byte) error {
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return decodeError(resp, body)
+	}
+	return fmt.Errorf("decode response: unexpected status %d with content type %q", resp.StatusCode, resp.Header.Get("Content-Type"))
+}
+
+// optional Необязательный параметр (пустое значение не передаётся)
+func optional[T ~string](value string) *T {
+	if value == "" {
+		return nil
+	}
+	res := T(value)
+	return &res
+}
+
+// optionalTime Необязательный параметр времени (нулевое значение не передаётся)
+func optionalTime(value time.Time) *time.Time {
+	if value.IsZero() {
+		return nil
+	}
+	return &value
+}
+
+// optionalLimit Необязательный размер страницы (0 - по умолчанию)
+func optionalLimit(limit int) *int {
+	if limit <= 0 {
+		return nil
+	}
+	return &limit
+}
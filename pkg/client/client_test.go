@@ -0,0 +1,135 @@
+package client
+
+import (
+	"context"
+	"errors"
+	"io"
+	"log/slog"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/sudo-odner/Backend-trainee-assignment-avito-2025/internal/config"
+	"github.com/sudo-odner/Backend-trainee-assignment-avito-2025/internal/storage/storagetest"
+	"github.com/sudo-odner/Backend-trainee-assignment-avito-2025/internal/transport/router"
+)
+
+const testToken = "admin-token"
+
+// testStorage Хранилище в памяти; не реализованные им методы Storage паникуют, роутер отвечает 500
+type testStorage struct {
+	*storagetest.Storage
+	unimplementedStorage
+}
+
+type unimplementedStorage struct {
+	router.Storage
+}
+
+// newTestClient Клиент к настоящему роутеру с хранилищем в памяти
+func newTestClient(t *testing.T, s *storagetest.Storage, token string) *Client {
+	t.Helper()
+	cfg := &config.Config{}
+	cfg.Auth.Enabled = true
+	cfg.Auth.AdminToken = testToken
+	cfg.OpenAPI.ValidateRequests = true
+	server := httptest.NewServer(router.New(slog.New(slog.NewTextHandler(io.Discard, nil)), &testStorage{Storage: s}, cfg))
+	t.Cleanup(server.Close)
+	return New(server.URL, Options{Token: token, BaseBackoff: time.Millisecond, MaxBackoff: time.Millisecond})
+}
+
+func TestTeamAndPullRequest(t *testing.T) {
+	ctx := context.Background()
+	c := newTestClient(t, storagetest.Empty(), testToken)
+
+	team := Team{TeamName: "backend", Members: []TeamMember{{UserId: "u1", Username: "Alice", IsActive: true}}}
+	if _, err := c.AddTeam(ctx, team); err != nil {
+		t.Fatalf("AddTeam() error = %v", err)
+	}
+	if _, err := c.AddTeam(ctx, team); !errors.Is(err, ErrTeamExists) {
+		t.Errorf("AddTeam() again error = %v, want ErrTeamExists", err)
+	}
+	got, err := c.GetTeam(ctx, "backend")
+	if err != nil || got.TeamName != "backend" || len(got.Members) != 1 {
+		t.Fatalf("GetTeam() = %+v, %v", got, err)
+	}
+	if _, err := c.GetTeam(ctx, "missing"); !errors.Is(err, ErrNotFound) {
+		t.Errorf("GetTeam(missing) error = %v, want ErrNotFound", err)
+	}
+
+	created, err := c.CreatePR(ctx, "pr-1", "Feature", "u1")
+	if err != nil {
+		t.Fatalf("CreatePR() error = %v", err)
+	}
+	if string(created.PR.Status) != StatusOpen || created.ETag != `"1"` {
+		t.Errorf("CreatePR() = %+v, want OPEN with ETag \"1\"", created)
+	}
+	merged, err := c.MergePR(ctx, "pr-1", created.ETag)
+	if err != nil {
+		t.Fatalf("MergePR() error = %v", err)
+	}
+	if string(merged.PR.Status) != StatusMerged || merged.PR.MergedAt == nil || merged.ETag != `"2"` {
+		t.Errorf("MergePR() = %+v, want MERGED with ETag \"2\"", merged)
+	}
+	if _, err := c.MergePR(ctx, "pr-1", created.ETag); !errors.Is(err, ErrPreconditionFailed) {
+		t.Errorf("MergePR(stale ETag) error = %v, want ErrPreconditionFailed", err)
+	}
+}
+
+func TestErrorResponse(t *testing.T) {
+	ctx := context.Background()
+
+	var apiErr *Error
+	_, err := newTestClient(t, storagetest.Empty(), "wrong-token").GetTeam(ctx, "backend")
+	if !errors.Is(err, ErrUnauthorized) || !errors.As(err, &apiErr) || apiErr.StatusCode != 401 || apiErr.RequestID == "" {
+		t.Errorf("GetTeam(wrong token) error = %#v, want 401 ErrUnauthorized with request id", err)
+	}
+
+	// Запрос отклоняется проверкой по спецификации до обработчика
+	_, err = newTestClient(t, storagetest.Empty(), testToken).ListPRs(ctx, PRListQuery{Status: "CLOSED"})
+	if !errors.Is(err, ErrBadRequest) {
+		t.Errorf("ListPRs(status=CLOSED) error = %v, want ErrBadRequest", err)
+	}
+}
+
+func TestMergePRRetry(t *testing.T) {
+	tests := []struct {
+		name      string
+		ifMatch   string
+		wantCalls int
+		wantErr   error
+	}{
+		{name: "without If-Match", wantCalls: 2},
+		// Первый merge мог пройти, повтор с той же версией получил бы 412 вместо ответа
+		{name: "with If-Match", ifMatch: `"1"`, wantCalls: 1, wantErr: ErrServer},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s := storagetest.New()
+			s.FailNext(1)
+			c := newTestClient(t, s, testToken)
+
+			_, err := c.MergePR(context.Background(), "pr-1", tt.ifMatch)
+			if !errors.Is(err, tt.wantErr) {
+				t.Errorf("MergePR() error = %v, want %v", err, tt.wantErr)
+			}
+			if got := s.Count("MergePR"); got != tt.wantCalls {
+				t.Errorf("MergePR calls = %d, want %d", got, tt.wantCalls)
+			}
+		})
+	}
+}
+
+func TestCreatePRNotRetried(t *testing.T) {
+	s := storagetest.New()
+	s.FailNext(1)
+	c := newTestClient(t, s, testToken)
+
+	if _, err := c.CreatePR(context.Background(), "pr-1", "Feature", "u1"); !errors.Is(err, ErrServer) {
+		t.Errorf("CreatePR() error = %v, want ErrServer", err)
+	}
+	if got := s.Count("CreatePRWithReviewers"); got != 1 {
+		t.Errorf("CreatePRWithReviewers calls = %d, want 1", got)
+	}
+}
@@ -0,0 +1,102 @@
+package client
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/sudo-odner/Backend-trainee-assignment-avito-2025/internal/transport"
+	"github.com/sudo-odner/Backend-trainee-assignment-avito-2025/pkg/apiclient"
+)
+
+// Ошибки API по кодам ответа, проверяются через errors.Is
+var (
+	ErrNotFound            = errors.New("resource not found")
+	ErrBadRequest          = errors.New("bad request")
+	ErrUnauthorized        = errors.New("unauthorized")
+	ErrForbidden           = errors.New("forbidden")
+	ErrTeamExists          = errors.New("team already exists")
+	ErrPRExists            = errors.New("pull request already exists")
+	ErrPRMerged            = errors.New("pull request is merged")
+	ErrNotAssigned         = errors.New("reviewer is not assigned")
+	ErrNoCandidate         = errors.New("no replacement candidate")
+	ErrNotEmpty            = errors.New("storage is not empty")
+	ErrPreconditionFailed  = errors.New("pull request version mismatch")
+	ErrIdempotencyConflict = errors.New("idempotency key conflict")
+	ErrRateLimited         = errors.New("rate limited")
+	ErrServer              = errors.New("server error")
+)
+
+// codeErrors Ошибка клиента по коду ответа сервиса
+var codeErrors = map[string]error{
+	transport.NOT_FOUND:            ErrNotFound,
+	transport.BAD_REQUEST:          ErrBadRequest,
+	transport.UNAUTHORIZED:         ErrUnauthorized,
+	transport.FORBIDDEN:            ErrForbidden,
+	transport.TEAM_EXISTS:          ErrTeamExists,
+	transport.PR_EXISTS:            ErrPRExists,
+	transport.PR_MERGED:            ErrPRMerged,
+	transport.NOT_ASSIGNED:         ErrNotAssigned,
+	transport.NO_CANDIDATE:         ErrNoCandidate,
+	transport.NOT_EMPTY:            ErrNotEmpty,
+	transport.PRECONDITION_FAILED:  ErrPreconditionFailed,
+	transport.IDEMPOTENCY_CONFLICT: ErrIdempotencyConflict,
+	transport.RATE_LIMITED:         ErrRateLimited,
+	transport.SERVER_ERROR:         ErrServer,
+}
+
+// Error Ответ API с ошибкой
+type Error struct {
+	StatusCode int
+	Code       string
+	Message    string
+	// Идентификатор запроса для поиска в логах сервиса
+	RequestID string
+	// Задержка из Retry-After (для 429)
+	RetryAfter time.Duration
+}
+
+func (e *Error) Error() string {
+	if e.Code == "" {
+		return fmt.Sprintf("api error %d: %s", e.StatusCode, e.Message)
+	}
+	return fmt.Sprintf("api error %d %s: %s", e.StatusCode, e.Code, e.Message)
+}
+
+// Is Сравнение с ErrNotFound и другими ошибками по коду; ответ без кода (например, от прокси) сравнивается по статусу
+func (e *Error) Is(target error) bool {
+	if known, ok := codeErrors[e.Code]; ok {
+		return known == target
+	}
+	return target == ErrServer && e.StatusCode >= http.StatusInternalServerError
+}
+
+// decodeError Ошибка из ответа в формате ErrorResponse спецификации
+func decodeError(resp *http.Response, body []byte) error {
+	apiErr := &Error{
+		StatusCode: resp.StatusCode,
+		Message:    http.StatusText(resp.StatusCode),
+		RetryAfter: parseRetryAfter(resp.Header),
+	}
+	var errResp apiclient.ErrorResponse
+	if err := json.Unmarshal(body, &errResp); err != nil || errResp.Error.Code == "" {
+		return apiErr
+	}
+	apiErr.Code = string(errResp.Error.Code)
+	apiErr.Message = errResp.Error.Message
+	if errResp.Error.RequestId != nil {
+		apiErr.RequestID = *errResp.Error.RequestId
+	}
+	return apiErr
+}
+
+// parseRetryAfter Задержка из Retry-After в секундах (0 - не задана)
+func parseRetryAfter(header http.Header) time.Duration {
+	if seconds, err := strconv.Atoi(header.Get("Retry-After")); err == nil && seconds > 0 {
+		return time.Duration(seconds) * time.Second
+	}
+	return 0
+}
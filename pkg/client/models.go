@@ -0,0 +1,121 @@
+package client
+
+import (
+	"time"
+
+	"github.com/sudo-odner/Backend-trainee-assignment-avito-2025/pkg/apiclient"
+)
+
+// Типы ручек из task/openapi.yml берутся из сгенерированного клиента
+type (
+	Team               = apiclient.Team
+	TeamMember         = apiclient.TeamMember
+	User               = apiclient.User
+	PullRequest        = apiclient.PullRequest
+	PullRequestShort   = apiclient.PullRequestShort
+	PullRequestDetails = apiclient.PullRequestDetails
+	RebalanceMove      = apiclient.RebalanceMove
+	ImportSummary      = apiclient.ImportSummary
+	Decline            = apiclient.ReviewerDecline
+	TimelineItem       = apiclient.TimelineItem
+	OverdueReview      = apiclient.OverdueReview
+	ReviewStat         = apiclient.ReviewStat
+	TeamStat           = apiclient.TeamStat
+	PRStat             = apiclient.PullRequestStat
+	TeamFairness       = apiclient.TeamFairness
+)
+
+// Статусы PR
+const (
+	StatusOpen   = string(apiclient.PullRequestStatusOPEN)
+	StatusMerged = string(apiclient.PullRequestStatusMERGED)
+)
+
+// Роль пользователя в очереди PR (ReviewQuery.Role)
+const (
+	RoleReviewer = string(apiclient.Reviewer)
+	RoleAuthor   = string(apiclient.Author)
+)
+
+// ReviewQuery Параметры очереди PR пользователя (пустые - по умолчанию)
+type ReviewQuery struct {
+	UserID string
+	// RoleReviewer (по умолчанию) или RoleAuthor
+	Role   string
+	Status string
+	// Сортировка по времени создания: asc (по умолчанию) или desc
+	Order  string
+	Cursor string
+	Limit  int
+}
+
+// ReviewPage Страница очереди PR пользователя
+type ReviewPage struct {
+	UserID       string
+	PullRequests []PullRequestShort
+	// Курсор следующей страницы (nil - страниц больше нет)
+	NextCursor *string
+}
+
+// PRResult PR после изменения и его версия для If-Match
+type PRResult struct {
+	PR   PullRequest
+	ETag string
+}
+
+// ReassignResult Результат переназначения reviewer
+type ReassignResult struct {
+	PR         PullRequest
+	ReplacedBy string
+	ETag       string
+}
+
+// DeclineResult Результат отказа от ревью
+type DeclineResult struct {
+	PR PullRequest
+	// Замена (nil - кандидатов не нашлось)
+	ReplacedBy *string
+	Declines   []Decline
+	ETag       string
+}
+
+// PRDetails PR с reviewer и хронологией изменений
+type PRDetails struct {
+	PR       PullRequestDetails
+	Timeline []TimelineItem
+	ETag     string
+}
+
+// PRListQuery Фильтры списка PR (пустые значения не передаются)
+type PRListQuery struct {
+	AuthorID   string
+	ReviewerID string
+	TeamName   string
+	Status     string
+	// Подстрока в названии PR
+	NameQuery string
+	// Диапазоны времени [From, To)
+	CreatedFrom time.Time
+	CreatedTo   time.Time
+	MergedFrom  time.Time
+	MergedTo    time.Time
+	// Поле сортировки: created_at (по умолчанию) или merged_at; asc (по умолчанию) или desc
+	Sort   string
+	Order  string
+	Cursor string
+	Limit  int
+}
+
+// PRPage Страница списка PR
+type PRPage struct {
+	PullRequests []PullRequest
+	NextCursor   *string
+}
+
+// StatQuery Фильтры статистики: окно времени [From, To), команда и статус PR
+type StatQuery struct {
+	From     time.Time
+	To       time.Time
+	TeamName string
+	Status   string
+}
@@ -0,0 +1,147 @@
+package client
+
+import (
+	"context"
+
+	"github.com/sudo-odner/Backend-trainee-assignment-avito-2025/pkg/apiclient"
+)
+
+// CreatePR Создание PR с автоматическим назначением reviewer
+func (c *Client) CreatePR(ctx context.Context, prID, prName, authorID string) (*PRResult, error) {
+	resp, err := c.api.PostPullRequestCreateWithResponse(ctx, apiclient.PostPullRequestCreateJSONRequestBody{
+		PullRequestId:   prID,
+		PullRequestName: prName,
+		AuthorId:        authorID,
+	})
+	if err != nil {
+		return nil, err
+	}
+	if resp.JSON201 == nil {
+		return nil, responseError(resp.HTTPResponse, resp.Body)
+	}
+	return &PRResult{PR: resp.JSON201.Pr, ETag: resp.HTTPResponse.Header.Get(headerETag)}, nil
+}
+
+// MergePR Перевод PR в MERGED (ifMatch - ожидаемая версия из ETag, пусто - без проверки)
+func (c *Client) MergePR(ctx context.Context, prID, ifMatch string) (*PRResult, error) {
+	// Повтор с If-Match после потерянного ответа вернул бы 412: версия уже сменилась при первом merge
+	if ifMatch == "" {
+		ctx = idempotent(ctx)
+	}
+	resp, err := c.api.PostPullRequestMergeWithResponse(ctx,
+		&apiclient.PostPullRequestMergeParams{IfMatch: optional[apiclient.IfMatchHeader](ifMatch)},
+		apiclient.PostPullRequestMergeJSONRequestBody{PullRequestId: prID})
+	if err != nil {
+		return nil, err
+	}
+	if resp.JSON200 == nil {
+		return nil, responseError(resp.HTTPResponse, resp.Body)
+	}
+	return &PRResult{PR: resp.JSON200.Pr, ETag: resp.HTTPResponse.Header.Get(headerETag)}, nil
+}
+
+// ReassignReviewer Замена reviewer на другого из его команды
+func (c *Client) ReassignReviewer(ctx context.Context, prID, oldReviewerID, ifMatch string) (*ReassignResult, error) {
+	resp, err := c.api.PostPullRequestReassignWithResponse(ctx,
+		&apiclient.PostPullRequestReassignParams{IfMatch: optional[apiclient.IfMatchHeader](ifMatch)},
+		apiclient.PostPullRequestReassignJSONRequestBody{PullRequestId: prID, OldReviewerId: oldReviewerID})
+	if err != nil {
+		return nil, err
+	}
+	if resp.JSON200 == nil {
+		return nil, responseError(resp.HTTPResponse, resp.Body)
+	}
+	return &ReassignResult{
+		PR:         resp.JSON200.Pr,
+		ReplacedBy: resp.JSON200.ReplacedBy,
+		ETag:       resp.HTTPResponse.Header.Get(headerETag),
+	}, nil
+}
+
+// DeclineReview Отказ reviewer от ревью с причиной и подбором замены
+func (c *Client) DeclineReview(ctx context.Context, prID, reviewerID, reason, ifMatch string) (*DeclineResult, error) {
+	resp, err := c.api.PostPullRequestDeclineWithResponse(ctx,
+		&apiclient.PostPullRequestDeclineParams{IfMatch: optional[apiclient.IfMatchHeader](ifMatch)},
+		apiclient.PostPullRequestDeclineJSONRequestBody{PullRequestId: prID, ReviewerId: reviewerID, Reason: reason})
+	if err != nil {
+		return nil, err
+	}
+	if resp.JSON200 == nil {
+		return nil, responseError(resp.HTTPResponse, resp.Body)
+	}
+	return &DeclineResult{
+		PR:         resp.JSON200.Pr,
+		ReplacedBy: resp.JSON200.ReplacedBy,
+		Declines:   resp.JSON200.Declines,
+		ETag:       resp.HTTPResponse.Header.Get(headerETag),
+	}, nil
+}
+
+// GetPR PR с reviewer и хронологией изменений
+func (c *Client) GetPR(ctx context.Context, prID string) (*PRDetails, error) {
+	resp, err := c.api.GetPullRequestGetWithResponse(idempotent(ctx), &apiclient.GetPullRequestGetParams{PullRequestId: prID})
+	if err != nil {
+		return nil, err
+	}
+	if resp.JSON200 == nil {
+		return nil, responseError(resp.HTTPResponse, resp.Body)
+	}
+	return &PRDetails{
+		PR:       resp.JSON200.Pr,
+		Timeline: resp.JSON200.Timeline,
+		ETag:     resp.HTTPResponse.Header.Get(headerETag),
+	}, nil
+}
+
+// ListPRs Список PR с фильтрами и постраничной выдачей
+func (c *Client) ListPRs(ctx context.Context, q PRListQuery) (*PRPage, error) {
+	resp, err := c.api.GetPullRequestListWithResponse(idempotent(ctx), &apiclient.GetPullRequestListParams{
+		AuthorId:    optional[string](q.AuthorID),
+		ReviewerId:  optional[string](q.ReviewerID),
+		TeamName:    optional[apiclient.TeamNameFilter](q.TeamName),
+		Status:      optional[apiclient.GetPullRequestListParamsStatus](q.Status),
+		Q:           optional[string](q.NameQuery),
+		CreatedFrom: optionalTime(q.CreatedFrom),
+		CreatedTo:   optionalTime(q.CreatedTo),
+		MergedFrom:  optionalTime(q.MergedFrom),
+		MergedTo:    optionalTime(q.MergedTo),
+		Sort:        optional[apiclient.GetPullRequestListParamsSort](q.Sort),
+		Order:       optional[apiclient.GetPullRequestListParamsOrder](q.Order),
+		Cursor:      optional[apiclient.CursorQuery](q.Cursor),
+		Limit:       optionalLimit(q.Limit),
+	})
+	if err != nil {
+		return nil, err
+	}
+	if resp.JSON200 == nil {
+		return nil, responseError(resp.HTTPResponse, resp.Body)
+	}
+	return &PRPage{PullRequests: resp.JSON200.PullRequests, NextCursor: resp.JSON200.NextCursor}, nil
+}
+
+// GetPRDeclines История отказов от ревью PR
+func (c *Client) GetPRDeclines(ctx context.Context, prID string) ([]Decline, error) {
+	resp, err := c.api.GetPullRequestDeclinesWithResponse(idempotent(ctx), &apiclient.GetPullRequestDeclinesParams{PullRequestId: prID})
+	if err != nil {
+		return nil, err
+	}
+	if resp.JSON200 == nil {
+		return nil, responseError(resp.HTTPResponse, resp.Body)
+	}
+	return resp.JSON200.Declines, nil
+}
+
+// GetOverdueReviews Просроченные ревью (фильтры по команде и reviewer необязательны)
+func (c *Client) GetOverdueReviews(ctx context.Context, teamName, reviewerID string) ([]OverdueReview, error) {
+	resp, err := c.api.GetPullRequestOverdueWithResponse(idempotent(ctx), &apiclient.GetPullRequestOverdueParams{
+		TeamName:   optional[apiclient.TeamNameFilter](teamName),
+		ReviewerId: optional[string](reviewerID),
+	})
+	if err != nil {
+		return nil, err
+	}
+	if resp.JSON200 == nil {
+		return nil, responseError(resp.HTTPResponse, resp.Body)
+	}
+	return resp.JSON200.Reviews, nil
+}
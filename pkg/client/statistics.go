@@ -0,0 +1,75 @@
+package client
+
+import (
+	"context"
+
+	"github.com/sudo-odner/Backend-trainee-assignment-avito-2025/pkg/apiclient"
+)
+
+// GetReviewStat Число назначений на ревью по пользователям
+func (c *Client) GetReviewStat(ctx context.Context, q StatQuery) ([]ReviewStat, error) {
+	resp, err := c.api.GetStatisticReviewsWithResponse(idempotent(ctx), &apiclient.GetStatisticReviewsParams{
+		From:     optionalTime(q.From),
+		To:       optionalTime(q.To),
+		TeamName: optional[apiclient.TeamNameFilter](q.TeamName),
+		Status:   optional[apiclient.GetStatisticReviewsParamsStatus](q.Status),
+	})
+	if err != nil {
+		return nil, err
+	}
+	if resp.JSON200 == nil {
+		return nil, responseError(resp.HTTPResponse, resp.Body)
+	}
+	return resp.JSON200.ReviewStat, nil
+}
+
+// GetTeamStat Статистика по командам
+func (c *Client) GetTeamStat(ctx context.Context, q StatQuery) ([]TeamStat, error) {
+	resp, err := c.api.GetStatisticTeamsWithResponse(idempotent(ctx), &apiclient.GetStatisticTeamsParams{
+		From:     optionalTime(q.From),
+		To:       optionalTime(q.To),
+		TeamName: optional[apiclient.TeamNameFilter](q.TeamName),
+		Status:   optional[apiclient.GetStatisticTeamsParamsStatus](q.Status),
+	})
+	if err != nil {
+		return nil, err
+	}
+	if resp.JSON200 == nil {
+		return nil, responseError(resp.HTTPResponse, resp.Body)
+	}
+	return resp.JSON200.TeamStat, nil
+}
+
+// GetPRStat Статистика по PR и время до merge
+func (c *Client) GetPRStat(ctx context.Context, q StatQuery) (*PRStat, error) {
+	resp, err := c.api.GetStatisticPullRequestsWithResponse(idempotent(ctx), &apiclient.GetStatisticPullRequestsParams{
+		From:     optionalTime(q.From),
+		To:       optionalTime(q.To),
+		TeamName: optional[apiclient.TeamNameFilter](q.TeamName),
+		Status:   optional[apiclient.GetStatisticPullRequestsParamsStatus](q.Status),
+	})
+	if err != nil {
+		return nil, err
+	}
+	if resp.JSON200 == nil {
+		return nil, responseError(resp.HTTPResponse, resp.Body)
+	}
+	return resp.JSON200, nil
+}
+
+// GetFairness Метрики распределения нагрузки ревью по командам
+func (c *Client) GetFairness(ctx context.Context, q StatQuery) ([]TeamFairness, error) {
+	resp, err := c.api.GetStatisticFairnessWithResponse(idempotent(ctx), &apiclient.GetStatisticFairnessParams{
+		From:     optionalTime(q.From),
+		To:       optionalTime(q.To),
+		TeamName: optional[apiclient.TeamNameFilter](q.TeamName),
+		Status:   optional[apiclient.GetStatisticFairnessParamsStatus](q.Status),
+	})
+	if err != nil {
+		return nil, err
+	}
+	if resp.JSON200 == nil {
+		return nil, responseError(resp.HTTPResponse, resp.Body)
+	}
+	return resp.JSON200.Teams, nil
+}
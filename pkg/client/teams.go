@@ -0,0 +1,78 @@
+package client
+
+import (
+	"bytes"
+	"context"
+
+	"github.com/sudo-odner/Backend-trainee-assignment-avito-2025/pkg/apiclient"
+)
+
+// AddTeam Создание команды с участниками (создаёт/обновляет пользователей)
+func (c *Client) AddTeam(ctx context.Context, team Team) (*Team, error) {
+	resp, err := c.api.PostTeamAddWithResponse(ctx, team)
+	if err != nil {
+		return nil, err
+	}
+	if resp.JSON201 == nil {
+		return nil, responseError(resp.HTTPResponse, resp.Body)
+	}
+	return &resp.JSON201.Team, nil
+}
+
+// GetTeam Команда с участниками
+func (c *Client) GetTeam(ctx context.Context, teamName string) (*Team, error) {
+	resp, err := c.api.GetTeamGetWithResponse(idempotent(ctx), &apiclient.GetTeamGetParams{TeamName: teamName})
+	if err != nil {
+		return nil, err
+	}
+	if resp.JSON200 == nil {
+		return nil, responseError(resp.HTTPResponse, resp.Body)
+	}
+	return resp.JSON200, nil
+}
+
+// DeactivateTeam Деактивация всех участников команды, возвращает число деактивированных
+func (c *Client) DeactivateTeam(ctx context.Context, teamName string) (int, error) {
+	resp, err := c.api.PostTeamDeactivateWithResponse(idempotent(ctx), apiclient.PostTeamDeactivateJSONRequestBody{TeamName: teamName})
+	if err != nil {
+		return 0, err
+	}
+	if resp.JSON200 == nil {
+		return 0, responseError(resp.HTTPResponse, resp.Body)
+	}
+	return resp.JSON200.DeactivateCount, nil
+}
+
+// RebalanceTeam Перераспределение ревью внутри команды (dryRun - только план)
+func (c *Client) RebalanceTeam(ctx context.Context, teamName string, dryRun bool) ([]RebalanceMove, error) {
+	if dryRun {
+		ctx = idempotent(ctx)
+	}
+	resp, err := c.api.PostTeamRebalanceWithResponse(ctx, apiclient.PostTeamRebalanceJSONRequestBody{TeamName: teamName, DryRun: &dryRun})
+	if err != nil {
+		return nil, err
+	}
+	if resp.JSON200 == nil {
+		return nil, responseError(resp.HTTPResponse, resp.Body)
+	}
+	return resp.JSON200.Moves, nil
+}
+
+// ImportTeams Импорт состава команд из YAML или CSV (format: yaml|csv)
+func (c *Client) ImportTeams(ctx context.Context, roster []byte, format string, dryRun bool) (*ImportSummary, error) {
+	if dryRun {
+		ctx = idempotent(ctx)
+	}
+	params := &apiclient.PostTeamImportParams{
+		Format: optional[apiclient.PostTeamImportParamsFormat](format),
+		DryRun: &dryRun,
+	}
+	resp, err := c.api.PostTeamImportWithBodyWithResponse(ctx, params, "text/"+format, bytes.NewReader(roster))
+	if err != nil {
+		return nil, err
+	}
+	if resp.JSON200 == nil {
+		return nil, responseError(resp.HTTPResponse, resp.Body)
+	}
+	return resp.JSON200, nil
+}
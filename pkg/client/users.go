@@ -0,0 +1,43 @@
+package client
+
+import (
+	"context"
+
+	"github.com/sudo-odner/Backend-trainee-assignment-avito-2025/pkg/apiclient"
+)
+
+// SetUserActive Установка флага активности пользователя
+func (c *Client) SetUserActive(ctx context.Context, userID string, isActive bool) (*User, error) {
+	resp, err := c.api.PostUsersSetIsActiveWithResponse(idempotent(ctx),
+		apiclient.PostUsersSetIsActiveJSONRequestBody{UserId: userID, IsActive: isActive})
+	if err != nil {
+		return nil, err
+	}
+	if resp.JSON200 == nil {
+		return nil, responseError(resp.HTTPResponse, resp.Body)
+	}
+	return &resp.JSON200.User, nil
+}
+
+// GetUserReviews Очередь пользователя: PR, где он reviewer или автор
+func (c *Client) GetUserReviews(ctx context.Context, q ReviewQuery) (*ReviewPage, error) {
+	resp, err := c.api.GetUsersGetReviewWithResponse(idempotent(ctx), &apiclient.GetUsersGetReviewParams{
+		UserId: q.UserID,
+		Role:   optional[apiclient.GetUsersGetReviewParamsRole](q.Role),
+		Status: optional[apiclient.GetUsersGetReviewParamsStatus](q.Status),
+		Order:  optional[apiclient.GetUsersGetReviewParamsOrder](q.Order),
+		Cursor: optional[string](q.Cursor),
+		Limit:  optionalLimit(q.Limit),
+	})
+	if err != nil {
+		return nil, err
+	}
+	if resp.JSON200 == nil {
+		return nil, responseError(resp.HTTPResponse, resp.Body)
+	}
+	return &ReviewPage{
+		UserID:       resp.JSON200.UserId,
+		PullRequests: resp.JSON200.PullRequests,
+		NextCursor:   resp.JSON200.NextCursor,
+	}, nil
+}
@@ -0,0 +1,4 @@
+// Package prpb gRPC API сервиса, сгенерированный из pr_service.proto
+package prpb
+
+//go:generate protoc --go_out=. --go_opt=paths=source_relative --go-grpc_out=. --go-grpc_opt=paths=source_relative pr_service.proto
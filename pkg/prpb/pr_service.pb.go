@@ -0,0 +1,2667 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.12
+// 	protoc        (unknown)
+// source: pr_service.proto
+
+package prpb
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	durationpb "google.golang.org/protobuf/types/known/durationpb"
+	structpb "google.golang.org/protobuf/types/known/structpb"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type PullRequestStatus int32
+
+const (
+	PullRequestStatus_PULL_REQUEST_STATUS_UNSPECIFIED PullRequestStatus = 0
+	PullRequestStatus_PULL_REQUEST_STATUS_OPEN        PullRequestStatus = 1
+	PullRequestStatus_PULL_REQUEST_STATUS_MERGED      PullRequestStatus = 2
+)
+
+// Enum value maps for PullRequestStatus.
+var (
+	PullRequestStatus_name = map[int32]string{
+		0: "PULL_REQUEST_STATUS_UNSPECIFIED",
+		1: "PULL_REQUEST_STATUS_OPEN",
+		2: "PULL_REQUEST_STATUS_MERGED",
+	}
+	PullRequestStatus_value = map[string]int32{
+		"PULL_REQUEST_STATUS_UNSPECIFIED": 0,
+		"PULL_REQUEST_STATUS_OPEN":        1,
+		"PULL_REQUEST_STATUS_MERGED":      2,
+	}
+)
+
+func (x PullRequestStatus) Enum() *PullRequestStatus {
+	p := new(PullRequestStatus)
+	*p = x
+	return p
+}
+
+func (x PullRequestStatus) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (PullRequestStatus) Descriptor() protoreflect.EnumDescriptor {
+	return file_pr_service_proto_enumTypes[0].Descriptor()
+}
+
+func (PullRequestStatus) Type() protoreflect.EnumType {
+	return &file_pr_service_proto_enumTypes[0]
+}
+
+func (x PullRequestStatus) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use PullRequestStatus.Descriptor instead.
+func (PullRequestStatus) EnumDescriptor() ([]byte, []int) {
+	return file_pr_service_proto_rawDescGZIP(), []int{0}
+}
+
+type TeamMember struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Username      string                 `protobuf:"bytes,2,opt,name=username,proto3" json:"username,omitempty"`
+	IsActive      bool                   `protobuf:"varint,3,opt,name=is_active,json=isActive,proto3" json:"is_active,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TeamMember) Reset() {
+	*x = TeamMember{}
+	mi := &file_pr_service_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TeamMember) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TeamMember) ProtoMessage() {}
+
+func (x *TeamMember) ProtoReflect() protoreflect.Message {
+	mi := &file_pr_service_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TeamMember.ProtoReflect.Descriptor instead.
+func (*TeamMember) Descriptor() ([]byte, []int) {
+	return file_pr_service_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *TeamMember) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *TeamMember) GetUsername() string {
+	if x != nil {
+		return x.Username
+	}
+	return ""
+}
+
+func (x *TeamMember) GetIsActive() bool {
+	if x != nil {
+		return x.IsActive
+	}
+	return false
+}
+
+type Team struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TeamName      string                 `protobuf:"bytes,1,opt,name=team_name,json=teamName,proto3" json:"team_name,omitempty"`
+	Members       []*TeamMember          `protobuf:"bytes,2,rep,name=members,proto3" json:"members,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Team) Reset() {
+	*x = Team{}
+	mi := &file_pr_service_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Team) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Team) ProtoMessage() {}
+
+func (x *Team) ProtoReflect() protoreflect.Message {
+	mi := &file_pr_service_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Team.ProtoReflect.Descriptor instead.
+func (*Team) Descriptor() ([]byte, []int) {
+	return file_pr_service_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *Team) GetTeamName() string {
+	if x != nil {
+		return x.TeamName
+	}
+	return ""
+}
+
+func (x *Team) GetMembers() []*TeamMember {
+	if x != nil {
+		return x.Members
+	}
+	return nil
+}
+
+type User struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Username      string                 `protobuf:"bytes,2,opt,name=username,proto3" json:"username,omitempty"`
+	TeamName      string                 `protobuf:"bytes,3,opt,name=team_name,json=teamName,proto3" json:"team_name,omitempty"`
+	IsActive      bool                   `protobuf:"varint,4,opt,name=is_active,json=isActive,proto3" json:"is_active,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *User) Reset() {
+	*x = User{}
+	mi := &file_pr_service_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *User) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*User) ProtoMessage() {}
+
+func (x *User) ProtoReflect() protoreflect.Message {
+	mi := &file_pr_service_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use User.ProtoReflect.Descriptor instead.
+func (*User) Descriptor() ([]byte, []int) {
+	return file_pr_service_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *User) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *User) GetUsername() string {
+	if x != nil {
+		return x.Username
+	}
+	return ""
+}
+
+func (x *User) GetTeamName() string {
+	if x != nil {
+		return x.TeamName
+	}
+	return ""
+}
+
+func (x *User) GetIsActive() bool {
+	if x != nil {
+		return x.IsActive
+	}
+	return false
+}
+
+type PullRequest struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	PullRequestId   string                 `protobuf:"bytes,1,opt,name=pull_request_id,json=pullRequestId,proto3" json:"pull_request_id,omitempty"`
+	PullRequestName string                 `protobuf:"bytes,2,opt,name=pull_request_name,json=pullRequestName,proto3" json:"pull_request_name,omitempty"`
+	AuthorId        string                 `protobuf:"bytes,3,opt,name=author_id,json=authorId,proto3" json:"author_id,omitempty"`
+	Status          PullRequestStatus      `protobuf:"varint,4,opt,name=status,proto3,enum=prservice.v1.PullRequestStatus" json:"status,omitempty"`
+	// user_id назначенных reviewer (0..2)
+	AssignedReviewers []string               `protobuf:"bytes,5,rep,name=assigned_reviewers,json=assignedReviewers,proto3" json:"assigned_reviewers,omitempty"`
+	CreatedAt         *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	MergedAt          *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=merged_at,json=mergedAt,proto3" json:"merged_at,omitempty"`
+	// Версия PR (аналог ETag), передаётся в if_version изменяющих запросов
+	Version       int64 `protobuf:"varint,8,opt,name=version,proto3" json:"version,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PullRequest) Reset() {
+	*x = PullRequest{}
+	mi := &file_pr_service_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PullRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PullRequest) ProtoMessage() {}
+
+func (x *PullRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pr_service_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PullRequest.ProtoReflect.Descriptor instead.
+func (*PullRequest) Descriptor() ([]byte, []int) {
+	return file_pr_service_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *PullRequest) GetPullRequestId() string {
+	if x != nil {
+		return x.PullRequestId
+	}
+	return ""
+}
+
+func (x *PullRequest) GetPullRequestName() string {
+	if x != nil {
+		return x.PullRequestName
+	}
+	return ""
+}
+
+func (x *PullRequest) GetAuthorId() string {
+	if x != nil {
+		return x.AuthorId
+	}
+	return ""
+}
+
+func (x *PullRequest) GetStatus() PullRequestStatus {
+	if x != nil {
+		return x.Status
+	}
+	return PullRequestStatus_PULL_REQUEST_STATUS_UNSPECIFIED
+}
+
+func (x *PullRequest) GetAssignedReviewers() []string {
+	if x != nil {
+		return x.AssignedReviewers
+	}
+	return nil
+}
+
+func (x *PullRequest) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *PullRequest) GetMergedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.MergedAt
+	}
+	return nil
+}
+
+func (x *PullRequest) GetVersion() int64 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
+type AddTeamRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Team          *Team                  `protobuf:"bytes,1,opt,name=team,proto3" json:"team,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AddTeamRequest) Reset() {
+	*x = AddTeamRequest{}
+	mi := &file_pr_service_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AddTeamRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddTeamRequest) ProtoMessage() {}
+
+func (x *AddTeamRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pr_service_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddTeamRequest.ProtoReflect.Descriptor instead.
+func (*AddTeamRequest) Descriptor() ([]byte, []int) {
+	return file_pr_service_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *AddTeamRequest) GetTeam() *Team {
+	if x != nil {
+		return x.Team
+	}
+	return nil
+}
+
+type AddTeamResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Team          *Team                  `protobuf:"bytes,1,opt,name=team,proto3" json:"team,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AddTeamResponse) Reset() {
+	*x = AddTeamResponse{}
+	mi := &file_pr_service_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AddTeamResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddTeamResponse) ProtoMessage() {}
+
+func (x *AddTeamResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pr_service_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddTeamResponse.ProtoReflect.Descriptor instead.
+func (*AddTeamResponse) Descriptor() ([]byte, []int) {
+	return file_pr_service_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *AddTeamResponse) GetTeam() *Team {
+	if x != nil {
+		return x.Team
+	}
+	return nil
+}
+
+type GetTeamRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TeamName      string                 `protobuf:"bytes,1,opt,name=team_name,json=teamName,proto3" json:"team_name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetTeamRequest) Reset() {
+	*x = GetTeamRequest{}
+	mi := &file_pr_service_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetTeamRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetTeamRequest) ProtoMessage() {}
+
+func (x *GetTeamRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pr_service_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetTeamRequest.ProtoReflect.Descriptor instead.
+func (*GetTeamRequest) Descriptor() ([]byte, []int) {
+	return file_pr_service_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *GetTeamRequest) GetTeamName() string {
+	if x != nil {
+		return x.TeamName
+	}
+	return ""
+}
+
+type GetTeamResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Team          *Team                  `protobuf:"bytes,1,opt,name=team,proto3" json:"team,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetTeamResponse) Reset() {
+	*x = GetTeamResponse{}
+	mi := &file_pr_service_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetTeamResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetTeamResponse) ProtoMessage() {}
+
+func (x *GetTeamResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pr_service_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetTeamResponse.ProtoReflect.Descriptor instead.
+func (*GetTeamResponse) Descriptor() ([]byte, []int) {
+	return file_pr_service_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *GetTeamResponse) GetTeam() *Team {
+	if x != nil {
+		return x.Team
+	}
+	return nil
+}
+
+type DeactivateTeamRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TeamName      string                 `protobuf:"bytes,1,opt,name=team_name,json=teamName,proto3" json:"team_name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeactivateTeamRequest) Reset() {
+	*x = DeactivateTeamRequest{}
+	mi := &file_pr_service_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeactivateTeamRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeactivateTeamRequest) ProtoMessage() {}
+
+func (x *DeactivateTeamRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pr_service_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeactivateTeamRequest.ProtoReflect.Descriptor instead.
+func (*DeactivateTeamRequest) Descriptor() ([]byte, []int) {
+	return file_pr_service_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *DeactivateTeamRequest) GetTeamName() string {
+	if x != nil {
+		return x.TeamName
+	}
+	return ""
+}
+
+type DeactivateTeamResponse struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	TeamName        string                 `protobuf:"bytes,1,opt,name=team_name,json=teamName,proto3" json:"team_name,omitempty"`
+	DeactivateCount int32                  `protobuf:"varint,2,opt,name=deactivate_count,json=deactivateCount,proto3" json:"deactivate_count,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *DeactivateTeamResponse) Reset() {
+	*x = DeactivateTeamResponse{}
+	mi := &file_pr_service_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeactivateTeamResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeactivateTeamResponse) ProtoMessage() {}
+
+func (x *DeactivateTeamResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pr_service_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeactivateTeamResponse.ProtoReflect.Descriptor instead.
+func (*DeactivateTeamResponse) Descriptor() ([]byte, []int) {
+	return file_pr_service_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *DeactivateTeamResponse) GetTeamName() string {
+	if x != nil {
+		return x.TeamName
+	}
+	return ""
+}
+
+func (x *DeactivateTeamResponse) GetDeactivateCount() int32 {
+	if x != nil {
+		return x.DeactivateCount
+	}
+	return 0
+}
+
+type SetUserActiveRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	IsActive      bool                   `protobuf:"varint,2,opt,name=is_active,json=isActive,proto3" json:"is_active,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetUserActiveRequest) Reset() {
+	*x = SetUserActiveRequest{}
+	mi := &file_pr_service_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetUserActiveRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetUserActiveRequest) ProtoMessage() {}
+
+func (x *SetUserActiveRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pr_service_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetUserActiveRequest.ProtoReflect.Descriptor instead.
+func (*SetUserActiveRequest) Descriptor() ([]byte, []int) {
+	return file_pr_service_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *SetUserActiveRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *SetUserActiveRequest) GetIsActive() bool {
+	if x != nil {
+		return x.IsActive
+	}
+	return false
+}
+
+type SetUserActiveResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	User          *User                  `protobuf:"bytes,1,opt,name=user,proto3" json:"user,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetUserActiveResponse) Reset() {
+	*x = SetUserActiveResponse{}
+	mi := &file_pr_service_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetUserActiveResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetUserActiveResponse) ProtoMessage() {}
+
+func (x *SetUserActiveResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pr_service_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetUserActiveResponse.ProtoReflect.Descriptor instead.
+func (*SetUserActiveResponse) Descriptor() ([]byte, []int) {
+	return file_pr_service_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *SetUserActiveResponse) GetUser() *User {
+	if x != nil {
+		return x.User
+	}
+	return nil
+}
+
+type GetUserReviewsRequest struct {
+	state  protoimpl.MessageState `protogen:"open.v1"`
+	UserId string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	// reviewer (по умолчанию) или author
+	Role   string            `protobuf:"bytes,2,opt,name=role,proto3" json:"role,omitempty"`
+	Status PullRequestStatus `protobuf:"varint,3,opt,name=status,proto3,enum=prservice.v1.PullRequestStatus" json:"status,omitempty"`
+	// Сортировка по времени создания по убыванию
+	Desc bool `protobuf:"varint,4,opt,name=desc,proto3" json:"desc,omitempty"`
+	// Курсор следующей страницы (next_cursor из предыдущего ответа)
+	Cursor string `protobuf:"bytes,5,opt,name=cursor,proto3" json:"cursor,omitempty"`
+	// 1..1000, по умолчанию 100
+	Limit         int32 `protobuf:"varint,6,opt,name=limit,proto3" json:"limit,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetUserReviewsRequest) Reset() {
+	*x = GetUserReviewsRequest{}
+	mi := &file_pr_service_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetUserReviewsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetUserReviewsRequest) ProtoMessage() {}
+
+func (x *GetUserReviewsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pr_service_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetUserReviewsRequest.ProtoReflect.Descriptor instead.
+func (*GetUserReviewsRequest) Descriptor() ([]byte, []int) {
+	return file_pr_service_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *GetUserReviewsRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *GetUserReviewsRequest) GetRole() string {
+	if x != nil {
+		return x.Role
+	}
+	return ""
+}
+
+func (x *GetUserReviewsRequest) GetStatus() PullRequestStatus {
+	if x != nil {
+		return x.Status
+	}
+	return PullRequestStatus_PULL_REQUEST_STATUS_UNSPECIFIED
+}
+
+func (x *GetUserReviewsRequest) GetDesc() bool {
+	if x != nil {
+		return x.Desc
+	}
+	return false
+}
+
+func (x *GetUserReviewsRequest) GetCursor() string {
+	if x != nil {
+		return x.Cursor
+	}
+	return ""
+}
+
+func (x *GetUserReviewsRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+type GetUserReviewsResponse struct {
+	state        protoimpl.MessageState `protogen:"open.v1"`
+	UserId       string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	PullRequests []*PullRequest         `protobuf:"bytes,2,rep,name=pull_requests,json=pullRequests,proto3" json:"pull_requests,omitempty"`
+	// Пусто - страниц больше нет
+	NextCursor    string `protobuf:"bytes,3,opt,name=next_cursor,json=nextCursor,proto3" json:"next_cursor,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetUserReviewsResponse) Reset() {
+	*x = GetUserReviewsResponse{}
+	mi := &file_pr_service_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetUserReviewsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetUserReviewsResponse) ProtoMessage() {}
+
+func (x *GetUserReviewsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pr_service_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetUserReviewsResponse.ProtoReflect.Descriptor instead.
+func (*GetUserReviewsResponse) Descriptor() ([]byte, []int) {
+	return file_pr_service_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *GetUserReviewsResponse) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *GetUserReviewsResponse) GetPullRequests() []*PullRequest {
+	if x != nil {
+		return x.PullRequests
+	}
+	return nil
+}
+
+func (x *GetUserReviewsResponse) GetNextCursor() string {
+	if x != nil {
+		return x.NextCursor
+	}
+	return ""
+}
+
+type CreatePullRequestRequest struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	PullRequestId   string                 `protobuf:"bytes,1,opt,name=pull_request_id,json=pullRequestId,proto3" json:"pull_request_id,omitempty"`
+	PullRequestName string                 `protobuf:"bytes,2,opt,name=pull_request_name,json=pullRequestName,proto3" json:"pull_request_name,omitempty"`
+	AuthorId        string                 `protobuf:"bytes,3,opt,name=author_id,json=authorId,proto3" json:"author_id,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *CreatePullRequestRequest) Reset() {
+	*x = CreatePullRequestRequest{}
+	mi := &file_pr_service_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreatePullRequestRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreatePullRequestRequest) ProtoMessage() {}
+
+func (x *CreatePullRequestRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pr_service_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreatePullRequestRequest.ProtoReflect.Descriptor instead.
+func (*CreatePullRequestRequest) Descriptor() ([]byte, []int) {
+	return file_pr_service_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *CreatePullRequestRequest) GetPullRequestId() string {
+	if x != nil {
+		return x.PullRequestId
+	}
+	return ""
+}
+
+func (x *CreatePullRequestRequest) GetPullRequestName() string {
+	if x != nil {
+		return x.PullRequestName
+	}
+	return ""
+}
+
+func (x *CreatePullRequestRequest) GetAuthorId() string {
+	if x != nil {
+		return x.AuthorId
+	}
+	return ""
+}
+
+type CreatePullRequestResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Pr            *PullRequest           `protobuf:"bytes,1,opt,name=pr,proto3" json:"pr,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreatePullRequestResponse) Reset() {
+	*x = CreatePullRequestResponse{}
+	mi := &file_pr_service_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreatePullRequestResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreatePullRequestResponse) ProtoMessage() {}
+
+func (x *CreatePullRequestResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pr_service_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreatePullRequestResponse.ProtoReflect.Descriptor instead.
+func (*CreatePullRequestResponse) Descriptor() ([]byte, []int) {
+	return file_pr_service_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *CreatePullRequestResponse) GetPr() *PullRequest {
+	if x != nil {
+		return x.Pr
+	}
+	return nil
+}
+
+type MergePullRequestRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PullRequestId string                 `protobuf:"bytes,1,opt,name=pull_request_id,json=pullRequestId,proto3" json:"pull_request_id,omitempty"`
+	// Ожидаемая версия PR, 0 - без проверки
+	IfVersion     int64 `protobuf:"varint,2,opt,name=if_version,json=ifVersion,proto3" json:"if_version,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MergePullRequestRequest) Reset() {
+	*x = MergePullRequestRequest{}
+	mi := &file_pr_service_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MergePullRequestRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MergePullRequestRequest) ProtoMessage() {}
+
+func (x *MergePullRequestRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pr_service_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MergePullRequestRequest.ProtoReflect.Descriptor instead.
+func (*MergePullRequestRequest) Descriptor() ([]byte, []int) {
+	return file_pr_service_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *MergePullRequestRequest) GetPullRequestId() string {
+	if x != nil {
+		return x.PullRequestId
+	}
+	return ""
+}
+
+func (x *MergePullRequestRequest) GetIfVersion() int64 {
+	if x != nil {
+		return x.IfVersion
+	}
+	return 0
+}
+
+type MergePullRequestResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Pr            *PullRequest           `protobuf:"bytes,1,opt,name=pr,proto3" json:"pr,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MergePullRequestResponse) Reset() {
+	*x = MergePullRequestResponse{}
+	mi := &file_pr_service_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MergePullRequestResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MergePullRequestResponse) ProtoMessage() {}
+
+func (x *MergePullRequestResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pr_service_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MergePullRequestResponse.ProtoReflect.Descriptor instead.
+func (*MergePullRequestResponse) Descriptor() ([]byte, []int) {
+	return file_pr_service_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *MergePullRequestResponse) GetPr() *PullRequest {
+	if x != nil {
+		return x.Pr
+	}
+	return nil
+}
+
+type ReassignReviewerRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PullRequestId string                 `protobuf:"bytes,1,opt,name=pull_request_id,json=pullRequestId,proto3" json:"pull_request_id,omitempty"`
+	OldReviewerId string                 `protobuf:"bytes,2,opt,name=old_reviewer_id,json=oldReviewerId,proto3" json:"old_reviewer_id,omitempty"`
+	// Ожидаемая версия PR, 0 - без проверки
+	IfVersion     int64 `protobuf:"varint,3,opt,name=if_version,json=ifVersion,proto3" json:"if_version,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReassignReviewerRequest) Reset() {
+	*x = ReassignReviewerRequest{}
+	mi := &file_pr_service_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReassignReviewerRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReassignReviewerRequest) ProtoMessage() {}
+
+func (x *ReassignReviewerRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pr_service_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReassignReviewerRequest.ProtoReflect.Descriptor instead.
+func (*ReassignReviewerRequest) Descriptor() ([]byte, []int) {
+	return file_pr_service_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *ReassignReviewerRequest) GetPullRequestId() string {
+	if x != nil {
+		return x.PullRequestId
+	}
+	return ""
+}
+
+func (x *ReassignReviewerRequest) GetOldReviewerId() string {
+	if x != nil {
+		return x.OldReviewerId
+	}
+	return ""
+}
+
+func (x *ReassignReviewerRequest) GetIfVersion() int64 {
+	if x != nil {
+		return x.IfVersion
+	}
+	return 0
+}
+
+type ReassignReviewerResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Pr    *PullRequest           `protobuf:"bytes,1,opt,name=pr,proto3" json:"pr,omitempty"`
+	// user_id нового reviewer
+	ReplacedBy    string `protobuf:"bytes,2,opt,name=replaced_by,json=replacedBy,proto3" json:"replaced_by,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReassignReviewerResponse) Reset() {
+	*x = ReassignReviewerResponse{}
+	mi := &file_pr_service_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReassignReviewerResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReassignReviewerResponse) ProtoMessage() {}
+
+func (x *ReassignReviewerResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pr_service_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReassignReviewerResponse.ProtoReflect.Descriptor instead.
+func (*ReassignReviewerResponse) Descriptor() ([]byte, []int) {
+	return file_pr_service_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *ReassignReviewerResponse) GetPr() *PullRequest {
+	if x != nil {
+		return x.Pr
+	}
+	return nil
+}
+
+func (x *ReassignReviewerResponse) GetReplacedBy() string {
+	if x != nil {
+		return x.ReplacedBy
+	}
+	return ""
+}
+
+type DeclineReviewRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PullRequestId string                 `protobuf:"bytes,1,opt,name=pull_request_id,json=pullRequestId,proto3" json:"pull_request_id,omitempty"`
+	ReviewerId    string                 `protobuf:"bytes,2,opt,name=reviewer_id,json=reviewerId,proto3" json:"reviewer_id,omitempty"`
+	Reason        string                 `protobuf:"bytes,3,opt,name=reason,proto3" json:"reason,omitempty"`
+	// Ожидаемая версия PR, 0 - без проверки
+	IfVersion     int64 `protobuf:"varint,4,opt,name=if_version,json=ifVersion,proto3" json:"if_version,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeclineReviewRequest) Reset() {
+	*x = DeclineReviewRequest{}
+	mi := &file_pr_service_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeclineReviewRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeclineReviewRequest) ProtoMessage() {}
+
+func (x *DeclineReviewRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pr_service_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeclineReviewRequest.ProtoReflect.Descriptor instead.
+func (*DeclineReviewRequest) Descriptor() ([]byte, []int) {
+	return file_pr_service_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *DeclineReviewRequest) GetPullRequestId() string {
+	if x != nil {
+		return x.PullRequestId
+	}
+	return ""
+}
+
+func (x *DeclineReviewRequest) GetReviewerId() string {
+	if x != nil {
+		return x.ReviewerId
+	}
+	return ""
+}
+
+func (x *DeclineReviewRequest) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+func (x *DeclineReviewRequest) GetIfVersion() int64 {
+	if x != nil {
+		return x.IfVersion
+	}
+	return 0
+}
+
+type DeclineReviewResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Pr    *PullRequest           `protobuf:"bytes,1,opt,name=pr,proto3" json:"pr,omitempty"`
+	// Пусто - кандидатов на замену не нашлось
+	ReplacedBy    string `protobuf:"bytes,2,opt,name=replaced_by,json=replacedBy,proto3" json:"replaced_by,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeclineReviewResponse) Reset() {
+	*x = DeclineReviewResponse{}
+	mi := &file_pr_service_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeclineReviewResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeclineReviewResponse) ProtoMessage() {}
+
+func (x *DeclineReviewResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pr_service_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeclineReviewResponse.ProtoReflect.Descriptor instead.
+func (*DeclineReviewResponse) Descriptor() ([]byte, []int) {
+	return file_pr_service_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *DeclineReviewResponse) GetPr() *PullRequest {
+	if x != nil {
+		return x.Pr
+	}
+	return nil
+}
+
+func (x *DeclineReviewResponse) GetReplacedBy() string {
+	if x != nil {
+		return x.ReplacedBy
+	}
+	return ""
+}
+
+type GetPullRequestRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PullRequestId string                 `protobuf:"bytes,1,opt,name=pull_request_id,json=pullRequestId,proto3" json:"pull_request_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetPullRequestRequest) Reset() {
+	*x = GetPullRequestRequest{}
+	mi := &file_pr_service_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetPullRequestRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetPullRequestRequest) ProtoMessage() {}
+
+func (x *GetPullRequestRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pr_service_proto_msgTypes[22]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetPullRequestRequest.ProtoReflect.Descriptor instead.
+func (*GetPullRequestRequest) Descriptor() ([]byte, []int) {
+	return file_pr_service_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *GetPullRequestRequest) GetPullRequestId() string {
+	if x != nil {
+		return x.PullRequestId
+	}
+	return ""
+}
+
+type GetPullRequestResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Pr            *PullRequest           `protobuf:"bytes,1,opt,name=pr,proto3" json:"pr,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetPullRequestResponse) Reset() {
+	*x = GetPullRequestResponse{}
+	mi := &file_pr_service_proto_msgTypes[23]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetPullRequestResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetPullRequestResponse) ProtoMessage() {}
+
+func (x *GetPullRequestResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pr_service_proto_msgTypes[23]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetPullRequestResponse.ProtoReflect.Descriptor instead.
+func (*GetPullRequestResponse) Descriptor() ([]byte, []int) {
+	return file_pr_service_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *GetPullRequestResponse) GetPr() *PullRequest {
+	if x != nil {
+		return x.Pr
+	}
+	return nil
+}
+
+type ListPullRequestsRequest struct {
+	state      protoimpl.MessageState `protogen:"open.v1"`
+	AuthorId   string                 `protobuf:"bytes,1,opt,name=author_id,json=authorId,proto3" json:"author_id,omitempty"`
+	ReviewerId string                 `protobuf:"bytes,2,opt,name=reviewer_id,json=reviewerId,proto3" json:"reviewer_id,omitempty"`
+	TeamName   string                 `protobuf:"bytes,3,opt,name=team_name,json=teamName,proto3" json:"team_name,omitempty"`
+	Status     PullRequestStatus      `protobuf:"varint,4,opt,name=status,proto3,enum=prservice.v1.PullRequestStatus" json:"status,omitempty"`
+	// Подстрока в названии PR (без учёта регистра)
+	NameQuery string `protobuf:"bytes,5,opt,name=name_query,json=nameQuery,proto3" json:"name_query,omitempty"`
+	// Диапазоны времени [from, to)
+	CreatedFrom *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=created_from,json=createdFrom,proto3" json:"created_from,omitempty"`
+	CreatedTo   *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=created_to,json=createdTo,proto3" json:"created_to,omitempty"`
+	MergedFrom  *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=merged_from,json=mergedFrom,proto3" json:"merged_from,omitempty"`
+	MergedTo    *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=merged_to,json=mergedTo,proto3" json:"merged_to,omitempty"`
+	// created_at (по умолчанию) или merged_at
+	SortBy string `protobuf:"bytes,10,opt,name=sort_by,json=sortBy,proto3" json:"sort_by,omitempty"`
+	Desc   bool   `protobuf:"varint,11,opt,name=desc,proto3" json:"desc,omitempty"`
+	Cursor string `protobuf:"bytes,12,opt,name=cursor,proto3" json:"cursor,omitempty"`
+	// 1..1000, по умолчанию 100
+	Limit         int32 `protobuf:"varint,13,opt,name=limit,proto3" json:"limit,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListPullRequestsRequest) Reset() {
+	*x = ListPullRequestsRequest{}
+	mi := &file_pr_service_proto_msgTypes[24]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListPullRequestsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListPullRequestsRequest) ProtoMessage() {}
+
+func (x *ListPullRequestsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pr_service_proto_msgTypes[24]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListPullRequestsRequest.ProtoReflect.Descriptor instead.
+func (*ListPullRequestsRequest) Descriptor() ([]byte, []int) {
+	return file_pr_service_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *ListPullRequestsRequest) GetAuthorId() string {
+	if x != nil {
+		return x.AuthorId
+	}
+	return ""
+}
+
+func (x *ListPullRequestsRequest) GetReviewerId() string {
+	if x != nil {
+		return x.ReviewerId
+	}
+	return ""
+}
+
+func (x *ListPullRequestsRequest) GetTeamName() string {
+	if x != nil {
+		return x.TeamName
+	}
+	return ""
+}
+
+func (x *ListPullRequestsRequest) GetStatus() PullRequestStatus {
+	if x != nil {
+		return x.Status
+	}
+	return PullRequestStatus_PULL_REQUEST_STATUS_UNSPECIFIED
+}
+
+func (x *ListPullRequestsRequest) GetNameQuery() string {
+	if x != nil {
+		return x.NameQuery
+	}
+	return ""
+}
+
+func (x *ListPullRequestsRequest) GetCreatedFrom() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedFrom
+	}
+	return nil
+}
+
+func (x *ListPullRequestsRequest) GetCreatedTo() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedTo
+	}
+	return nil
+}
+
+func (x *ListPullRequestsRequest) GetMergedFrom() *timestamppb.Timestamp {
+	if x != nil {
+		return x.MergedFrom
+	}
+	return nil
+}
+
+func (x *ListPullRequestsRequest) GetMergedTo() *timestamppb.Timestamp {
+	if x != nil {
+		return x.MergedTo
+	}
+	return nil
+}
+
+func (x *ListPullRequestsRequest) GetSortBy() string {
+	if x != nil {
+		return x.SortBy
+	}
+	return ""
+}
+
+func (x *ListPullRequestsRequest) GetDesc() bool {
+	if x != nil {
+		return x.Desc
+	}
+	return false
+}
+
+func (x *ListPullRequestsRequest) GetCursor() string {
+	if x != nil {
+		return x.Cursor
+	}
+	return ""
+}
+
+func (x *ListPullRequestsRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+type ListPullRequestsResponse struct {
+	state        protoimpl.MessageState `protogen:"open.v1"`
+	PullRequests []*PullRequest         `protobuf:"bytes,1,rep,name=pull_requests,json=pullRequests,proto3" json:"pull_requests,omitempty"`
+	// Пусто - страниц больше нет
+	NextCursor    string `protobuf:"bytes,2,opt,name=next_cursor,json=nextCursor,proto3" json:"next_cursor,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListPullRequestsResponse) Reset() {
+	*x = ListPullRequestsResponse{}
+	mi := &file_pr_service_proto_msgTypes[25]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListPullRequestsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListPullRequestsResponse) ProtoMessage() {}
+
+func (x *ListPullRequestsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pr_service_proto_msgTypes[25]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListPullRequestsResponse.ProtoReflect.Descriptor instead.
+func (*ListPullRequestsResponse) Descriptor() ([]byte, []int) {
+	return file_pr_service_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *ListPullRequestsResponse) GetPullRequests() []*PullRequest {
+	if x != nil {
+		return x.PullRequests
+	}
+	return nil
+}
+
+func (x *ListPullRequestsResponse) GetNextCursor() string {
+	if x != nil {
+		return x.NextCursor
+	}
+	return ""
+}
+
+// StatFilter Окно времени [from, to), команда и статус PR
+type StatFilter struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	From          *timestamppb.Timestamp `protobuf:"bytes,1,opt,name=from,proto3" json:"from,omitempty"`
+	To            *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=to,proto3" json:"to,omitempty"`
+	TeamName      string                 `protobuf:"bytes,3,opt,name=team_name,json=teamName,proto3" json:"team_name,omitempty"`
+	Status        PullRequestStatus      `protobuf:"varint,4,opt,name=status,proto3,enum=prservice.v1.PullRequestStatus" json:"status,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *StatFilter) Reset() {
+	*x = StatFilter{}
+	mi := &file_pr_service_proto_msgTypes[26]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StatFilter) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StatFilter) ProtoMessage() {}
+
+func (x *StatFilter) ProtoReflect() protoreflect.Message {
+	mi := &file_pr_service_proto_msgTypes[26]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StatFilter.ProtoReflect.Descriptor instead.
+func (*StatFilter) Descriptor() ([]byte, []int) {
+	return file_pr_service_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *StatFilter) GetFrom() *timestamppb.Timestamp {
+	if x != nil {
+		return x.From
+	}
+	return nil
+}
+
+func (x *StatFilter) GetTo() *timestamppb.Timestamp {
+	if x != nil {
+		return x.To
+	}
+	return nil
+}
+
+func (x *StatFilter) GetTeamName() string {
+	if x != nil {
+		return x.TeamName
+	}
+	return ""
+}
+
+func (x *StatFilter) GetStatus() PullRequestStatus {
+	if x != nil {
+		return x.Status
+	}
+	return PullRequestStatus_PULL_REQUEST_STATUS_UNSPECIFIED
+}
+
+type ReviewStat struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	ReviewCount   int32                  `protobuf:"varint,2,opt,name=review_count,json=reviewCount,proto3" json:"review_count,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReviewStat) Reset() {
+	*x = ReviewStat{}
+	mi := &file_pr_service_proto_msgTypes[27]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReviewStat) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReviewStat) ProtoMessage() {}
+
+func (x *ReviewStat) ProtoReflect() protoreflect.Message {
+	mi := &file_pr_service_proto_msgTypes[27]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReviewStat.ProtoReflect.Descriptor instead.
+func (*ReviewStat) Descriptor() ([]byte, []int) {
+	return file_pr_service_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *ReviewStat) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *ReviewStat) GetReviewCount() int32 {
+	if x != nil {
+		return x.ReviewCount
+	}
+	return 0
+}
+
+type GetReviewStatsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Filter        *StatFilter            `protobuf:"bytes,1,opt,name=filter,proto3" json:"filter,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetReviewStatsRequest) Reset() {
+	*x = GetReviewStatsRequest{}
+	mi := &file_pr_service_proto_msgTypes[28]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetReviewStatsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetReviewStatsRequest) ProtoMessage() {}
+
+func (x *GetReviewStatsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pr_service_proto_msgTypes[28]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetReviewStatsRequest.ProtoReflect.Descriptor instead.
+func (*GetReviewStatsRequest) Descriptor() ([]byte, []int) {
+	return file_pr_service_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *GetReviewStatsRequest) GetFilter() *StatFilter {
+	if x != nil {
+		return x.Filter
+	}
+	return nil
+}
+
+type GetReviewStatsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ReviewStat    []*ReviewStat          `protobuf:"bytes,1,rep,name=review_stat,json=reviewStat,proto3" json:"review_stat,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetReviewStatsResponse) Reset() {
+	*x = GetReviewStatsResponse{}
+	mi := &file_pr_service_proto_msgTypes[29]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetReviewStatsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetReviewStatsResponse) ProtoMessage() {}
+
+func (x *GetReviewStatsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pr_service_proto_msgTypes[29]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetReviewStatsResponse.ProtoReflect.Descriptor instead.
+func (*GetReviewStatsResponse) Descriptor() ([]byte, []int) {
+	return file_pr_service_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *GetReviewStatsResponse) GetReviewStat() []*ReviewStat {
+	if x != nil {
+		return x.ReviewStat
+	}
+	return nil
+}
+
+type TeamStat struct {
+	state              protoimpl.MessageState `protogen:"open.v1"`
+	TeamName           string                 `protobuf:"bytes,1,opt,name=team_name,json=teamName,proto3" json:"team_name,omitempty"`
+	Members            int32                  `protobuf:"varint,2,opt,name=members,proto3" json:"members,omitempty"`
+	ActiveMembers      int32                  `protobuf:"varint,3,opt,name=active_members,json=activeMembers,proto3" json:"active_members,omitempty"`
+	PullRequests       int32                  `protobuf:"varint,4,opt,name=pull_requests,json=pullRequests,proto3" json:"pull_requests,omitempty"`
+	OpenPullRequests   int32                  `protobuf:"varint,5,opt,name=open_pull_requests,json=openPullRequests,proto3" json:"open_pull_requests,omitempty"`
+	MergedPullRequests int32                  `protobuf:"varint,6,opt,name=merged_pull_requests,json=mergedPullRequests,proto3" json:"merged_pull_requests,omitempty"`
+	ReviewAssignments  int32                  `protobuf:"varint,7,opt,name=review_assignments,json=reviewAssignments,proto3" json:"review_assignments,omitempty"`
+	AvgReviewersPerPr  float64                `protobuf:"fixed64,8,opt,name=avg_reviewers_per_pr,json=avgReviewersPerPr,proto3" json:"avg_reviewers_per_pr,omitempty"`
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
+}
+
+func (x *TeamStat) Reset() {
+	*x = TeamStat{}
+	mi := &file_pr_service_proto_msgTypes[30]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TeamStat) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TeamStat) ProtoMessage() {}
+
+func (x *TeamStat) ProtoReflect() protoreflect.Message {
+	mi := &file_pr_service_proto_msgTypes[30]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TeamStat.ProtoReflect.Descriptor instead.
+func (*TeamStat) Descriptor() ([]byte, []int) {
+	return file_pr_service_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *TeamStat) GetTeamName() string {
+	if x != nil {
+		return x.TeamName
+	}
+	return ""
+}
+
+func (x *TeamStat) GetMembers() int32 {
+	if x != nil {
+		return x.Members
+	}
+	return 0
+}
+
+func (x *TeamStat) GetActiveMembers() int32 {
+	if x != nil {
+		return x.ActiveMembers
+	}
+	return 0
+}
+
+func (x *TeamStat) GetPullRequests() int32 {
+	if x != nil {
+		return x.PullRequests
+	}
+	return 0
+}
+
+func (x *TeamStat) GetOpenPullRequests() int32 {
+	if x != nil {
+		return x.OpenPullRequests
+	}
+	return 0
+}
+
+func (x *TeamStat) GetMergedPullRequests() int32 {
+	if x != nil {
+		return x.MergedPullRequests
+	}
+	return 0
+}
+
+func (x *TeamStat) GetReviewAssignments() int32 {
+	if x != nil {
+		return x.ReviewAssignments
+	}
+	return 0
+}
+
+func (x *TeamStat) GetAvgReviewersPerPr() float64 {
+	if x != nil {
+		return x.AvgReviewersPerPr
+	}
+	return 0
+}
+
+type GetTeamStatsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Filter        *StatFilter            `protobuf:"bytes,1,opt,name=filter,proto3" json:"filter,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetTeamStatsRequest) Reset() {
+	*x = GetTeamStatsRequest{}
+	mi := &file_pr_service_proto_msgTypes[31]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetTeamStatsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetTeamStatsRequest) ProtoMessage() {}
+
+func (x *GetTeamStatsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pr_service_proto_msgTypes[31]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetTeamStatsRequest.ProtoReflect.Descriptor instead.
+func (*GetTeamStatsRequest) Descriptor() ([]byte, []int) {
+	return file_pr_service_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *GetTeamStatsRequest) GetFilter() *StatFilter {
+	if x != nil {
+		return x.Filter
+	}
+	return nil
+}
+
+type GetTeamStatsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TeamStat      []*TeamStat            `protobuf:"bytes,1,rep,name=team_stat,json=teamStat,proto3" json:"team_stat,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetTeamStatsResponse) Reset() {
+	*x = GetTeamStatsResponse{}
+	mi := &file_pr_service_proto_msgTypes[32]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetTeamStatsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetTeamStatsResponse) ProtoMessage() {}
+
+func (x *GetTeamStatsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pr_service_proto_msgTypes[32]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetTeamStatsResponse.ProtoReflect.Descriptor instead.
+func (*GetTeamStatsResponse) Descriptor() ([]byte, []int) {
+	return file_pr_service_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *GetTeamStatsResponse) GetTeamStat() []*TeamStat {
+	if x != nil {
+		return x.TeamStat
+	}
+	return nil
+}
+
+type GetPullRequestStatsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Filter        *StatFilter            `protobuf:"bytes,1,opt,name=filter,proto3" json:"filter,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetPullRequestStatsRequest) Reset() {
+	*x = GetPullRequestStatsRequest{}
+	mi := &file_pr_service_proto_msgTypes[33]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetPullRequestStatsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetPullRequestStatsRequest) ProtoMessage() {}
+
+func (x *GetPullRequestStatsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pr_service_proto_msgTypes[33]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetPullRequestStatsRequest.ProtoReflect.Descriptor instead.
+func (*GetPullRequestStatsRequest) Descriptor() ([]byte, []int) {
+	return file_pr_service_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *GetPullRequestStatsRequest) GetFilter() *StatFilter {
+	if x != nil {
+		return x.Filter
+	}
+	return nil
+}
+
+type GetPullRequestStatsResponse struct {
+	state              protoimpl.MessageState `protogen:"open.v1"`
+	PullRequests       int32                  `protobuf:"varint,1,opt,name=pull_requests,json=pullRequests,proto3" json:"pull_requests,omitempty"`
+	OpenPullRequests   int32                  `protobuf:"varint,2,opt,name=open_pull_requests,json=openPullRequests,proto3" json:"open_pull_requests,omitempty"`
+	MergedPullRequests int32                  `protobuf:"varint,3,opt,name=merged_pull_requests,json=mergedPullRequests,proto3" json:"merged_pull_requests,omitempty"`
+	AvgReviewersPerPr  float64                `protobuf:"fixed64,4,opt,name=avg_reviewers_per_pr,json=avgReviewersPerPr,proto3" json:"avg_reviewers_per_pr,omitempty"`
+	// Время до merge (перцентили)
+	TimeToMergeP50 *durationpb.Duration `protobuf:"bytes,5,opt,name=time_to_merge_p50,json=timeToMergeP50,proto3" json:"time_to_merge_p50,omitempty"`
+	TimeToMergeP90 *durationpb.Duration `protobuf:"bytes,6,opt,name=time_to_merge_p90,json=timeToMergeP90,proto3" json:"time_to_merge_p90,omitempty"`
+	TimeToMergeP99 *durationpb.Duration `protobuf:"bytes,7,opt,name=time_to_merge_p99,json=timeToMergeP99,proto3" json:"time_to_merge_p99,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *GetPullRequestStatsResponse) Reset() {
+	*x = GetPullRequestStatsResponse{}
+	mi := &file_pr_service_proto_msgTypes[34]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetPullRequestStatsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetPullRequestStatsResponse) ProtoMessage() {}
+
+func (x *GetPullRequestStatsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pr_service_proto_msgTypes[34]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetPullRequestStatsResponse.ProtoReflect.Descriptor instead.
+func (*GetPullRequestStatsResponse) Descriptor() ([]byte, []int) {
+	return file_pr_service_proto_rawDescGZIP(), []int{34}
+}
+
+func (x *GetPullRequestStatsResponse) GetPullRequests() int32 {
+	if x != nil {
+		return x.PullRequests
+	}
+	return 0
+}
+
+func (x *GetPullRequestStatsResponse) GetOpenPullRequests() int32 {
+	if x != nil {
+		return x.OpenPullRequests
+	}
+	return 0
+}
+
+func (x *GetPullRequestStatsResponse) GetMergedPullRequests() int32 {
+	if x != nil {
+		return x.MergedPullRequests
+	}
+	return 0
+}
+
+func (x *GetPullRequestStatsResponse) GetAvgReviewersPerPr() float64 {
+	if x != nil {
+		return x.AvgReviewersPerPr
+	}
+	return 0
+}
+
+func (x *GetPullRequestStatsResponse) GetTimeToMergeP50() *durationpb.Duration {
+	if x != nil {
+		return x.TimeToMergeP50
+	}
+	return nil
+}
+
+func (x *GetPullRequestStatsResponse) GetTimeToMergeP90() *durationpb.Duration {
+	if x != nil {
+		return x.TimeToMergeP90
+	}
+	return nil
+}
+
+func (x *GetPullRequestStatsResponse) GetTimeToMergeP99() *durationpb.Duration {
+	if x != nil {
+		return x.TimeToMergeP99
+	}
+	return nil
+}
+
+type WatchPullRequestEventsRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Фильтры (пусто - все PR)
+	PullRequestId string `protobuf:"bytes,1,opt,name=pull_request_id,json=pullRequestId,proto3" json:"pull_request_id,omitempty"`
+	TeamName      string `protobuf:"bytes,2,opt,name=team_name,json=teamName,proto3" json:"team_name,omitempty"`
+	// Типы событий: pr.created, reviewer.assigned, reviewer.reassigned, reviewer.declined, reviewer.removed, pr.merged (пусто - все)
+	Types []string `protobuf:"bytes,3,rep,name=types,proto3" json:"types,omitempty"`
+	// Продолжить после события с этим id (0 - только новые события)
+	AfterId       int64 `protobuf:"varint,4,opt,name=after_id,json=afterId,proto3" json:"after_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *WatchPullRequestEventsRequest) Reset() {
+	*x = WatchPullRequestEventsRequest{}
+	mi := &file_pr_service_proto_msgTypes[35]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WatchPullRequestEventsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WatchPullRequestEventsRequest) ProtoMessage() {}
+
+func (x *WatchPullRequestEventsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pr_service_proto_msgTypes[35]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WatchPullRequestEventsRequest.ProtoReflect.Descriptor instead.
+func (*WatchPullRequestEventsRequest) Descriptor() ([]byte, []int) {
+	return file_pr_service_proto_rawDescGZIP(), []int{35}
+}
+
+func (x *WatchPullRequestEventsRequest) GetPullRequestId() string {
+	if x != nil {
+		return x.PullRequestId
+	}
+	return ""
+}
+
+func (x *WatchPullRequestEventsRequest) GetTeamName() string {
+	if x != nil {
+		return x.TeamName
+	}
+	return ""
+}
+
+func (x *WatchPullRequestEventsRequest) GetTypes() []string {
+	if x != nil {
+		return x.Types
+	}
+	return nil
+}
+
+func (x *WatchPullRequestEventsRequest) GetAfterId() int64 {
+	if x != nil {
+		return x.AfterId
+	}
+	return 0
+}
+
+type PullRequestEvent struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Возрастающий id события, для продолжения потока через after_id
+	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Type          string                 `protobuf:"bytes,2,opt,name=type,proto3" json:"type,omitempty"`
+	PullRequestId string                 `protobuf:"bytes,3,opt,name=pull_request_id,json=pullRequestId,proto3" json:"pull_request_id,omitempty"`
+	UserIds       []string               `protobuf:"bytes,4,rep,name=user_ids,json=userIds,proto3" json:"user_ids,omitempty"`
+	TeamName      string                 `protobuf:"bytes,5,opt,name=team_name,json=teamName,proto3" json:"team_name,omitempty"`
+	Actor         string                 `protobuf:"bytes,6,opt,name=actor,proto3" json:"actor,omitempty"`
+	Reason        string                 `protobuf:"bytes,7,opt,name=reason,proto3" json:"reason,omitempty"`
+	Before        *structpb.Struct       `protobuf:"bytes,8,opt,name=before,proto3" json:"before,omitempty"`
+	After         *structpb.Struct       `protobuf:"bytes,9,opt,name=after,proto3" json:"after,omitempty"`
+	OccurredAt    *timestamppb.Timestamp `protobuf:"bytes,10,opt,name=occurred_at,json=occurredAt,proto3" json:"occurred_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PullRequestEvent) Reset() {
+	*x = PullRequestEvent{}
+	mi := &file_pr_service_proto_msgTypes[36]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PullRequestEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PullRequestEvent) ProtoMessage() {}
+
+func (x *PullRequestEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_pr_service_proto_msgTypes[36]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PullRequestEvent.ProtoReflect.Descriptor instead.
+func (*PullRequestEvent) Descriptor() ([]byte, []int) {
+	return file_pr_service_proto_rawDescGZIP(), []int{36}
+}
+
+func (x *PullRequestEvent) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *PullRequestEvent) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *PullRequestEvent) GetPullRequestId() string {
+	if x != nil {
+		return x.PullRequestId
+	}
+	return ""
+}
+
+func (x *PullRequestEvent) GetUserIds() []string {
+	if x != nil {
+		return x.UserIds
+	}
+	return nil
+}
+
+func (x *PullRequestEvent) GetTeamName() string {
+	if x != nil {
+		return x.TeamName
+	}
+	return ""
+}
+
+func (x *PullRequestEvent) GetActor() string {
+	if x != nil {
+		return x.Actor
+	}
+	return ""
+}
+
+func (x *PullRequestEvent) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+func (x *PullRequestEvent) GetBefore() *structpb.Struct {
+	if x != nil {
+		return x.Before
+	}
+	return nil
+}
+
+func (x *PullRequestEvent) GetAfter() *structpb.Struct {
+	if x != nil {
+		return x.After
+	}
+	return nil
+}
+
+func (x *PullRequestEvent) GetOccurredAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.OccurredAt
+	}
+	return nil
+}
+
+var File_pr_service_proto protoreflect.FileDescriptor
+
+const file_pr_service_proto_rawDesc = "" +
+	"\n" +
+	"\x10pr_service.proto\x12\fprservice.v1\x1a\x1egoogle/protobuf/duration.proto\x1a\x1cgoogle/protobuf/struct.proto\x1a\x1fgoogle/protobuf/timestamp.proto\"^\n" +
+	"\n" +
+	"TeamMember\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12\x1a\n" +
+	"\busername\x18\x02 \x01(\tR\busername\x12\x1b\n" +
+	"\tis_active\x18\x03 \x01(\bR\bisActive\"W\n" +
+	"\x04Team\x12\x1b\n" +
+	"\tteam_name\x18\x01 \x01(\tR\bteamName\x122\n" +
+	"\amembers\x18\x02 \x03(\v2\x18.prservice.v1.TeamMemberR\amembers\"u\n" +
+	"\x04User\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12\x1a\n" +
+	"\busername\x18\x02 \x01(\tR\busername\x12\x1b\n" +
+	"\tteam_name\x18\x03 \x01(\tR\bteamName\x12\x1b\n" +
+	"\tis_active\x18\x04 \x01(\bR\bisActive\"\xf4\x02\n" +
+	"\vPullRequest\x12&\n" +
+	"\x0fpull_request_id\x18\x01 \x01(\tR\rpullRequestId\x12*\n" +
+	"\x11pull_request_name\x18\x02 \x01(\tR\x0fpullRequestName\x12\x1b\n" +
+	"\tauthor_id\x18\x03 \x01(\tR\bauthorId\x127\n" +
+	"\x06status\x18\x04 \x01(\x0e2\x1f.prservice.v1.PullRequestStatusR\x06status\x12-\n" +
+	"\x12assigned_reviewers\x18\x05 \x03(\tR\x11assignedReviewers\x129\n" +
+	"\n" +
+	"created_at\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x127\n" +
+	"\tmerged_at\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\bmergedAt\x12\x18\n" +
+	"\aversion\x18\b \x01(\x03R\aversion\"8\n" +
+	"\x0eAddTeamRequest\x12&\n" +
+	"\x04team\x18\x01 \x01(\v2\x12.prservice.v1.TeamR\x04team\"9\n" +
+	"\x0fAddTeamResponse\x12&\n" +
+	"\x04team\x18\x01 \x01(\v2\x12.prservice.v1.TeamR\x04team\"-\n" +
+	"\x0eGetTeamRequest\x12\x1b\n" +
+	"\tteam_name\x18\x01 \x01(\tR\bteamName\"9\n" +
+	"\x0fGetTeamResponse\x12&\n" +
+	"\x04team\x18\x01 \x01(\v2\x12.prservice.v1.TeamR\x04team\"4\n" +
+	"\x15DeactivateTeamRequest\x12\x1b\n" +
+	"\tteam_name\x18\x01 \x01(\tR\bteamName\"`\n" +
+	"\x16DeactivateTeamResponse\x12\x1b\n" +
+	"\tteam_name\x18\x01 \x01(\tR\bteamName\x12)\n" +
+	"\x10deactivate_count\x18\x02 \x01(\x05R\x0fdeactivateCount\"L\n" +
+	"\x14SetUserActiveRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12\x1b\n" +
+	"\tis_active\x18\x02 \x01(\bR\bisActive\"?\n" +
+	"\x15SetUserActiveResponse\x12&\n" +
+	"\x04user\x18\x01 \x01(\v2\x12.prservice.v1.UserR\x04user\"\xbf\x01\n" +
+	"\x15GetUserReviewsRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12\x12\n" +
+	"\x04role\x18\x02 \x01(\tR\x04role\x127\n" +
+	"\x06status\x18\x03 \x01(\x0e2\x1f.prservice.v1.PullRequestStatusR\x06status\x12\x12\n" +
+	"\x04desc\x18\x04 \x01(\bR\x04desc\x12\x16\n" +
+	"\x06cursor\x18\x05 \x01(\tR\x06cursor\x12\x14\n" +
+	"\x05limit\x18\x06 \x01(\x05R\x05limit\"\x92\x01\n" +
+	"\x16GetUserReviewsResponse\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12>\n" +
+	"\rpull_requests\x18\x02 \x03(\v2\x19.prservice.v1.PullRequestR\fpullRequests\x12\x1f\n" +
+	"\vnext_cursor\x18\x03 \x01(\tR\n" +
+	"nextCursor\"\x8b\x01\n" +
+	"\x18CreatePullRequestRequest\x12&\n" +
+	"\x0fpull_request_id\x18\x01 \x01(\tR\rpullRequestId\x12*\n" +
+	"\x11pull_request_name\x18\x02 \x01(\tR\x0fpullRequestName\x12\x1b\n" +
+	"\tauthor_id\x18\x03 \x01(\tR\bauthorId\"F\n" +
+	"\x19CreatePullRequestResponse\x12)\n" +
+	"\x02pr\x18\x01 \x01(\v2\x19.prservice.v1.PullRequestR\x02pr\"`\n" +
+	"\x17MergePullRequestRequest\x12&\n" +
+	"\x0fpull_request_id\x18\x01 \x01(\tR\rpullRequestId\x12\x1d\n" +
+	"\n" +
+	"if_version\x18\x02 \x01(\x03R\tifVersion\"E\n" +
+	"\x18MergePullRequestResponse\x12)\n" +
+	"\x02pr\x18\x01 \x01(\v2\x19.prservice.v1.PullRequestR\x02pr\"\x88\x01\n" +
+	"\x17ReassignReviewerRequest\x12&\n" +
+	"\x0fpull_request_id\x18\x01 \x01(\tR\rpullRequestId\x12&\n" +
+	"\x0fold_reviewer_id\x18\x02 \x01(\tR\roldReviewerId\x12\x1d\n" +
+	"\n" +
+	"if_version\x18\x03 \x01(\x03R\tifVersion\"f\n" +
+	"\x18ReassignReviewerResponse\x12)\n" +
+	"\x02pr\x18\x01 \x01(\v2\x19.prservice.v1.PullRequestR\x02pr\x12\x1f\n" +
+	"\vreplaced_by\x18\x02 \x01(\tR\n" +
+	"replacedBy\"\x96\x01\n" +
+	"\x14DeclineReviewRequest\x12&\n" +
+	"\x0fpull_request_id\x18\x01 \x01(\tR\rpullRequestId\x12\x1f\n" +
+	"\vreviewer_id\x18\x02 \x01(\tR\n" +
+	"reviewerId\x12\x16\n" +
+	"\x06reason\x18\x03 \x01(\tR\x06reason\x12\x1d\n" +
+	"\n" +
+	"if_version\x18\x04 \x01(\x03R\tifVersion\"c\n" +
+	"\x15DeclineReviewResponse\x12)\n" +
+	"\x02pr\x18\x01 \x01(\v2\x19.prservice.v1.PullRequestR\x02pr\x12\x1f\n" +
+	"\vreplaced_by\x18\x02 \x01(\tR\n" +
+	"replacedBy\"?\n" +
+	"\x15GetPullRequestRequest\x12&\n" +
+	"\x0fpull_request_id\x18\x01 \x01(\tR\rpullRequestId\"C\n" +
+	"\x16GetPullRequestResponse\x12)\n" +
+	"\x02pr\x18\x01 \x01(\v2\x19.prservice.v1.PullRequestR\x02pr\"\x97\x04\n" +
+	"\x17ListPullRequestsRequest\x12\x1b\n" +
+	"\tauthor_id\x18\x01 \x01(\tR\bauthorId\x12\x1f\n" +
+	"\vreviewer_id\x18\x02 \x01(\tR\n" +
+	"reviewerId\x12\x1b\n" +
+	"\tteam_name\x18\x03 \x01(\tR\bteamName\x127\n" +
+	"\x06status\x18\x04 \x01(\x0e2\x1f.prservice.v1.PullRequestStatusR\x06status\x12\x1d\n" +
+	"\n" +
+	"name_query\x18\x05 \x01(\tR\tnameQuery\x12=\n" +
+	"\fcreated_from\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\vcreatedFrom\x129\n" +
+	"\n" +
+	"created_to\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedTo\x12;\n" +
+	"\vmerged_from\x18\b \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"mergedFrom\x127\n" +
+	"\tmerged_to\x18\t \x01(\v2\x1a.google.protobuf.TimestampR\bmergedTo\x12\x17\n" +
+	"\asort_by\x18\n" +
+	" \x01(\tR\x06sortBy\x12\x12\n" +
+	"\x04desc\x18\v \x01(\bR\x04desc\x12\x16\n" +
+	"\x06cursor\x18\f \x01(\tR\x06cursor\x12\x14\n" +
+	"\x05limit\x18\r \x01(\x05R\x05limit\"{\n" +
+	"\x18ListPullRequestsResponse\x12>\n" +
+	"\rpull_requests\x18\x01 \x03(\v2\x19.prservice.v1.PullRequestR\fpullRequests\x12\x1f\n" +
+	"\vnext_cursor\x18\x02 \x01(\tR\n" +
+	"nextCursor\"\xbe\x01\n" +
+	"\n" +
+	"StatFilter\x12.\n" +
+	"\x04from\x18\x01 \x01(\v2\x1a.google.protobuf.TimestampR\x04from\x12*\n" +
+	"\x02to\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\x02to\x12\x1b\n" +
+	"\tteam_name\x18\x03 \x01(\tR\bteamName\x127\n" +
+	"\x06status\x18\x04 \x01(\x0e2\x1f.prservice.v1.PullRequestStatusR\x06status\"H\n" +
+	"\n" +
+	"ReviewStat\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12!\n" +
+	"\freview_count\x18\x02 \x01(\x05R\vreviewCount\"I\n" +
+	"\x15GetReviewStatsRequest\x120\n" +
+	"\x06filter\x18\x01 \x01(\v2\x18.prservice.v1.StatFilterR\x06filter\"S\n" +
+	"\x16GetReviewStatsResponse\x129\n" +
+	"\vreview_stat\x18\x01 \x03(\v2\x18.prservice.v1.ReviewStatR\n" +
+	"reviewStat\"\xcd\x02\n" +
+	"\bTeamStat\x12\x1b\n" +
+	"\tteam_name\x18\x01 \x01(\tR\bteamName\x12\x18\n" +
+	"\amembers\x18\x02 \x01(\x05R\amembers\x12%\n" +
+	"\x0eactive_members\x18\x03 \x01(\x05R\ractiveMembers\x12#\n" +
+	"\rpull_requests\x18\x04 \x01(\x05R\fpullRequests\x12,\n" +
+	"\x12open_pull_requests\x18\x05 \x01(\x05R\x10openPullRequests\x120\n" +
+	"\x14merged_pull_requests\x18\x06 \x01(\x05R\x12mergedPullRequests\x12-\n" +
+	"\x12review_assignments\x18\a \x01(\x05R\x11reviewAssignments\x12/\n" +
+	"\x14avg_reviewers_per_pr\x18\b \x01(\x01R\x11avgReviewersPerPr\"G\n" +
+	"\x13GetTeamStatsRequest\x120\n" +
+	"\x06filter\x18\x01 \x01(\v2\x18.prservice.v1.StatFilterR\x06filter\"K\n" +
+	"\x14GetTeamStatsResponse\x123\n" +
+	"\tteam_stat\x18\x01 \x03(\v2\x16.prservice.v1.TeamStatR\bteamStat\"N\n" +
+	"\x1aGetPullRequestStatsRequest\x120\n" +
+	"\x06filter\x18\x01 \x01(\v2\x18.prservice.v1.StatFilterR\x06filter\"\xa5\x03\n" +
+	"\x1bGetPullRequestStatsResponse\x12#\n" +
+	"\rpull_requests\x18\x01 \x01(\x05R\fpullRequests\x12,\n" +
+	"\x12open_pull_requests\x18\x02 \x01(\x05R\x10openPullRequests\x120\n" +
+	"\x14merged_pull_requests\x18\x03 \x01(\x05R\x12mergedPullRequests\x12/\n" +
+	"\x14avg_reviewers_per_pr\x18\x04 \x01(\x01R\x11avgReviewersPerPr\x12D\n" +
+	"\x11time_to_merge_p50\x18\x05 \x01(\v2\x19.google.protobuf.DurationR\x0etimeToMergeP50\x12D\n" +
+	"\x11time_to_merge_p90\x18\x06 \x01(\v2\x19.google.protobuf.DurationR\x0etimeToMergeP90\x12D\n" +
+	"\x11time_to_merge_p99\x18\a \x01(\v2\x19.google.protobuf.DurationR\x0etimeToMergeP99\"\x95\x01\n" +
+	"\x1dWatchPullRequestEventsRequest\x12&\n" +
+	"\x0fpull_request_id\x18\x01 \x01(\tR\rpullRequestId\x12\x1b\n" +
+	"\tteam_name\x18\x02 \x01(\tR\bteamName\x12\x14\n" +
+	"\x05types\x18\x03 \x03(\tR\x05types\x12\x19\n" +
+	"\bafter_id\x18\x04 \x01(\x03R\aafterId\"\xe1\x02\n" +
+	"\x10PullRequestEvent\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x12\n" +
+	"\x04type\x18\x02 \x01(\tR\x04type\x12&\n" +
+	"\x0fpull_request_id\x18\x03 \x01(\tR\rpullRequestId\x12\x19\n" +
+	"\buser_ids\x18\x04 \x03(\tR\auserIds\x12\x1b\n" +
+	"\tteam_name\x18\x05 \x01(\tR\bteamName\x12\x14\n" +
+	"\x05actor\x18\x06 \x01(\tR\x05actor\x12\x16\n" +
+	"\x06reason\x18\a \x01(\tR\x06reason\x12/\n" +
+	"\x06before\x18\b \x01(\v2\x17.google.protobuf.StructR\x06before\x12-\n" +
+	"\x05after\x18\t \x01(\v2\x17.google.protobuf.StructR\x05after\x12;\n" +
+	"\voccurred_at\x18\n" +
+	" \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"occurredAt*v\n" +
+	"\x11PullRequestStatus\x12#\n" +
+	"\x1fPULL_REQUEST_STATUS_UNSPECIFIED\x10\x00\x12\x1c\n" +
+	"\x18PULL_REQUEST_STATUS_OPEN\x10\x01\x12\x1e\n" +
+	"\x1aPULL_REQUEST_STATUS_MERGED\x10\x022\xfe\n" +
+	"\n" +
+	"\tPRService\x12F\n" +
+	"\aAddTeam\x12\x1c.prservice.v1.AddTeamRequest\x1a\x1d.prservice.v1.AddTeamResponse\x12F\n" +
+	"\aGetTeam\x12\x1c.prservice.v1.GetTeamRequest\x1a\x1d.prservice.v1.GetTeamResponse\x12[\n" +
+	"\x0eDeactivateTeam\x12#.prservice.v1.DeactivateTeamRequest\x1a$.prservice.v1.DeactivateTeamResponse\x12X\n" +
+	"\rSetUserActive\x12\".prservice.v1.SetUserActiveRequest\x1a#.prservice.v1.SetUserActiveResponse\x12[\n" +
+	"\x0eGetUserReviews\x12#.prservice.v1.GetUserReviewsRequest\x1a$.prservice.v1.GetUserReviewsResponse\x12d\n" +
+	"\x11CreatePullRequest\x12&.prservice.v1.CreatePullRequestRequest\x1a'.prservice.v1.CreatePullRequestResponse\x12a\n" +
+	"\x10MergePullRequest\x12%.prservice.v1.MergePullRequestRequest\x1a&.prservice.v1.MergePullRequestResponse\x12a\n" +
+	"\x10ReassignReviewer\x12%.prservice.v1.ReassignReviewerRequest\x1a&.prservice.v1.ReassignReviewerResponse\x12X\n" +
+	"\rDeclineReview\x12\".prservice.v1.DeclineReviewRequest\x1a#.prservice.v1.DeclineReviewResponse\x12[\n" +
+	"\x0eGetPullRequest\x12#.prservice.v1.GetPullRequestRequest\x1a$.prservice.v1.GetPullRequestResponse\x12a\n" +
+	"\x10ListPullRequests\x12%.prservice.v1.ListPullRequestsRequest\x1a&.prservice.v1.ListPullRequestsResponse\x12[\n" +
+	"\x0eGetReviewStats\x12#.prservice.v1.GetReviewStatsRequest\x1a$.prservice.v1.GetReviewStatsResponse\x12U\n" +
+	"\fGetTeamStats\x12!.prservice.v1.GetTeamStatsRequest\x1a\".prservice.v1.GetTeamStatsResponse\x12j\n" +
+	"\x13GetPullRequestStats\x12(.prservice.v1.GetPullRequestStatsRequest\x1a).prservice.v1.GetPullRequestStatsResponse\x12g\n" +
+	"\x16WatchPullRequestEvents\x12+.prservice.v1.WatchPullRequestEventsRequest\x1a\x1e.prservice.v1.PullRequestEvent0\x01BKZIgithub.com/sudo-odner/Backend-trainee-assignment-avito-2025/pkg/prpb;prpbb\x06proto3"
+
+var (
+	file_pr_service_proto_rawDescOnce sync.Once
+	file_pr_service_proto_rawDescData []byte
+)
+
+func file_pr_service_proto_rawDescGZIP() []byte {
+	file_pr_service_proto_rawDescOnce.Do(func() {
+		file_pr_service_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_pr_service_proto_rawDesc), len(file_pr_service_proto_rawDesc)))
+	})
+	return file_pr_service_proto_rawDescData
+}
+
+var file_pr_service_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
+var file_pr_service_proto_msgTypes = make([]protoimpl.MessageInfo, 37)
+var file_pr_service_proto_goTypes = []any{
+	(PullRequestStatus)(0),                // 0: prservice.v1.PullRequestStatus
+	(*TeamMember)(nil),                    // 1: prservice.v1.TeamMember
+	(*Team)(nil),                          // 2: prservice.v1.Team
+	(*User)(nil),                          // 3: prservice.v1.User
+	(*PullRequest)(nil),                   // 4: prservice.v1.PullRequest
+	(*AddTeamRequest)(nil),                // 5: prservice.v1.AddTeamRequest
+	(*AddTeamResponse)(nil),               // 6: prservice.v1.AddTeamResponse
+	(*GetTeamRequest)(nil),                // 7: prservice.v1.GetTeamRequest
+	(*GetTeamResponse)(nil),               // 8: prservice.v1.GetTeamResponse
+	(*DeactivateTeamRequest)(nil),         // 9: prservice.v1.DeactivateTeamRequest
+	(*DeactivateTeamResponse)(nil),        // 10: prservice.v1.DeactivateTeamResponse
+	(*SetUserActiveRequest)(nil),          // 11: prservice.v1.SetUserActiveRequest
+	(*SetUserActiveResponse)(nil),         // 12: prservice.v1.SetUserActiveResponse
+	(*GetUserReviewsRequest)(nil),         // 13: prservice.v1.GetUserReviewsRequest
+	(*GetUserReviewsResponse)(nil),        // 14: prservice.v1.GetUserReviewsResponse
+	(*CreatePullRequestRequest)(nil),      // 15: prservice.v1.CreatePullRequestRequest
+	(*CreatePullRequestResponse)(nil),     // 16: prservice.v1.CreatePullRequestResponse
+	(*MergePullRequestRequest)(nil),       // 17: prservice.v1.MergePullRequestRequest
+	(*MergePullRequestResponse)(nil),      // 18: prservice.v1.MergePullRequestResponse
+	(*ReassignReviewerRequest)(nil),       // 19: prservice.v1.ReassignReviewerRequest
+	(*ReassignReviewerResponse)(nil),      // 20: prservice.v1.ReassignReviewerResponse
+	(*DeclineReviewRequest)(nil),          // 21: prservice.v1.DeclineReviewRequest
+	(*DeclineReviewResponse)(nil),         // 22: prservice.v1.DeclineReviewResponse
+	(*GetPullRequestRequest)(nil),         // 23: prservice.v1.GetPullRequestRequest
+	(*GetPullRequestResponse)(nil),        // 24: prservice.v1.GetPullRequestResponse
+	(*ListPullRequestsRequest)(nil),       // 25: prservice.v1.ListPullRequestsRequest
+	(*ListPullRequestsResponse)(nil),      // 26: prservice.v1.ListPullRequestsResponse
+	(*StatFilter)(nil),                    // 27: prservice.v1.StatFilter
+	(*ReviewStat)(nil),                    // 28: prservice.v1.ReviewStat
+	(*GetReviewStatsRequest)(nil),         // 29: prservice.v1.GetReviewStatsRequest
+	(*GetReviewStatsResponse)(nil),        // 30: prservice.v1.GetReviewStatsResponse
+	(*TeamStat)(nil),                      // 31: prservice.v1.TeamStat
+	(*GetTeamStatsRequest)(nil),           // 32: prservice.v1.GetTeamStatsRequest
+	(*GetTeamStatsResponse)(nil),          // 33: prservice.v1.GetTeamStatsResponse
+	(*GetPullRequestStatsRequest)(nil),    // 34: prservice.v1.GetPullRequestStatsRequest
+	(*GetPullRequestStatsResponse)(nil),   // 35: prservice.v1.GetPullRequestStatsResponse
+	(*WatchPullRequestEventsRequest)(nil), // 36: prservice.v1.WatchPullRequestEventsRequest
+	(*PullRequestEvent)(nil),              // 37: prservice.v1.PullRequestEvent
+	(*timestamppb.Timestamp)(nil),         // 38: google.protobuf.Timestamp
+	(*durationpb.Duration)(nil),           // 39: google.protobuf.Duration
+	(*structpb.Struct)(nil),               // 40: google.protobuf.Struct
+}
+var file_pr_service_proto_depIdxs = []int32{
+	1,  // 0: prservice.v1.Team.members:type_name -> prservice.v1.TeamMember
+	0,  // 1: prservice.v1.PullRequest.status:type_name -> prservice.v1.PullRequestStatus
+	38, // 2: prservice.v1.PullRequest.created_at:type_name -> google.protobuf.Timestamp
+	38, // 3: prservice.v1.PullRequest.merged_at:type_name -> google.protobuf.Timestamp
+	2,  // 4: prservice.v1.AddTeamRequest.team:type_name -> prservice.v1.Team
+	2,  // 5: prservice.v1.AddTeamResponse.team:type_name -> prservice.v1.Team
+	2,  // 6: prservice.v1.GetTeamResponse.team:type_name -> prservice.v1.Team
+	3,  // 7: prservice.v1.SetUserActiveResponse.user:type_name -> prservice.v1.User
+	0,  // 8: prservice.v1.GetUserReviewsRequest.status:type_name -> prservice.v1.PullRequestStatus
+	4,  // 9: prservice.v1.GetUserReviewsResponse.pull_requests:type_name -> prservice.v1.PullRequest
+	4,  // 10: prservice.v1.CreatePullRequestResponse.pr:type_name -> prservice.v1.PullRequest
+	4,  // 11: prservice.v1.MergePullRequestResponse.pr:type_name -> prservice.v1.PullRequest
+	4,  // 12: prservice.v1.ReassignReviewerResponse.pr:type_name -> prservice.v1.PullRequest
+	4,  // 13: prservice.v1.DeclineReviewResponse.pr:type_name -> prservice.v1.PullRequest
+	4,  // 14: prservice.v1.GetPullRequestResponse.pr:type_name -> prservice.v1.PullRequest
+	0,  // 15: prservice.v1.ListPullRequestsRequest.status:type_name -> prservice.v1.PullRequestStatus
+	38, // 16: prservice.v1.ListPullRequestsRequest.created_from:type_name -> google.protobuf.Timestamp
+	38, // 17: prservice.v1.ListPullRequestsRequest.created_to:type_name -> google.protobuf.Timestamp
+	38, // 18: prservice.v1.ListPullRequestsRequest.merged_from:type_name -> google.protobuf.Timestamp
+	38, // 19: prservice.v1.ListPullRequestsRequest.merged_to:type_name -> google.protobuf.Timestamp
+	4,  // 20: prservice.v1.ListPullRequestsResponse.pull_requests:type_name -> prservice.v1.PullRequest
+	38, // 21: prservice.v1.StatFilter.from:type_name -> google.protobuf.Timestamp
+	38, // 22: prservice.v1.StatFilter.to:type_name -> google.protobuf.Timestamp
+	0,  // 23: prservice.v1.StatFilter.status:type_name -> prservice.v1.PullRequestStatus
+	27, // 24: prservice.v1.GetReviewStatsRequest.filter:type_name -> prservice.v1.StatFilter
+	28, // 25: prservice.v1.GetReviewStatsResponse.review_stat:type_name -> prservice.v1.ReviewStat
+	27, // 26: prservice.v1.GetTeamStatsRequest.filter:type_name -> prservice.v1.StatFilter
+	31, // 27: prservice.v1.GetTeamStatsResponse.team_stat:type_name -> prservice.v1.TeamStat
+	27, // 28: prservice.v1.GetPullRequestStatsRequest.filter:type_name -> prservice.v1.StatFilter
+	39, // 29: prservice.v1.GetPullRequestStatsResponse.time_to_merge_p50:type_name -> google.protobuf.Duration
+	39, // 30: prservice.v1.GetPullRequestStatsResponse.time_to_merge_p90:type_name -> google.protobuf.Duration
+	39, // 31: prservice.v1.GetPullRequestStatsResponse.time_to_merge_p99:type_name -> google.protobuf.Duration
+	40, // 32: prservice.v1.PullRequestEvent.before:type_name -> google.protobuf.Struct
+	40, // 33: prservice.v1.PullRequestEvent.after:type_name -> google.protobuf.Struct
+	38, // 34: prservice.v1.PullRequestEvent.occurred_at:type_name -> google.protobuf.Timestamp
+	5,  // 35: prservice.v1.PRService.AddTeam:input_type -> prservice.v1.AddTeamRequest
+	7,  // 36: prservice.v1.PRService.GetTeam:input_type -> prservice.v1.GetTeamRequest
+	9,  // 37: prservice.v1.PRService.DeactivateTeam:input_type -> prservice.v1.DeactivateTeamRequest
+	11, // 38: prservice.v1.PRService.SetUserActive:input_type -> prservice.v1.SetUserActiveRequest
+	13, // 39: prservice.v1.PRService.GetUserReviews:input_type -> prservice.v1.GetUserReviewsRequest
+	15, // 40: prservice.v1.PRService.CreatePullRequest:input_type -> prservice.v1.CreatePullRequestRequest
+	17, // 41: prservice.v1.PRService.MergePullRequest:input_type -> prservice.v1.MergePullRequestRequest
+	19, // 42: prservice.v1.PRService.ReassignReviewer:input_type -> prservice.v1.ReassignReviewerRequest
+	21, // 43: prservice.v1.PRService.DeclineReview:input_type -> prservice.v1.DeclineReviewRequest
+	23, // 44: prservice.v1.PRService.GetPullRequest:input_type -> prservice.v1.GetPullRequestRequest
+	25, // 45: prservice.v1.PRService.ListPullRequests:input_type -> prservice.v1.ListPullRequestsRequest
+	29, // 46: prservice.v1.PRService.GetReviewStats:input_type -> prservice.v1.GetReviewStatsRequest
+	32, // 47: prservice.v1.PRService.GetTeamStats:input_type -> prservice.v1.GetTeamStatsRequest
+	34, // 48: prservice.v1.PRService.GetPullRequestStats:input_type -> prservice.v1.GetPullRequestStatsRequest
+	36, // 49: prservice.v1.PRService.WatchPullRequestEvents:input_type -> prservice.v1.WatchPullRequestEventsRequest
+	6,  // 50: prservice.v1.PRService.AddTeam:output_type -> prservice.v1.AddTeamResponse
+	8,  // 51: prservice.v1.PRService.GetTeam:output_type -> prservice.v1.GetTeamResponse
+	10, // 52: prservice.v1.PRService.DeactivateTeam:output_type -> prservice.v1.DeactivateTeamResponse
+	12, // 53: prservice.v1.PRService.SetUserActive:output_type -> prservice.v1.SetUserActiveResponse
+	14, // 54: prservice.v1.PRService.GetUserReviews:output_type -> prservice.v1.GetUserReviewsResponse
+	16, // 55: prservice.v1.PRService.CreatePullRequest:output_type -> prservice.v1.CreatePullRequestResponse
+	18, // 56: prservice.v1.PRService.MergePullRequest:output_type -> prservice.v1.MergePullRequestResponse
+	20, // 57: prservice.v1.PRService.ReassignReviewer:output_type -> prservice.v1.ReassignReviewerResponse
+	22, // 58: prservice.v1.PRService.DeclineReview:output_type -> prservice.v1.DeclineReviewResponse
+	24, // 59: prservice.v1.PRService.GetPullRequest:output_type -> prservice.v1.GetPullRequestResponse
+	26, // 60: prservice.v1.PRService.ListPullRequests:output_type -> prservice.v1.ListPullRequestsResponse
+	30, // 61: prservice.v1.PRService.GetReviewStats:output_type -> prservice.v1.GetReviewStatsResponse
+	33, // 62: prservice.v1.PRService.GetTeamStats:output_type -> prservice.v1.GetTeamStatsResponse
+	35, // 63: prservice.v1.PRService.GetPullRequestStats:output_type -> prservice.v1.GetPullRequestStatsResponse
+	37, // 64: prservice.v1.PRService.WatchPullRequestEvents:output_type -> prservice.v1.PullRequestEvent
+	50, // [50:65] is the sub-list for method output_type
+	35, // [35:50] is the sub-list for method input_type
+	35, // [35:35] is the sub-list for extension type_name
+	35, // [35:35] is the sub-list for extension extendee
+	0,  // [0:35] is the sub-list for field type_name
+}
+
+func init() { file_pr_service_proto_init() }
+func file_pr_service_proto_init() {
+	if File_pr_service_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_pr_service_proto_rawDesc), len(file_pr_service_proto_rawDesc)),
+			NumEnums:      1,
+			NumMessages:   37,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_pr_service_proto_goTypes,
+		DependencyIndexes: file_pr_service_proto_depIdxs,
+		EnumInfos:         file_pr_service_proto_enumTypes,
+		MessageInfos:      file_pr_service_proto_msgTypes,
+	}.Build()
+	File_pr_service_proto = out.File
+	file_pr_service_proto_goTypes = nil
+	file_pr_service_proto_depIdxs = nil
+}
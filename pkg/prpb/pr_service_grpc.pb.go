@@ -0,0 +1,697 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.6.2
+// - protoc             (unknown)
+// source: pr_service.proto
+
+package prpb
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.64.0 or later.
+const _ = grpc.SupportPackageIsVersion9
+
+const (
+	PRService_AddTeam_FullMethodName                = "/prservice.v1.PRService/AddTeam"
+	PRService_GetTeam_FullMethodName                = "/prservice.v1.PRService/GetTeam"
+	PRService_DeactivateTeam_FullMethodName         = "/prservice.v1.PRService/DeactivateTeam"
+	PRService_SetUserActive_FullMethodName          = "/prservice.v1.PRService/SetUserActive"
+	PRService_GetUserReviews_FullMethodName         = "/prservice.v1.PRService/GetUserReviews"
+	PRService_CreatePullRequest_FullMethodName      = "/prservice.v1.PRService/CreatePullRequest"
+	PRService_MergePullRequest_FullMethodName       = "/prservice.v1.PRService/MergePullRequest"
+	PRService_ReassignReviewer_FullMethodName       = "/prservice.v1.PRService/ReassignReviewer"
+	PRService_DeclineReview_FullMethodName          = "/prservice.v1.PRService/DeclineReview"
+	PRService_GetPullRequest_FullMethodName         = "/prservice.v1.PRService/GetPullRequest"
+	PRService_ListPullRequests_FullMethodName       = "/prservice.v1.PRService/ListPullRequests"
+	PRService_GetReviewStats_FullMethodName         = "/prservice.v1.PRService/GetReviewStats"
+	PRService_GetTeamStats_FullMethodName           = "/prservice.v1.PRService/GetTeamStats"
+	PRService_GetPullRequestStats_FullMethodName    = "/prservice.v1.PRService/GetPullRequestStats"
+	PRService_WatchPullRequestEvents_FullMethodName = "/prservice.v1.PRService/WatchPullRequestEvents"
+)
+
+// PRServiceClient is the client API for PRService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+//
+// PRService gRPC API сервиса назначения reviewer: те же операции и ошибки, что и в HTTP API (task/openapi.yml).
+// Ошибки возвращаются статусом gRPC с google.rpc.ErrorInfo, reason - код ошибки HTTP API (NOT_FOUND, PR_MERGED, ...).
+// Токен API передаётся в metadata "authorization: Bearer <token>", инициатор изменения для admin - в "x-actor".
+type PRServiceClient interface {
+	// Создать команду с участниками (создаёт/обновляет пользователей)
+	AddTeam(ctx context.Context, in *AddTeamRequest, opts ...grpc.CallOption) (*AddTeamResponse, error)
+	// Получить команду с участниками
+	GetTeam(ctx context.Context, in *GetTeamRequest, opts ...grpc.CallOption) (*GetTeamResponse, error)
+	// Деактивировать всех участников команды
+	DeactivateTeam(ctx context.Context, in *DeactivateTeamRequest, opts ...grpc.CallOption) (*DeactivateTeamResponse, error)
+	// Установить флаг активности пользователя
+	SetUserActive(ctx context.Context, in *SetUserActiveRequest, opts ...grpc.CallOption) (*SetUserActiveResponse, error)
+	// PR, где пользователь назначен reviewer или является автором
+	GetUserReviews(ctx context.Context, in *GetUserReviewsRequest, opts ...grpc.CallOption) (*GetUserReviewsResponse, error)
+	// Создать PR и назначить до 2 reviewer из команды автора
+	CreatePullRequest(ctx context.Context, in *CreatePullRequestRequest, opts ...grpc.CallOption) (*CreatePullRequestResponse, error)
+	// Пометить PR как MERGED (идемпотентная операция)
+	MergePullRequest(ctx context.Context, in *MergePullRequestRequest, opts ...grpc.CallOption) (*MergePullRequestResponse, error)
+	// Переназначить reviewer на другого из его команды
+	ReassignReviewer(ctx context.Context, in *ReassignReviewerRequest, opts ...grpc.CallOption) (*ReassignReviewerResponse, error)
+	// Отказ reviewer от ревью с подбором замены
+	DeclineReview(ctx context.Context, in *DeclineReviewRequest, opts ...grpc.CallOption) (*DeclineReviewResponse, error)
+	// Получить PR
+	GetPullRequest(ctx context.Context, in *GetPullRequestRequest, opts ...grpc.CallOption) (*GetPullRequestResponse, error)
+	// Список PR с фильтрами и постраничной выдачей
+	ListPullRequests(ctx context.Context, in *ListPullRequestsRequest, opts ...grpc.CallOption) (*ListPullRequestsResponse, error)
+	// Число назначений на ревью по пользователям
+	GetReviewStats(ctx context.Context, in *GetReviewStatsRequest, opts ...grpc.CallOption) (*GetReviewStatsResponse, error)
+	// Статистика по командам
+	GetTeamStats(ctx context.Context, in *GetTeamStatsRequest, opts ...grpc.CallOption) (*GetTeamStatsResponse, error)
+	// Статистика по PR и время до merge
+	GetPullRequestStats(ctx context.Context, in *GetPullRequestStatsRequest, opts ...grpc.CallOption) (*GetPullRequestStatsResponse, error)
+	// Поток событий PR (создание, назначения, замены, отказы, merge) по мере их появления.
+	// Доступен admin и руководителю команды (только с team_name своей команды)
+	WatchPullRequestEvents(ctx context.Context, in *WatchPullRequestEventsRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[PullRequestEvent], error)
+}
+
+type pRServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewPRServiceClient(cc grpc.ClientConnInterface) PRServiceClient {
+	return &pRServiceClient{cc}
+}
+
+func (c *pRServiceClient) AddTeam(ctx context.Context, in *AddTeamRequest, opts ...grpc.CallOption) (*AddTeamResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AddTeamResponse)
+	err := c.cc.Invoke(ctx, PRService_AddTeam_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *pRServiceClient) GetTeam(ctx context.Context, in *GetTeamRequest, opts ...grpc.CallOption) (*GetTeamResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetTeamResponse)
+	err := c.cc.Invoke(ctx, PRService_GetTeam_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *pRServiceClient) DeactivateTeam(ctx context.Context, in *DeactivateTeamRequest, opts ...grpc.CallOption) (*DeactivateTeamResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeactivateTeamResponse)
+	err := c.cc.Invoke(ctx, PRService_DeactivateTeam_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *pRServiceClient) SetUserActive(ctx context.Context, in *SetUserActiveRequest, opts ...grpc.CallOption) (*SetUserActiveResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SetUserActiveResponse)
+	err := c.cc.Invoke(ctx, PRService_SetUserActive_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *pRServiceClient) GetUserReviews(ctx context.Context, in *GetUserReviewsRequest, opts ...grpc.CallOption) (*GetUserReviewsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetUserReviewsResponse)
+	err := c.cc.Invoke(ctx, PRService_GetUserReviews_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *pRServiceClient) CreatePullRequest(ctx context.Context, in *CreatePullRequestRequest, opts ...grpc.CallOption) (*CreatePullRequestResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CreatePullRequestResponse)
+	err := c.cc.Invoke(ctx, PRService_CreatePullRequest_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *pRServiceClient) MergePullRequest(ctx context.Context, in *MergePullRequestRequest, opts ...grpc.CallOption) (*MergePullRequestResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MergePullRequestResponse)
+	err := c.cc.Invoke(ctx, PRService_MergePullRequest_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *pRServiceClient) ReassignReviewer(ctx context.Context, in *ReassignReviewerRequest, opts ...grpc.CallOption) (*ReassignReviewerResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ReassignReviewerResponse)
+	err := c.cc.Invoke(ctx, PRService_ReassignReviewer_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *pRServiceClient) DeclineReview(ctx context.Context, in *DeclineReviewRequest, opts ...grpc.CallOption) (*DeclineReviewResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeclineReviewResponse)
+	err := c.cc.Invoke(ctx, PRService_DeclineReview_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *pRServiceClient) GetPullRequest(ctx context.Context, in *GetPullRequestRequest, opts ...grpc.CallOption) (*GetPullRequestResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetPullRequestResponse)
+	err := c.cc.Invoke(ctx, PRService_GetPullRequest_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *pRServiceClient) ListPullRequests(ctx context.Context, in *ListPullRequestsRequest, opts ...grpc.CallOption) (*ListPullRequestsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListPullRequestsResponse)
+	err := c.cc.Invoke(ctx, PRService_ListPullRequests_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *pRServiceClient) GetReviewStats(ctx context.Context, in *GetReviewStatsRequest, opts ...grpc.CallOption) (*GetReviewStatsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetReviewStatsResponse)
+	err := c.cc.Invoke(ctx, PRService_GetReviewStats_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *pRServiceClient) GetTeamStats(ctx context.Context, in *GetTeamStatsRequest, opts ...grpc.CallOption) (*GetTeamStatsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetTeamStatsResponse)
+	err := c.cc.Invoke(ctx, PRService_GetTeamStats_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *pRServiceClient) GetPullRequestStats(ctx context.Context, in *GetPullRequestStatsRequest, opts ...grpc.CallOption) (*GetPullRequestStatsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetPullRequestStatsResponse)
+	err := c.cc.Invoke(ctx, PRService_GetPullRequestStats_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *pRServiceClient) WatchPullRequestEvents(ctx context.Context, in *WatchPullRequestEventsRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[PullRequestEvent], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &PRService_ServiceDesc.Streams[0], PRService_WatchPullRequestEvents_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[WatchPullRequestEventsRequest, PullRequestEvent]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type PRService_WatchPullRequestEventsClient = grpc.ServerStreamingClient[PullRequestEvent]
+
+// PRServiceServer is the server API for PRService service.
+// All implementations must embed UnimplementedPRServiceServer
+// for forward compatibility.
+//
+// PRService gRPC API сервиса назначения reviewer: те же операции и ошибки, что и в HTTP API (task/openapi.yml).
+// Ошибки возвращаются статусом gRPC с google.rpc.ErrorInfo, reason - код ошибки HTTP API (NOT_FOUND, PR_MERGED, ...).
+// Токен API передаётся в metadata "authorization: Bearer <token>", инициатор изменения для admin - в "x-actor".
+type PRServiceServer interface {
+	// Создать команду с участниками (создаёт/обновляет пользователей)
+	AddTeam(context.Context, *AddTeamRequest) (*AddTeamResponse, error)
+	// Получить команду с участниками
+	GetTeam(context.Context, *GetTeamRequest) (*GetTeamResponse, error)
+	// Деактивировать всех участников команды
+	DeactivateTeam(context.Context, *DeactivateTeamRequest) (*DeactivateTeamResponse, error)
+	// Установить флаг активности пользователя
+	SetUserActive(context.Context, *SetUserActiveRequest) (*SetUserActiveResponse, error)
+	// PR, где пользователь назначен reviewer или является автором
+	GetUserReviews(context.Context, *GetUserReviewsRequest) (*GetUserReviewsResponse, error)
+	// Создать PR и назначить до 2 reviewer из команды автора
+	CreatePullRequest(context.Context, *CreatePullRequestRequest) (*CreatePullRequestResponse, error)
+	// Пометить PR как MERGED (идемпотентная операция)
+	MergePullRequest(context.Context, *MergePullRequestRequest) (*MergePullRequestResponse, error)
+	// Переназначить reviewer на другого из его команды
+	ReassignReviewer(context.Context, *ReassignReviewerRequest) (*ReassignReviewerResponse, error)
+	// Отказ reviewer от ревью с подбором замены
+	DeclineReview(context.Context, *DeclineReviewRequest) (*DeclineReviewResponse, error)
+	// Получить PR
+	GetPullRequest(context.Context, *GetPullRequestRequest) (*GetPullRequestResponse, error)
+	// Список PR с фильтрами и постраничной выдачей
+	ListPullRequests(context.Context, *ListPullRequestsRequest) (*ListPullRequestsResponse, error)
+	// Число назначений на ревью по пользователям
+	GetReviewStats(context.Context, *GetReviewStatsRequest) (*GetReviewStatsResponse, error)
+	// Статистика по командам
+	GetTeamStats(context.Context, *GetTeamStatsRequest) (*GetTeamStatsResponse, error)
+	// Статистика по PR и время до merge
+	GetPullRequestStats(context.Context, *GetPullRequestStatsRequest) (*GetPullRequestStatsResponse, error)
+	// Поток событий PR (создание, назначения, замены, отказы, merge) по мере их появления.
+	// Доступен admin и руководителю команды (только с team_name своей команды)
+	WatchPullRequestEvents(*WatchPullRequestEventsRequest, grpc.ServerStreamingServer[PullRequestEvent]) error
+	mustEmbedUnimplementedPRServiceServer()
+}
+
+// UnimplementedPRServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedPRServiceServer struct{}
+
+func (UnimplementedPRServiceServer) AddTeam(context.Context, *AddTeamRequest) (*AddTeamResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method AddTeam not implemented")
+}
+func (UnimplementedPRServiceServer) GetTeam(context.Context, *GetTeamRequest) (*GetTeamResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetTeam not implemented")
+}
+func (UnimplementedPRServiceServer) DeactivateTeam(context.Context, *DeactivateTeamRequest) (*DeactivateTeamResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method DeactivateTeam not implemented")
+}
+func (UnimplementedPRServiceServer) SetUserActive(context.Context, *SetUserActiveRequest) (*SetUserActiveResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method SetUserActive not implemented")
+}
+func (UnimplementedPRServiceServer) GetUserReviews(context.Context, *GetUserReviewsRequest) (*GetUserReviewsResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetUserReviews not implemented")
+}
+func (UnimplementedPRServiceServer) CreatePullRequest(context.Context, *CreatePullRequestRequest) (*CreatePullRequestResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method CreatePullRequest not implemented")
+}
+func (UnimplementedPRServiceServer) MergePullRequest(context.Context, *MergePullRequestRequest) (*MergePullRequestResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method MergePullRequest not implemented")
+}
+func (UnimplementedPRServiceServer) ReassignReviewer(context.Context, *ReassignReviewerRequest) (*ReassignReviewerResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ReassignReviewer not implemented")
+}
+func (UnimplementedPRServiceServer) DeclineReview(context.Context, *DeclineReviewRequest) (*DeclineReviewResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method DeclineReview not implemented")
+}
+func (UnimplementedPRServiceServer) GetPullRequest(context.Context, *GetPullRequestRequest) (*GetPullRequestResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetPullRequest not implemented")
+}
+func (UnimplementedPRServiceServer) ListPullRequests(context.Context, *ListPullRequestsRequest) (*ListPullRequestsResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListPullRequests not implemented")
+}
+func (UnimplementedPRServiceServer) GetReviewStats(context.Context, *GetReviewStatsRequest) (*GetReviewStatsResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetReviewStats not implemented")
+}
+func (UnimplementedPRServiceServer) GetTeamStats(context.Context, *GetTeamStatsRequest) (*GetTeamStatsResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetTeamStats not implemented")
+}
+func (UnimplementedPRServiceServer) GetPullRequestStats(context.Context, *GetPullRequestStatsRequest) (*GetPullRequestStatsResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetPullRequestStats not implemented")
+}
+func (UnimplementedPRServiceServer) WatchPullRequestEvents(*WatchPullRequestEventsRequest, grpc.ServerStreamingServer[PullRequestEvent]) error {
+	return status.Error(codes.Unimplemented, "method WatchPullRequestEvents not implemented")
+}
+func (UnimplementedPRServiceServer) mustEmbedUnimplementedPRServiceServer() {}
+func (UnimplementedPRServiceServer) testEmbeddedByValue()                   {}
+
+// UnsafePRServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to PRServiceServer will
+// result in compilation errors.
+type UnsafePRServiceServer interface {
+	mustEmbedUnimplementedPRServiceServer()
+}
+
+func RegisterPRServiceServer(s grpc.ServiceRegistrar, srv PRServiceServer) {
+	// If the following call panics, it indicates UnimplementedPRServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&PRService_ServiceDesc, srv)
+}
+
+func _PRService_AddTeam_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AddTeamRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PRServiceServer).AddTeam(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PRService_AddTeam_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PRServiceServer).AddTeam(ctx, req.(*AddTeamRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PRService_GetTeam_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetTeamRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PRServiceServer).GetTeam(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PRService_GetTeam_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PRServiceServer).GetTeam(ctx, req.(*GetTeamRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PRService_DeactivateTeam_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeactivateTeamRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PRServiceServer).DeactivateTeam(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PRService_DeactivateTeam_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PRServiceServer).DeactivateTeam(ctx, req.(*DeactivateTeamRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PRService_SetUserActive_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetUserActiveRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PRServiceServer).SetUserActive(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PRService_SetUserActive_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PRServiceServer).SetUserActive(ctx, req.(*SetUserActiveRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PRService_GetUserReviews_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetUserReviewsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PRServiceServer).GetUserReviews(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PRService_GetUserReviews_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PRServiceServer).GetUserReviews(ctx, req.(*GetUserReviewsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PRService_CreatePullRequest_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreatePullRequestRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PRServiceServer).CreatePullRequest(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PRService_CreatePullRequest_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PRServiceServer).CreatePullRequest(ctx, req.(*CreatePullRequestRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PRService_MergePullRequest_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MergePullRequestRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PRServiceServer).MergePullRequest(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PRService_MergePullRequest_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PRServiceServer).MergePullRequest(ctx, req.(*MergePullRequestRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PRService_ReassignReviewer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReassignReviewerRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PRServiceServer).ReassignReviewer(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PRService_ReassignReviewer_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PRServiceServer).ReassignReviewer(ctx, req.(*ReassignReviewerRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PRService_DeclineReview_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeclineReviewRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PRServiceServer).DeclineReview(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PRService_DeclineReview_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PRServiceServer).DeclineReview(ctx, req.(*DeclineReviewRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PRService_GetPullRequest_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetPullRequestRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PRServiceServer).GetPullRequest(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PRService_GetPullRequest_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PRServiceServer).GetPullRequest(ctx, req.(*GetPullRequestRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PRService_ListPullRequests_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListPullRequestsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PRServiceServer).ListPullRequests(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PRService_ListPullRequests_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PRServiceServer).ListPullRequests(ctx, req.(*ListPullRequestsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PRService_GetReviewStats_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetReviewStatsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PRServiceServer).GetReviewStats(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PRService_GetReviewStats_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PRServiceServer).GetReviewStats(ctx, req.(*GetReviewStatsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PRService_GetTeamStats_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetTeamStatsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PRServiceServer).GetTeamStats(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PRService_GetTeamStats_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PRServiceServer).GetTeamStats(ctx, req.(*GetTeamStatsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PRService_GetPullRequestStats_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetPullRequestStatsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PRServiceServer).GetPullRequestStats(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PRService_GetPullRequestStats_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PRServiceServer).GetPullRequestStats(ctx, req.(*GetPullRequestStatsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PRService_WatchPullRequestEvents_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(WatchPullRequestEventsRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(PRServiceServer).WatchPullRequestEvents(m, &grpc.GenericServerStream[WatchPullRequestEventsRequest, PullRequestEvent]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type PRService_WatchPullRequestEventsServer = grpc.ServerStreamingServer[PullRequestEvent]
+
+// PRService_ServiceDesc is the grpc.ServiceDesc for PRService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var PRService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "prservice.v1.PRService",
+	HandlerType: (*PRServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "AddTeam",
+			Handler:    _PRService_AddTeam_Handler,
+		},
+		{
+			MethodName: "GetTeam",
+			Handler:    _PRService_GetTeam_Handler,
+		},
+		{
+			MethodName: "DeactivateTeam",
+			Handler:    _PRService_DeactivateTeam_Handler,
+		},
+		{
+			MethodName: "SetUserActive",
+			Handler:    _PRService_SetUserActive_Handler,
+		},
+		{
+			MethodName: "GetUserReviews",
+			Handler:    _PRService_GetUserReviews_Handler,
+		},
+		{
+			MethodName: "CreatePullRequest",
+			Handler:    _PRService_CreatePullRequest_Handler,
+		},
+		{
+			MethodName: "MergePullRequest",
+			Handler:    _PRService_MergePullRequest_Handler,
+		},
+		{
+			MethodName: "ReassignReviewer",
+			Handler:    _PRService_ReassignReviewer_Handler,
+		},
+		{
+			MethodName: "DeclineReview",
+			Handler:    _PRService_DeclineReview_Handler,
+		},
+		{
+			MethodName: "GetPullRequest",
+			Handler:    _PRService_GetPullRequest_Handler,
+		},
+		{
+			MethodName: "ListPullRequests",
+			Handler:    _PRService_ListPullRequests_Handler,
+		},
+		{
+			MethodName: "GetReviewStats",
+			Handler:    _PRService_GetReviewStats_Handler,
+		},
+		{
+			MethodName: "GetTeamStats",
+			Handler:    _PRService_GetTeamStats_Handler,
+		},
+		{
+			MethodName: "GetPullRequestStats",
+			Handler:    _PRService_GetPullRequestStats_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "WatchPullRequestEvents",
+			Handler:       _PRService_WatchPullRequestEvents_Handler,
+			ServerStreams: true,
+		},
+	},
+	Metadata: "pr_service.proto",
+}
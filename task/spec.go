@@ -0,0 +1,9 @@
+// Package task Файлы поставленной задачи (спецификация API встраивается в сервис)
+package task
+
+import _ "embed"
+
+// OpenAPI Спецификация HTTP API сервиса
+//
+//go:embed openapi.yml
+var OpenAPI []byte
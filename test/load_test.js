@@ -7,6 +7,12 @@ export let options = {
     iterations: 50 // всего запросов на каждый эндпоинт
 };
 
+// Токен API (auth.admin_token из конфига)
+const headers = {
+    'Content-Type': 'application/json',
+    'Authorization': `Bearer ${__ENV.API_TOKEN || 'local_admin_token'}`
+};
+
 // Функция генерации рандомного ID
 function randID() {
     return Math.floor(Math.random() * 1000000);
@@ -30,18 +36,14 @@ export default function () {
         ]
     });
 
-    let res = http.post('http://localhost:8080/team/add', teamPayload, {
-        headers: { 'Content-Type': 'application/json' }
-    });
+    let res = http.post('http://localhost:8080/team/add', teamPayload, { headers });
     check(res, { 'team added': (r) => r.status === 201 });
 
     sleep(0.1);
 
     // /users/setIsActive
     let setActivePayload = JSON.stringify({ user_id: user1, is_active: true });
-    res = http.post('http://localhost:8080/users/setIsActive', setActivePayload, {
-        headers: { 'Content-Type': 'application/json' }
-    });
+    res = http.post('http://localhost:8080/users/setIsActive', setActivePayload, { headers });
     check(res, { 'user deactivated': (r) => r.status === 200 });
 
     sleep(0.1);
@@ -53,29 +55,25 @@ export default function () {
         pull_request_name: `PR ${randID()}`,
         author_id: user1
     });
-    res = http.post('http://localhost:8080/pullRequest/create', prPayload, {
-        headers: { 'Content-Type': 'application/json' }
-    });
+    res = http.post('http://localhost:8080/pullRequest/create', prPayload, { headers });
     check(res, { 'PR created': (r) => r.status === 201 });
 
     sleep(0.1);
 
     // /pullRequest/merge
     let mergePayload = JSON.stringify({ pull_request_id: prID });
-    res = http.post('http://localhost:8080/pullRequest/merge', mergePayload, {
-        headers: { 'Content-Type': 'application/json' }
-    });
+    res = http.post('http://localhost:8080/pullRequest/merge', mergePayload, { headers });
     check(res, { 'PR merged': (r) => r.status === 200 });
 
     sleep(0.1);
 
     // /team/get
-    res = http.get(`http://localhost:8080/team/get?team_name=${teamName}`);
+    res = http.get(`http://localhost:8080/team/get?team_name=${teamName}`, { headers });
     check(res, { 'team fetched': (r) => r.status === 200 });
 
     sleep(0.1);
 
     // /users/getReview
-    res = http.get(`http://localhost:8080/users/getReview?user_id=${user1}`);
+    res = http.get(`http://localhost:8080/users/getReview?user_id=${user1}`, { headers });
     check(res, { 'user reviews fetched': (r) => r.status === 200 });
 }
\ No newline at end of file